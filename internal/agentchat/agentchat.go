@@ -0,0 +1,533 @@
+// Package agentchat sends a message to an agent running in a tmux session
+// and reads back its reply.
+//
+// The message is nudged into the session like any other, then the pane is
+// polled until the output stops changing. The reply is located in the
+// capture (by an end marker, the echoed message, or the pre-send line
+// count, in that order of preference) and cleaned of agent UI chrome such
+// as the prompt box and status bar. gt mayor chat and gt polecat chat are
+// thin wrappers over SendAndCapture.
+package agentchat
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/steveyegge/gastown/internal/tmux"
+)
+
+// Errors SendAndCapture returns when opts.Timeout passes before the
+// response is complete.
+var (
+	// ErrNoResponse means the pane never changed after the send: the agent
+	// didn't react at all, so the message may not have arrived.
+	ErrNoResponse = errors.New("no response from agent")
+
+	// ErrResponseTimeout means output appeared but was still changing when
+	// time ran out: the agent is working on a reply, just slowly.
+	ErrResponseTimeout = errors.New("timed out waiting for agent to finish responding")
+)
+
+// retryBackoff is the delay before the first capture retry; it doubles
+// on each subsequent attempt.
+const retryBackoff = 200 * time.Millisecond
+
+// CaptureLines is how many pane lines each poll captures at first.
+const CaptureLines = 100
+
+// MaxCaptureLines caps how far the capture window grows when a long
+// response pushes the echoed message out of view.
+const MaxCaptureLines = 3200
+
+// Options controls how SendAndCapture waits for a response.
+type Options struct {
+	Timeout      time.Duration // overall limit from send to return
+	PollInterval time.Duration // delay between pane captures, at first
+	MaxPoll      time.Duration // longest delay once backed off; 0 means PollInterval
+	StableFor    time.Duration // unchanged duration that marks the response complete
+
+	// Baseline, if positive, replaces the pre-send pane line count used to
+	// locate the response when the echoed message can't be found. Multi-turn
+	// callers pass the previous turn's CapturedLines so earlier turns are
+	// never re-surfaced.
+	Baseline int
+
+	// Retries is how many times a failed pane capture is retried before
+	// the error is returned.
+	Retries int
+
+	// Logger, if set, receives diagnostic messages such as capture retries.
+	Logger func(format string, args ...interface{})
+
+	// Sentinel, if set, is an end marker the agent is asked to print when its
+	// reply is complete. See NewSentinel.
+	Sentinel string
+
+	// OnLines, if set, receives newly completed response lines as they
+	// appear (streaming mode).
+	OnLines func([]string)
+
+	// OnCapture, if set, receives the final capture and where the response
+	// was found in it, for debugging extraction (gt mayor chat
+	// --debug-capture).
+	OnCapture func(CaptureDebug)
+
+	// WrapWidth, if positive, is the pane width in cells. Rows that fill it
+	// are treated as wrapped and joined before the response is returned.
+	WrapWidth int
+
+	// Context, if set, is a one-line workspace summary sent, tagged, before
+	// the message. See WithContext.
+	Context string
+
+	// Nudge holds the delivery options for the send (see
+	// mayor.Manager.NudgeOpts). Its LockTimeout defaults to Timeout, so an
+	// agent tied up by other nudges fails the send with tmux.ErrSessionBusy.
+	Nudge tmux.NudgeOpts
+
+	// FirstResponseGrace, if positive, is how long the pane may stay
+	// unchanged after a send before the agent is re-nudged, for TUIs that
+	// sometimes swallow input. Renudges caps how many times, and Renudge
+	// says how (RenudgeEnter if empty).
+	FirstResponseGrace time.Duration
+	Renudges           int
+	Renudge            RenudgeMode
+}
+
+// RenudgeMode is how SendAndCapture re-nudges an agent that hasn't reacted
+// within Options.FirstResponseGrace.
+type RenudgeMode string
+
+const (
+	// RenudgeEnter presses Enter, submitting a message that was typed but
+	// not sent. Harmless at an empty prompt, so it is the default.
+	RenudgeEnter RenudgeMode = "enter"
+	// RenudgeMessage sends the whole message again, for input that never
+	// reached the pane. If the first send did arrive, the agent sees it twice.
+	RenudgeMessage RenudgeMode = "message"
+)
+
+// ParseRenudgeMode validates a mode name. Empty means RenudgeEnter.
+func ParseRenudgeMode(name string) (RenudgeMode, error) {
+	switch RenudgeMode(name) {
+	case "", RenudgeEnter:
+		return RenudgeEnter, nil
+	case RenudgeMessage:
+		return RenudgeMessage, nil
+	}
+	return "", fmt.Errorf("unknown renudge mode %q (want enter or message)", name)
+}
+
+// Result is the outcome of a single send/capture exchange.
+type Result struct {
+	Response   string `json:"response"`
+	ElapsedMs  int64  `json:"elapsed_ms"`
+	Session    string `json:"session"`
+	Truncated  bool   `json:"truncated"`
+	Stabilized bool   `json:"stabilized"`
+	Responded  bool   `json:"responded"`
+	Extraction string `json:"extraction"`         // the Method that found the response
+	Renudged   int    `json:"renudged,omitempty"` // re-nudges sent (see Options.FirstResponseGrace)
+
+	// CapturedLines is the pane line count at return, for use as the next
+	// turn's Baseline.
+	CapturedLines int `json:"-"`
+}
+
+// CaptureDebug describes how a response was extracted from the pane.
+type CaptureDebug struct {
+	Lines     []string // raw capture the response was extracted from
+	BeforeLen int      // pane line count before the send, or Options.Baseline
+	Baseline  int      // BeforeLen relative to the capture window; where MethodBaseline starts
+	Location
+}
+
+// SendAndCapture nudges a session with message and polls its pane
+// until the output has been stable for opts.StableFor, then returns the
+// cleaned response. If the timeout expires first, the partial response is
+// returned with Stabilized unset, along with ErrNoResponse if the pane never
+// changed after the send or ErrResponseTimeout if it did; any other error
+// comes with a nil result. Polls capture only output written since the send
+// (see captureWindow), so earlier turns that repeat the message can't be
+// mistaken for its echo.
+//
+// If opts.OnLines is set, newly completed response lines are passed to it as
+// they appear. The final line of each poll is held back until the output
+// stabilizes, since the agent may still be writing it.
+//
+// If opts.FirstResponseGrace is set and the pane is still unchanged that
+// long after the send, the agent is re-nudged (up to opts.Renudges times,
+// each restarting the grace period) before the wait continues. The timeout
+// still runs from the first send.
+func SendAndCapture(t *tmux.Tmux, sessionName, message string, opts Options) (*Result, error) {
+	return SendAndCaptureContext(context.Background(), t, sessionName, message, opts)
+}
+
+// SendAndCaptureContext is SendAndCapture bound to ctx. Cancelling ctx
+// kills any tmux call in progress and ends the poll loop: before the send
+// it returns ctx's error and a nil result (see tmux.NudgeSessionContext for
+// when a send is past stopping); after it, the partial response captured
+// so far is returned with Stabilized unset, as on a timeout, and an error
+// wrapping ctx.Err().
+func SendAndCaptureContext(ctx context.Context, t *tmux.Tmux, sessionName, message string, opts Options) (*Result, error) {
+	onLines := opts.OnLines
+
+	window := &captureWindow{
+		capture: func(n int) ([]string, error) { return CaptureWithRetry(ctx, t, sessionName, n, opts) },
+		size:    CaptureLines,
+	}
+	before, err := window.capture(window.size)
+	if err != nil {
+		return nil, fmt.Errorf("capturing output: %w", err)
+	}
+	beforeLen := len(before)
+	if opts.Baseline > 0 {
+		beforeLen = opts.Baseline
+	}
+
+	// Mark where the pane's output ends so each poll captures only what was
+	// written after the send. Without a usable mark, fall back to the tail
+	// window and locate the response by the echoed message alone.
+	if mark, err := t.MarkPane(sessionName); err == nil {
+		window.since = func() ([]string, bool, error) {
+			var lines []string
+			var truncated bool
+			err := retryCapture(ctx, opts, func() (err error) {
+				lines, truncated, err = t.CapturePaneSinceContext(ctx, sessionName, mark)
+				return err
+			})
+			return lines, truncated, err
+		}
+		if sinceBefore, err := window.lines(nil); err == nil && window.since != nil {
+			before = sinceBefore
+		}
+	}
+	beforeContent := strings.Join(before, "\n")
+
+	sent := WithContext(message, opts.Context)
+	if opts.Sentinel != "" {
+		sent = WithSentinelInstruction(sent, opts.Sentinel)
+	}
+
+	nudge := opts.Nudge
+	if nudge.LockTimeout <= 0 {
+		nudge.LockTimeout = opts.Timeout
+	}
+	start := time.Now()
+	if err := t.NudgeSessionContext(ctx, sessionName, sent, nudge); err != nil {
+		return nil, fmt.Errorf("sending message: %w", err)
+	}
+	deadline := start.Add(opts.Timeout)
+
+	extract := func(lines []string) (response []string, method Method, complete bool) {
+		return Extract(lines, window.start(beforeLen), message, opts.Sentinel)
+	}
+	isAnchored := func(lines []string) bool {
+		_, method, _ := extract(lines)
+		return method != MethodBaseline
+	}
+
+	var stream streamEmitter
+	result := &Result{Session: sessionName}
+	finish := func(lines []string, stabilized bool) *Result {
+		response, method, _ := extract(lines)
+		if opts.OnCapture != nil {
+			baseline := window.start(beforeLen)
+			opts.OnCapture(CaptureDebug{Lines: lines, BeforeLen: beforeLen, Baseline: baseline, Location: Locate(lines, baseline, message, opts.Sentinel)})
+		}
+		if onLines != nil {
+			onLines(stream.next(response, true))
+		}
+		if opts.WrapWidth > 0 {
+			response = JoinWrappedLines(response, opts.WrapWidth)
+		}
+		result.Response = strings.Join(response, "\n")
+		result.ElapsedMs = time.Since(start).Milliseconds()
+		result.Truncated = method == MethodBaseline && window.full(lines)
+		result.Extraction = string(method)
+		result.Stabilized = stabilized
+		result.Responded = strings.Join(lines, "\n") != beforeContent
+		result.CapturedLines = len(lines)
+		return result
+	}
+
+	lastSend := start
+	renudgesLeft := 0
+	if opts.FirstResponseGrace > 0 {
+		renudgesLeft = opts.Renudges
+	}
+	renudge := func() error {
+		result.Renudged++
+		if opts.Logger != nil {
+			opts.Logger("no response after %s, re-nudging with %s (%d/%d)", time.Since(lastSend).Round(time.Millisecond), renudgeMode(opts.Renudge), result.Renudged, opts.Renudges)
+		}
+		lastSend = time.Now()
+		if renudgeMode(opts.Renudge) == RenudgeMessage {
+			again := nudge
+			again.LockTimeout = time.Until(deadline)
+			return t.NudgeSessionContext(ctx, sessionName, sent, again)
+		}
+		return t.SendKeysRaw(sessionName, "Enter")
+	}
+
+	lines := before
+	lastContent := beforeContent
+	lastChange := time.Now()
+	poll := newPollBackoff(opts.PollInterval, opts.MaxPoll)
+
+	for time.Now().Before(deadline) {
+		next := deadline
+		if lastContent != beforeContent {
+			next = lastChange.Add(opts.StableFor)
+		} else if due := lastSend.Add(opts.FirstResponseGrace); renudgesLeft > 0 && due.Before(next) {
+			next = due
+		}
+		if !sleepContext(ctx, poll.wait(time.Until(next))) {
+			break
+		}
+
+		captured, err := window.lines(isAnchored)
+		if err != nil {
+			if ctx.Err() != nil {
+				break // keep the last good capture for the partial result
+			}
+			return nil, fmt.Errorf("capturing output: %w", err)
+		}
+		lines = captured
+		content := strings.Join(lines, "\n")
+
+		if content != lastContent {
+			lastContent = content
+			lastChange = time.Now()
+			poll.reset()
+			response, _, complete := extract(lines)
+			if complete {
+				return finish(lines, true), nil
+			}
+			if onLines != nil {
+				onLines(stream.next(response, false))
+			}
+			continue
+		}
+
+		if content != beforeContent && time.Since(lastChange) >= opts.StableFor {
+			return finish(lines, true), nil
+		}
+		if content == beforeContent && renudgesLeft > 0 && time.Since(lastSend) >= opts.FirstResponseGrace {
+			renudgesLeft--
+			if err := renudge(); err != nil {
+				if ctx.Err() != nil {
+					break
+				}
+				return nil, fmt.Errorf("re-nudging: %w", err)
+			}
+			poll.reset()
+		}
+	}
+
+	result = finish(lines, false)
+	if err := ctx.Err(); err != nil {
+		return result, fmt.Errorf("waiting for response: %w", err)
+	}
+	if !result.Responded {
+		return result, fmt.Errorf("%w after %s", ErrNoResponse, opts.Timeout)
+	}
+	return result, fmt.Errorf("%w after %s", ErrResponseTimeout, opts.Timeout)
+}
+
+// renudgeMode returns m, or RenudgeEnter if m is empty.
+func renudgeMode(m RenudgeMode) RenudgeMode {
+	if m == "" {
+		return RenudgeEnter
+	}
+	return m
+}
+
+// pollBackoff paces the chat poll loop: the delay starts at min and doubles
+// after every poll up to max, until reset when the pane changes.
+type pollBackoff struct {
+	min, max, cur time.Duration
+}
+
+func newPollBackoff(initial, limit time.Duration) *pollBackoff {
+	if limit < initial {
+		limit = initial
+	}
+	return &pollBackoff{min: initial, max: limit, cur: initial}
+}
+
+// wait returns the delay before the next poll and backs off for the one
+// after. The delay is cut short to untilDue (when stabilization or the
+// timeout is due) so pacing never adds latency, though it stays positive.
+func (b *pollBackoff) wait(untilDue time.Duration) time.Duration {
+	d := b.cur
+	if b.cur *= 2; b.cur > b.max {
+		b.cur = b.max
+	}
+	if untilDue < d {
+		d = untilDue
+	}
+	if d < time.Millisecond {
+		d = time.Millisecond
+	}
+	return d
+}
+
+// reset returns to the shortest delay after new output.
+func (b *pollBackoff) reset() {
+	b.cur = b.min
+}
+
+// JoinWrappedLines re-joins rows that tmux wrapped at the pane width. A row
+// whose display width reaches cols continues on the next row. Cleaning
+// right-trims rows, so a row one cell short is assumed to have wrapped at a
+// space, and the join puts that space back.
+func JoinWrappedLines(lines []string, cols int) []string {
+	var joined []string
+	var current strings.Builder
+	for i, line := range lines {
+		current.WriteString(line)
+		width := lipgloss.Width(line)
+		wrapped := width >= cols-1 && i+1 < len(lines) && strings.TrimSpace(lines[i+1]) != ""
+		if !wrapped {
+			joined = append(joined, current.String())
+			current.Reset()
+			continue
+		}
+		if width < cols {
+			current.WriteString(" ")
+		}
+	}
+	return joined
+}
+
+// captureWindow is the pane capture window for one exchange.
+//
+// With since set, each capture holds exactly the output written after the
+// pre-send mark (tmux.CapturePaneSince). Otherwise, or once since reports
+// the mark lost to trimmed history, the window is the pane's tail: it starts
+// at CaptureLines and doubles whenever a full capture no longer
+// contains the response anchor (the echoed message or sentinel
+// instruction), so a long reply can't scroll the anchor out of view.
+type captureWindow struct {
+	since   func() (lines []string, truncated bool, err error)
+	capture func(n int) ([]string, error)
+	size    int
+	// shift counts the older lines the window has gained since the pre-send
+	// capture, keeping the beforeLen fallback pointed at the same row.
+	shift int
+}
+
+// lines captures the window. Tail windows are widened until anchored
+// reports the anchor is in view, the pane has no more history, or the size
+// cap is reached; a nil anchored never widens.
+func (w *captureWindow) lines(anchored func([]string) bool) ([]string, error) {
+	if w.since != nil {
+		lines, truncated, err := w.since()
+		if err != nil || !truncated {
+			return lines, err
+		}
+		w.since = nil // history was trimmed past the mark; use the tail
+	}
+	if anchored == nil {
+		anchored = func([]string) bool { return true }
+	}
+	lines, err := w.capture(w.size)
+	for err == nil && w.full(lines) && w.size < MaxCaptureLines && !anchored(lines) {
+		w.size = min(w.size*2, MaxCaptureLines)
+		var wider []string
+		if wider, err = w.capture(w.size); err == nil {
+			w.shift += len(wider) - len(lines)
+			lines = wider
+		}
+	}
+	return lines, err
+}
+
+// full reports whether a capture filled the window, meaning older output
+// may have been cut off. A capture since the mark is never cut off.
+func (w *captureWindow) full(lines []string) bool {
+	return w.since == nil && len(lines) >= w.size
+}
+
+// start returns the row of a capture where output after the send begins,
+// given the pre-send line count of the tail window.
+func (w *captureWindow) start(beforeLen int) int {
+	if w.since != nil {
+		return 0
+	}
+	return beforeLen + w.shift
+}
+
+// CaptureWithRetry captures the last n pane lines, retrying transient
+// failures as retryCapture does.
+func CaptureWithRetry(ctx context.Context, t *tmux.Tmux, sessionName string, n int, opts Options) ([]string, error) {
+	var lines []string
+	err := retryCapture(ctx, opts, func() (err error) {
+		lines, err = t.CapturePaneLinesContext(ctx, sessionName, n)
+		return err
+	})
+	return lines, err
+}
+
+// retryCapture runs a pane capture, retrying transient failures up to
+// opts.Retries times with exponential backoff. A missing session or tmux
+// server is not transient and fails immediately, as does a cancelled ctx.
+func retryCapture(ctx context.Context, opts Options, capture func() error) error {
+	backoff := retryBackoff
+	for attempt := 0; ; attempt++ {
+		err := capture()
+		if err == nil {
+			return nil
+		}
+		if attempt >= opts.Retries || errors.Is(err, tmux.ErrSessionNotFound) || errors.Is(err, tmux.ErrNoServer) || ctx.Err() != nil {
+			return err
+		}
+		if opts.Logger != nil {
+			opts.Logger("capture failed (%v), retrying in %s (%d/%d)", err, backoff, attempt+1, opts.Retries)
+		}
+		if !sleepContext(ctx, backoff) {
+			return err
+		}
+		backoff *= 2
+	}
+}
+
+// sleepContext waits for d or until ctx is done, reporting whether the full
+// wait elapsed.
+func sleepContext(ctx context.Context, d time.Duration) bool {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+		return true
+	case <-ctx.Done():
+		return false
+	}
+}
+
+// streamEmitter tracks how many response lines have already been emitted so
+// each poll only yields the delta.
+type streamEmitter struct {
+	emitted int
+}
+
+// next returns the response lines not yet emitted. Unless final is set, the
+// last line is withheld because it may still be partially rendered; emitting
+// it now would print it twice once it completes.
+func (s *streamEmitter) next(response []string, final bool) []string {
+	ready := len(response)
+	if !final {
+		ready--
+	}
+	if ready <= s.emitted {
+		return nil
+	}
+	delta := response[s.emitted:ready]
+	s.emitted = ready
+	return delta
+}
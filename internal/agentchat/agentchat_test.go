@@ -0,0 +1,374 @@
+package agentchat
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/steveyegge/gastown/internal/tmux"
+)
+
+func TestStreamEmitter_WithholdsTrailingLineUntilFinal(t *testing.T) {
+	var s streamEmitter
+
+	// First poll: "Hel" may still be mid-render, so only the first line is emitted.
+	if got := s.next([]string{"line one", "Hel"}, false); !reflect.DeepEqual(got, []string{"line one"}) {
+		t.Errorf("poll 1 = %v, want [line one]", got)
+	}
+
+	// Second poll: the partial line completed and a new one started.
+	if got := s.next([]string{"line one", "Hello", "wor"}, false); !reflect.DeepEqual(got, []string{"Hello"}) {
+		t.Errorf("poll 2 = %v, want [Hello]", got)
+	}
+
+	// No change: nothing new to emit.
+	if got := s.next([]string{"line one", "Hello", "wor"}, false); got != nil {
+		t.Errorf("poll 3 = %v, want nil", got)
+	}
+
+	// Final flush emits the held-back line exactly once.
+	if got := s.next([]string{"line one", "Hello", "world"}, true); !reflect.DeepEqual(got, []string{"world"}) {
+		t.Errorf("final = %v, want [world]", got)
+	}
+	if got := s.next([]string{"line one", "Hello", "world"}, true); got != nil {
+		t.Errorf("after final = %v, want nil", got)
+	}
+}
+
+func TestCaptureWindow_LongResponseKeepsAnchor(t *testing.T) {
+	// Simulated pane: older history, the echoed prompt, a 300-line reply,
+	// then the input box. The reply alone overflows the first 100-line window.
+	pane := []string{"earlier output", "more history", "❯ dump the full log"}
+	for i := 1; i <= 300; i++ {
+		pane = append(pane, fmt.Sprintf("reply line %d", i))
+	}
+	pane = append(pane, "────────────────", "❯ ", "────────────────")
+
+	var sizes []int
+	w := &captureWindow{
+		size: CaptureLines,
+		capture: func(n int) ([]string, error) {
+			sizes = append(sizes, n)
+			if n >= len(pane) {
+				return pane, nil
+			}
+			return pane[len(pane)-n:], nil
+		},
+	}
+	anchored := func(lines []string) bool {
+		_, method, _ := Extract(lines, 0, "dump the full log", "")
+		return method == MethodAnchor
+	}
+
+	lines, err := w.lines(anchored)
+	if err != nil {
+		t.Fatalf("lines() error = %v", err)
+	}
+	if want := []int{100, 200, 400}; !reflect.DeepEqual(sizes, want) {
+		t.Errorf("capture sizes = %v, want %v", sizes, want)
+	}
+	if w.full(lines) {
+		t.Error("full() = true after capturing all history")
+	}
+	if w.shift != len(pane)-CaptureLines {
+		t.Errorf("shift = %d, want %d", w.shift, len(pane)-CaptureLines)
+	}
+
+	response, method, _ := Extract(lines, 0, "dump the full log", "")
+	if method != MethodAnchor {
+		t.Fatal("echoed message not found after widening")
+	}
+	if len(response) != 300 || response[0] != "reply line 1" || response[299] != "reply line 300" {
+		t.Errorf("response = %d lines (%q … %q), want reply lines 1-300", len(response), response[0], response[len(response)-1])
+	}
+
+	// Once anchored, later polls keep the wider window without re-growing.
+	sizes = nil
+	if _, err := w.lines(anchored); err != nil {
+		t.Fatalf("second lines() error = %v", err)
+	}
+	if want := []int{400}; !reflect.DeepEqual(sizes, want) {
+		t.Errorf("second poll capture sizes = %v, want %v", sizes, want)
+	}
+}
+
+func TestCaptureWindow_SinceMarkFallsBackWhenTruncated(t *testing.T) {
+	tail := []string{"❯ hi", "  also an older hi", "⏺ hello"}
+	truncated := false
+	captures := 0
+	w := &captureWindow{
+		size: CaptureLines,
+		since: func() ([]string, bool, error) {
+			if truncated {
+				return []string{"whole", "history"}, true, nil
+			}
+			return []string{"❯ hi", "⏺ hello"}, false, nil
+		},
+		capture: func(n int) ([]string, error) {
+			captures++
+			return tail, nil
+		},
+	}
+
+	lines, err := w.lines(nil)
+	if err != nil {
+		t.Fatalf("lines() error = %v", err)
+	}
+	if captures != 0 || len(lines) != 2 || w.full(lines) || w.start(5) != 0 {
+		t.Errorf("since capture = %q (tail captures %d, full %v, start %d); want the since lines from row 0",
+			lines, captures, w.full(lines), w.start(5))
+	}
+
+	// Once tmux has trimmed history past the mark, the tail window takes over
+	// for good, with the caller's pre-send line count as the fallback start.
+	truncated = true
+	lines, err = w.lines(nil)
+	if err != nil {
+		t.Fatalf("lines() after truncation error = %v", err)
+	}
+	if !reflect.DeepEqual(lines, tail) || w.since != nil || w.start(1) != 1 {
+		t.Errorf("after truncation: lines = %q, since cleared = %v, start = %d; want the tail window", lines, w.since == nil, w.start(1))
+	}
+}
+
+func TestPollBackoff(t *testing.T) {
+	const far = time.Hour
+	b := newPollBackoff(100*time.Millisecond, time.Second)
+	var got []time.Duration
+	for i := 0; i < 6; i++ {
+		got = append(got, b.wait(far))
+	}
+	want := []time.Duration{100, 200, 400, 800, 1000, 1000}
+	for i := range want {
+		want[i] *= time.Millisecond
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("quiet delays = %v, want %v", got, want)
+	}
+
+	// New output resets to the initial delay.
+	b.reset()
+	if d := b.wait(far); d != 100*time.Millisecond {
+		t.Errorf("delay after reset = %s, want 100ms", d)
+	}
+
+	// A poll due sooner (stabilization or timeout) cuts the delay short
+	// without disturbing the backoff.
+	if d := b.wait(50 * time.Millisecond); d != 50*time.Millisecond {
+		t.Errorf("delay with poll due in 50ms = %s, want 50ms", d)
+	}
+	if d := b.wait(0); d != time.Millisecond {
+		t.Errorf("delay with poll overdue = %s, want the 1ms floor", d)
+	}
+	if d := b.wait(far); d != 800*time.Millisecond {
+		t.Errorf("delay after shortened waits = %s, want backoff to have continued to 800ms", d)
+	}
+
+	// Without a max above the initial delay, polling is fixed-rate.
+	fixed := newPollBackoff(500*time.Millisecond, 0)
+	for i := 0; i < 3; i++ {
+		if d := fixed.wait(far); d != 500*time.Millisecond {
+			t.Fatalf("fixed-rate delay %d = %s, want 500ms", i, d)
+		}
+	}
+}
+
+func TestJoinWrappedLines(t *testing.T) {
+	tests := []struct {
+		name  string
+		lines []string
+		want  []string
+	}{
+		{
+			name:  "hard wrap mid-word",
+			lines: []string{"⏺ The gastown rig is heal", "thy and idle."},
+			want:  []string{"⏺ The gastown rig is healthy and idle."},
+		},
+		{
+			name:  "wrap at a trimmed space",
+			lines: []string{"⏺ The gastown rig is now", "healthy and idle."},
+			want:  []string{"⏺ The gastown rig is now healthy and idle."},
+		},
+		{
+			name:  "short lines untouched",
+			lines: []string{"⏺ Done.", "", "Next steps:"},
+			want:  []string{"⏺ Done.", "", "Next steps:"},
+		},
+		{
+			name:  "full row before blank line",
+			lines: []string{"⏺ The gastown rig is heal", ""},
+			want:  []string{"⏺ The gastown rig is heal", ""},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := JoinWrappedLines(tt.lines, 25); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("JoinWrappedLines() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSendAndCapture_TimeoutKinds(t *testing.T) {
+	if _, err := exec.LookPath("tmux"); err != nil {
+		t.Skip("tmux not installed")
+	}
+	socket := fmt.Sprintf("gt-test-chat-%d", os.Getpid())
+	tm := tmux.NewTmuxWithSocket(socket)
+	t.Cleanup(func() { _ = tm.KillServer() })
+
+	opts := Options{
+		Timeout:      2 * time.Second,
+		PollInterval: 50 * time.Millisecond,
+		MaxPoll:      100 * time.Millisecond,
+		StableFor:    time.Second,
+		Retries:      1,
+	}
+	tests := []struct {
+		name    string
+		command string
+		want    error
+	}{
+		// Input isn't echoed, and Enter only redraws the first row, above
+		// the cursor where the send was marked: nothing new is written.
+		{"silent", `stty -echo; printf 'ready\n'; while read -r l; do printf '\033[s\033[1;1Hgot it\033[u'; done`, ErrNoResponse},
+		// Output keeps changing faster than --stable-for.
+		{"busy", "stty -echo; while :; do date +%s%N; sleep 0.1; done", ErrResponseTimeout},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			session := "gt-test-chat-" + tt.name
+			if err := tm.NewSessionWithCommand(session, t.TempDir(), tt.command); err != nil {
+				t.Fatalf("NewSessionWithCommand: %v", err)
+			}
+			t.Cleanup(func() { _ = tm.KillSession(session) })
+			time.Sleep(300 * time.Millisecond)
+
+			result, err := SendAndCapture(tm, session, "hello", opts)
+			if !errors.Is(err, tt.want) {
+				t.Fatalf("err = %v, want %v", err, tt.want)
+			}
+			if result == nil || result.Stabilized {
+				t.Fatalf("result = %+v, want a partial unstabilized result", result)
+			}
+			if result.Responded != (tt.want == ErrResponseTimeout) {
+				t.Errorf("Responded = %v", result.Responded)
+			}
+		})
+	}
+}
+
+func TestSendAndCaptureContext_CancelEndsPoll(t *testing.T) {
+	if _, err := exec.LookPath("tmux"); err != nil {
+		t.Skip("tmux not installed")
+	}
+	socket := fmt.Sprintf("gt-test-chatctx-%d", os.Getpid())
+	tm := tmux.NewTmuxWithSocket(socket)
+	t.Cleanup(func() { _ = tm.KillServer() })
+
+	session := "gt-test-chatctx"
+	if err := tm.NewSessionWithCommand(session, t.TempDir(), "stty -echo; while :; do date +%s%N; sleep 0.1; done"); err != nil {
+		t.Fatalf("NewSessionWithCommand: %v", err)
+	}
+	time.Sleep(300 * time.Millisecond)
+
+	// The output never settles, so only the cancel can end the wait.
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+	defer cancel()
+	opts := Options{Timeout: time.Minute, PollInterval: 50 * time.Millisecond, MaxPoll: 100 * time.Millisecond, StableFor: time.Second, Retries: 1}
+	start := time.Now()
+	result, err := SendAndCaptureContext(ctx, tm, session, "hello", opts)
+
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("err = %v, want context.DeadlineExceeded", err)
+	}
+	if elapsed := time.Since(start); elapsed > 10*time.Second {
+		t.Errorf("returned after %s, want soon after the 3s cancel", elapsed)
+	}
+	if result == nil || result.Stabilized || !result.Responded {
+		t.Errorf("result = %+v, want a partial unstabilized result with output", result)
+	}
+}
+
+func TestSendAndCaptureContext_CancelledBeforeSend(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	// No tmux server is needed: the cancelled context stops the first capture.
+	result, err := SendAndCaptureContext(ctx, tmux.NewTmuxWithSocket("gt-test-chatctx-none"), "gt-test-none", "hello", Options{Timeout: time.Second, Retries: 3})
+	if !errors.Is(err, context.Canceled) || result != nil {
+		t.Errorf("SendAndCaptureContext() = %+v, %v, want nil and context.Canceled", result, err)
+	}
+}
+
+func TestSendAndCapture_RenudgeAfterGrace(t *testing.T) {
+	if _, err := exec.LookPath("tmux"); err != nil {
+		t.Skip("tmux not installed")
+	}
+	socket := fmt.Sprintf("gt-test-renudge-%d", os.Getpid())
+	tm := tmux.NewTmuxWithSocket(socket)
+	t.Cleanup(func() { _ = tm.KillServer() })
+
+	// The first message only redraws the top row, as a TUI that swallowed
+	// it might (enough for the send's Enter check, but no new output);
+	// every later line gets a reply.
+	const command = `stty -echo; printf 'ready\n'; read -r l; printf '\033[s\033[1;1Hgot it\033[u'; while read -r l; do printf 'reply\n'; done`
+	tests := []struct {
+		name     string
+		renudges int
+		mode     RenudgeMode
+		want     error
+	}{
+		{"off", 0, "", ErrNoResponse},
+		{"enter", 1, RenudgeEnter, nil},
+		{"message", 1, RenudgeMessage, nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			session := "gt-test-renudge-" + tt.name
+			if err := tm.NewSessionWithCommand(session, t.TempDir(), command); err != nil {
+				t.Fatalf("NewSessionWithCommand: %v", err)
+			}
+			t.Cleanup(func() { _ = tm.KillSession(session) })
+			time.Sleep(300 * time.Millisecond)
+
+			opts := Options{
+				Timeout:            6 * time.Second,
+				PollInterval:       50 * time.Millisecond,
+				MaxPoll:            100 * time.Millisecond,
+				StableFor:          500 * time.Millisecond,
+				Retries:            1,
+				FirstResponseGrace: time.Second,
+				Renudges:           tt.renudges,
+				Renudge:            tt.mode,
+			}
+			result, err := SendAndCapture(tm, session, "hello", opts)
+			if !errors.Is(err, tt.want) {
+				t.Fatalf("err = %v, want %v", err, tt.want)
+			}
+			if result.Renudged != tt.renudges {
+				t.Errorf("Renudged = %d, want %d", result.Renudged, tt.renudges)
+			}
+			if tt.want == nil && !strings.Contains(result.Response, "reply") {
+				t.Errorf("Response = %q, want the reply to the re-nudge", result.Response)
+			}
+		})
+	}
+}
+
+func TestParseRenudgeMode(t *testing.T) {
+	for name, want := range map[string]RenudgeMode{"": RenudgeEnter, "enter": RenudgeEnter, "message": RenudgeMessage} {
+		if got, err := ParseRenudgeMode(name); err != nil || got != want {
+			t.Errorf("ParseRenudgeMode(%q) = %q, %v, want %q", name, got, err, want)
+		}
+	}
+	if _, err := ParseRenudgeMode("twice"); err == nil {
+		t.Error("ParseRenudgeMode(twice) succeeded, want an error")
+	}
+}
@@ -0,0 +1,74 @@
+package agentchat
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"sync"
+)
+
+// defaultArtifactPatterns match Claude Code's UI chrome in a captured pane.
+// SetArtifactPatterns adds to them for other agent CLIs.
+var defaultArtifactPatterns = []string{
+	`^❯`,                 // input prompt
+	`⏵⏵`,                 // permission mode in the status bar
+	`bypass permissions`, // likewise
+	`esc to interrupt`,   // busy indicator
+	`\? for shortcuts`,   // shortcut hint
+	`^[─━╭╮╰╯│ ]+$`,      // rules and borders drawn around the prompt box
+}
+
+var (
+	artifactsMu  sync.RWMutex
+	artifacts, _ = compileArtifactPatterns(nil)
+)
+
+// SetArtifactPatterns replaces the extra patterns IsUIArtifact checks after
+// the built-in ones. Patterns that don't compile are left out and reported
+// in the returned error; the rest still take effect.
+func SetArtifactPatterns(custom []string) error {
+	compiled, err := compileArtifactPatterns(custom)
+	artifactsMu.Lock()
+	artifacts = compiled
+	artifactsMu.Unlock()
+	return err
+}
+
+// IsUIArtifact reports whether a captured line is agent UI chrome rather
+// than response content. Patterns are matched against the line with
+// surrounding whitespace trimmed; blank lines are never artifacts.
+func IsUIArtifact(line string) bool {
+	trimmed := strings.TrimSpace(line)
+	if trimmed == "" {
+		return false
+	}
+	artifactsMu.RLock()
+	defer artifactsMu.RUnlock()
+	for _, re := range artifacts {
+		if re.MatchString(trimmed) {
+			return true
+		}
+	}
+	return false
+}
+
+// compileArtifactPatterns returns the defaults followed by custom.
+func compileArtifactPatterns(custom []string) ([]*regexp.Regexp, error) {
+	compiled := make([]*regexp.Regexp, 0, len(defaultArtifactPatterns)+len(custom))
+	for _, p := range defaultArtifactPatterns {
+		compiled = append(compiled, regexp.MustCompile(p))
+	}
+	var bad []string
+	for _, p := range custom {
+		re, err := regexp.Compile(p)
+		if err != nil {
+			bad = append(bad, fmt.Sprintf("%q", p))
+			continue
+		}
+		compiled = append(compiled, re)
+	}
+	if len(bad) > 0 {
+		return compiled, fmt.Errorf("ignoring invalid artifact patterns: %s", strings.Join(bad, ", "))
+	}
+	return compiled, nil
+}
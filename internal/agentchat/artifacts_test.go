@@ -0,0 +1,63 @@
+package agentchat
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestIsUIArtifact(t *testing.T) {
+	tests := []struct {
+		line string
+		want bool
+	}{
+		{"❯ ", true},
+		{"──────────", true},
+		{"  ⏵⏵ bypass permissions on (shift+tab to cycle)", true},
+		{"✻ Thinking… (esc to interrupt)", true},
+		{"  ? for shortcuts", true},
+		{"", false},
+		{"⏺ Here is the answer", false},
+		{"plain text", false},
+	}
+	for _, tt := range tests {
+		if got := IsUIArtifact(tt.line); got != tt.want {
+			t.Errorf("IsUIArtifact(%q) = %v, want %v", tt.line, got, tt.want)
+		}
+	}
+}
+
+func TestSetArtifactPatterns_CustomPatternStripsLine(t *testing.T) {
+	if err := SetArtifactPatterns([]string{`^>\s*$`, `tokens used`}); err != nil {
+		t.Fatalf("SetArtifactPatterns() error = %v", err)
+	}
+	t.Cleanup(func() { _ = SetArtifactPatterns(nil) })
+
+	lines := []string{
+		"⏺ The gastown rig is healthy.",
+		"  12.3k tokens used · ctrl+c to quit",
+		">",
+		"──────────",
+		"Two polecats are working.",
+	}
+	want := []string{
+		"⏺ The gastown rig is healthy.",
+		"Two polecats are working.",
+	}
+	if got := CleanLines(lines); !reflect.DeepEqual(got, want) {
+		t.Errorf("CleanLines() = %q, want %q", got, want)
+	}
+}
+
+func TestSetArtifactPatterns_SkipsInvalid(t *testing.T) {
+	err := SetArtifactPatterns([]string{`(unclosed`, `status: idle`})
+	t.Cleanup(func() { _ = SetArtifactPatterns(nil) })
+	if err == nil {
+		t.Fatal("SetArtifactPatterns() error = nil, want one for the invalid pattern")
+	}
+	if !IsUIArtifact("status: idle") {
+		t.Error("valid pattern next to an invalid one was not applied")
+	}
+	if IsUIArtifact("(unclosed") {
+		t.Error("invalid pattern matched")
+	}
+}
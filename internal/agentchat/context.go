@@ -0,0 +1,58 @@
+package agentchat
+
+import "strings"
+
+// Tags around the summary WithContext puts before a message. They let the
+// summary be stripped from the response, whether it shows up in the echoed
+// prompt or the agent quotes it back.
+const (
+	contextOpen  = "<gt-context>"
+	contextClose = "</gt-context>"
+)
+
+// WithContext puts a rendered summary, tagged, in front of a message.
+func WithContext(message, summary string) string {
+	if summary == "" {
+		return message
+	}
+	return contextOpen + summary + contextClose + " " + message
+}
+
+// StripContext removes tagged context from captured lines, including a
+// summary wrapped across several rows. Rows left blank by the removal are
+// dropped; rows that were already blank are kept.
+func StripContext(lines []string) []string {
+	out := make([]string, 0, len(lines))
+	inside := false
+	for _, line := range lines {
+		removed := inside
+		var kept strings.Builder
+		rest := line
+		for rest != "" {
+			if inside {
+				i := strings.Index(rest, contextClose)
+				if i < 0 {
+					break
+				}
+				rest, inside = rest[i+len(contextClose):], false
+				continue
+			}
+			i := strings.Index(rest, contextOpen)
+			if i < 0 {
+				kept.WriteString(rest)
+				break
+			}
+			kept.WriteString(rest[:i])
+			rest, inside, removed = rest[i+len(contextOpen):], true, true
+		}
+		if removed && strings.TrimSpace(kept.String()) == "" {
+			continue
+		}
+		if removed {
+			out = append(out, strings.TrimRight(kept.String(), " \t"))
+		} else {
+			out = append(out, line)
+		}
+	}
+	return out
+}
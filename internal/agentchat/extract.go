@@ -0,0 +1,207 @@
+package agentchat
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+)
+
+// Method names how a response was found in the pane capture, from most to
+// least precise. It is reported as Result.Extraction.
+type Method string
+
+const (
+	// MethodSentinel: between the echoed sentinel instruction and the end
+	// marker.
+	MethodSentinel Method = "sentinel"
+	// MethodAnchor: after the last line echoing the sent message.
+	MethodAnchor Method = "anchor"
+	// MethodBaseline: after the lines that were in the pane before the
+	// send.
+	MethodBaseline Method = "baseline"
+)
+
+// Extract returns the cleaned response in a pane capture, found with the
+// most precise method that works on it. With a sentinel, the slice between
+// the echoed instruction and the end marker is preferred; agent UIs that
+// strip or reformat the injected marker fall back to anchoring on the echoed
+// message, and failing that to the beforeLen rows that preceded the send.
+// Lines from the end marker on are dropped whichever method is used, and
+// complete reports whether it has appeared.
+func Extract(lines []string, beforeLen int, message, sentinel string) (response []string, method Method, complete bool) {
+	lines = renderCarriageReturns(lines)
+	loc := Locate(lines, beforeLen, message, sentinel)
+	return CleanLines(lines[loc.Start:loc.End]), loc.Method, loc.Complete
+}
+
+// Location is where Extract finds a response in a capture: the rows
+// lines[Start:End], before cleaning.
+type Location struct {
+	Start    int
+	End      int
+	Method   Method
+	Complete bool // the end marker was found
+}
+
+// Locate returns where Extract would find the response in lines, without
+// cleaning it, so a bad extraction can be diagnosed. Rows are indexed as
+// captured; carriage returns never change the row count.
+func Locate(lines []string, beforeLen int, message, sentinel string) Location {
+	lines = renderCarriageReturns(lines)
+	loc := Location{End: len(lines)}
+	if sentinel != "" {
+		for i := len(lines) - 1; i >= 0; i-- {
+			if isSentinelLine(lines[i], sentinel) {
+				loc.End = i
+				loc.Complete = true
+				break
+			}
+		}
+		for i := loc.End - 1; i >= 0; i-- {
+			if strings.Contains(lines[i], sentinel) {
+				loc.Start, loc.Method = i+1, MethodSentinel
+				return loc
+			}
+		}
+	}
+
+	loc.Start, loc.Method = beforeLen, MethodBaseline
+	if anchor := messageAnchor(message); anchor != "" {
+		for i := loc.End - 1; i >= 0; i-- {
+			if col := strings.Index(lines[i], anchor); col >= 0 {
+				loc.Start, loc.Method = echoEnd(lines[:loc.End], i, col, message), MethodAnchor
+				break
+			}
+		}
+	}
+	if loc.Start > loc.End {
+		loc.Start = loc.End
+	}
+	return loc
+}
+
+// sentinelPrefix begins every end marker NewSentinel returns.
+const sentinelPrefix = "<<GT-END:"
+
+// NewSentinel returns a unique end marker such as <<GT-END:1a2b3c4d>>.
+// The ID is short so the echoed instruction is unlikely to wrap mid-marker.
+func NewSentinel() string {
+	return sentinelPrefix + strings.ReplaceAll(uuid.NewString(), "-", "")[:8] + ">>"
+}
+
+// WithSentinelInstruction appends the end-marker instruction to a message.
+// It stays on the same line because a newline in send-keys would submit the
+// prompt early.
+func WithSentinelInstruction(message, sentinel string) string {
+	return fmt.Sprintf("%s (When your reply is complete, end it with a line containing only %s)", message, sentinel)
+}
+
+// isSentinelLine reports whether a line is the agent printing the end marker
+// on its own, allowing for a leading response bullet.
+func isSentinelLine(line, sentinel string) bool {
+	trimmed := strings.TrimSpace(line)
+	trimmed = strings.TrimSpace(strings.TrimPrefix(trimmed, "⏺"))
+	return trimmed == sentinel
+}
+
+// messageAnchor returns the text used to locate the echoed message in the
+// pane: its first line, truncated so a wrapped prompt still matches.
+func messageAnchor(message string) string {
+	first := strings.TrimSpace(strings.SplitN(message, "\n", 2)[0])
+	const maxAnchor = 40
+	if len([]rune(first)) > maxAnchor {
+		first = string([]rune(first)[:maxAnchor])
+	}
+	return first
+}
+
+// echoEnd returns the row after the echoed message that starts on row i at
+// byte col. An echo that wrapped at the pane width, or a multi-line message,
+// continues on the rows below; they are followed for as long as the text
+// they add is the next part of the message. Whitespace is compared
+// collapsed, and a row may continue the previous one mid-word.
+func echoEnd(lines []string, i, col int, message string) int {
+	want := strings.Join(strings.Fields(message), " ")
+	echoed := strings.Join(strings.Fields(lines[i][col:]), " ")
+	j := i + 1
+	for ; j < len(lines) && len(echoed) < len(want); j++ {
+		next := strings.Join(strings.Fields(lines[j]), " ")
+		if next == "" {
+			break
+		}
+		if spaced := echoed + " " + next; strings.HasPrefix(want, spaced) {
+			echoed = spaced
+		} else if joined := echoed + next; strings.HasPrefix(want, joined) {
+			echoed = joined
+		} else {
+			break
+		}
+	}
+	return j
+}
+
+// CleanLines renders carriage-return redraws, drops tagged context
+// summaries (see WithContext) and agent UI artifacts, right-trims each line,
+// and trims leading and trailing blank lines.
+func CleanLines(lines []string) []string {
+	cleaned := make([]string, 0, len(lines))
+	for _, line := range StripContext(renderCarriageReturns(lines)) {
+		if IsUIArtifact(line) {
+			continue
+		}
+		cleaned = append(cleaned, strings.TrimRight(line, " \t"))
+	}
+
+	for len(cleaned) > 0 && strings.TrimSpace(cleaned[0]) == "" {
+		cleaned = cleaned[1:]
+	}
+	for len(cleaned) > 0 && strings.TrimSpace(cleaned[len(cleaned)-1]) == "" {
+		cleaned = cleaned[:len(cleaned)-1]
+	}
+	return cleaned
+}
+
+// renderCarriageReturns returns lines as a terminal would show them. A CR
+// ending a line (CRLF input) is dropped. A CR inside a line, as a spinner or
+// progress bar uses to redraw itself, returns to the start of the line: the
+// text after it overwrites what was there, and any longer remainder of the
+// earlier text stays visible. Columns are counted in runes, which matches
+// the single-width text agent UIs redraw. Lines without a CR are returned
+// as they are.
+func renderCarriageReturns(lines []string) []string {
+	var out []string
+	for i, line := range lines {
+		if !strings.Contains(line, "\r") {
+			if out != nil {
+				out = append(out, line)
+			}
+			continue
+		}
+		if out == nil {
+			out = make([]string, i, len(lines))
+			copy(out, lines[:i])
+		}
+		out = append(out, renderLine(strings.TrimRight(line, "\r")))
+	}
+	if out == nil {
+		return lines
+	}
+	return out
+}
+
+// renderLine overlays each CR-separated segment of line onto the ones
+// before it.
+func renderLine(line string) string {
+	var screen []rune
+	for _, segment := range strings.Split(line, "\r") {
+		for col, r := range []rune(segment) {
+			if col < len(screen) {
+				screen[col] = r
+			} else {
+				screen = append(screen, r)
+			}
+		}
+	}
+	return string(screen)
+}
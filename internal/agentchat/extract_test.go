@@ -0,0 +1,357 @@
+package agentchat
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestExtract_AnchorsOnEchoedMessage(t *testing.T) {
+	lines := []string{
+		"earlier output",
+		"❯ what is the status?",
+		"",
+		"⏺ All rigs are healthy.",
+		"",
+		"────────────────",
+		"❯ ",
+		"────────────────",
+		"  ⏵⏵ bypass permissions on",
+	}
+	got, method, _ := Extract(lines, 1, "what is the status?", "")
+	want := []string{"⏺ All rigs are healthy."}
+	if !reflect.DeepEqual(got, want) || method != MethodAnchor {
+		t.Errorf("Extract() = %q (%s), want %q (anchor)", got, method, want)
+	}
+}
+
+func TestExtract_Sentinel(t *testing.T) {
+	const sentinel = "<<GT-END:1a2b3c4d>>"
+	echo := "❯ list rigs " + "(When your reply is complete, end it with a line containing only " + sentinel + ")"
+
+	tests := []struct {
+		name         string
+		lines        []string
+		want         []string
+		wantComplete bool
+	}{
+		{
+			name:         "complete",
+			lines:        []string{"old", echo, "", "⏺ gastown", "  beads", "⏺ " + sentinel, "", "❯ "},
+			want:         []string{"⏺ gastown", "  beads"},
+			wantComplete: true,
+		},
+		{
+			name:  "in progress",
+			lines: []string{"old", echo, "", "⏺ gastown", "✻ Working… (esc to interrupt)"},
+			want:  []string{"⏺ gastown"},
+		},
+		{
+			name: "echo wrapped across lines",
+			lines: []string{
+				"❯ list rigs (When your reply is complete, end it with a line",
+				"  containing only " + sentinel + ")",
+				"⏺ gastown",
+				sentinel,
+			},
+			want:         []string{"⏺ gastown"},
+			wantComplete: true,
+		},
+		{
+			name:         "echo missing falls back to message anchor",
+			lines:        []string{"❯ list rigs (When your reply is", "⏺ gastown", sentinel},
+			want:         []string{"⏺ gastown"},
+			wantComplete: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, _, complete := Extract(tt.lines, 0, "list rigs", sentinel)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("response = %q, want %q", got, tt.want)
+			}
+			if complete != tt.wantComplete {
+				t.Errorf("complete = %v, want %v", complete, tt.wantComplete)
+			}
+		})
+	}
+}
+
+func TestExtract_Methods(t *testing.T) {
+	sentinel := "<<GT-END:feed0001>>"
+	echo := "❯ " + WithSentinelInstruction("list rigs", sentinel)
+	tests := []struct {
+		name       string
+		lines      []string
+		beforeLen  int
+		sentinel   string
+		want       []string
+		wantMethod Method
+		wantStart  int
+	}{
+		{
+			name:       "sentinel slice",
+			lines:      []string{"old", echo, "⏺ gastown", sentinel},
+			sentinel:   sentinel,
+			want:       []string{"⏺ gastown"},
+			wantMethod: MethodSentinel,
+			wantStart:  2,
+		},
+		{
+			// The UI dropped the injected instruction and marker from the echo.
+			name:       "marker stripped falls back to message anchor",
+			lines:      []string{"old", "❯ list rigs", "⏺ gastown"},
+			sentinel:   sentinel,
+			want:       []string{"⏺ gastown"},
+			wantMethod: MethodAnchor,
+			wantStart:  2,
+		},
+		{
+			name:       "no sentinel anchors on message",
+			lines:      []string{"old", "❯ list rigs", "⏺ gastown"},
+			want:       []string{"⏺ gastown"},
+			wantMethod: MethodAnchor,
+			wantStart:  2,
+		},
+		{
+			// The UI reformatted the prompt so neither the marker nor the
+			// message is recognizable.
+			name:       "echo unrecognizable falls back to baseline",
+			lines:      []string{"old", "> LIST RIGS", "⏺ gastown"},
+			beforeLen:  2,
+			sentinel:   sentinel,
+			want:       []string{"⏺ gastown"},
+			wantMethod: MethodBaseline,
+			wantStart:  2,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, method, _ := Extract(tt.lines, tt.beforeLen, "list rigs", tt.sentinel)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("response = %q, want %q", got, tt.want)
+			}
+			if method != tt.wantMethod {
+				t.Errorf("method = %q, want %q", method, tt.wantMethod)
+			}
+			if loc := Locate(tt.lines, tt.beforeLen, "list rigs", tt.sentinel); loc.Method != method || loc.Start != tt.wantStart || loc.End != 3 {
+				t.Errorf("Locate() = %+v, want %s rows [%d:3]", loc, method, tt.wantStart)
+			}
+		})
+	}
+}
+
+func TestNewSentinel_Unique(t *testing.T) {
+	a, b := NewSentinel(), NewSentinel()
+	if a == b {
+		t.Errorf("NewSentinel() returned %q twice", a)
+	}
+	if !isSentinelLine("⏺ "+a, a) {
+		t.Errorf("isSentinelLine did not match bulleted marker %q", a)
+	}
+}
+
+func TestExtract_StripsContext(t *testing.T) {
+	summary := "Workspace: 3 ready, 2 in progress, 1 blocked. Active polecats (2): gastown/alpha, gastown/bravo."
+	sent := WithContext("what should run next?", summary)
+	if !strings.HasPrefix(sent, contextOpen+summary+contextClose) {
+		t.Fatalf("WithContext() = %q", sent)
+	}
+
+	// The echoed prompt wraps the summary across rows, and the Mayor quotes
+	// it back before answering.
+	lines := []string{
+		"❯ " + sent[:40],
+		"  " + sent[40:90],
+		"  " + sent[90:],
+		"",
+		"⏺ You said: " + contextOpen + summary[:30],
+		summary[30:] + contextClose,
+		"",
+		"  Sling gastown-42 to bravo.",
+		"❯ ",
+	}
+	got, _, _ := Extract(lines, 0, "what should run next?", "")
+	want := []string{"⏺ You said:", "", "  Sling gastown-42 to bravo."}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Extract() = %q, want %q", got, want)
+	}
+}
+
+func TestExtract_LineHandling(t *testing.T) {
+	const message = "summarize the open convoys in the gastown rig and say which are stuck"
+	tests := []struct {
+		name       string
+		lines      []string
+		beforeLen  int
+		message    string
+		want       []string
+		wantMethod Method
+	}{
+		{
+			name:       "message on first line",
+			lines:      []string{"❯ list rigs", "", "⏺ gastown", "  beads", "", "❯ "},
+			message:    "list rigs",
+			want:       []string{"⏺ gastown", "  beads"},
+			wantMethod: MethodAnchor,
+		},
+		{
+			name: "message wrapped across two lines",
+			lines: []string{
+				"old output",
+				"❯ summarize the open convoys in the gastown rig and",
+				"  say which are stuck",
+				"",
+				"⏺ Two convoys are stuck.",
+			},
+			message:    message,
+			want:       []string{"⏺ Two convoys are stuck."},
+			wantMethod: MethodAnchor,
+		},
+		{
+			name: "message wrapped mid-word at the pane width",
+			lines: []string{
+				"❯ summarize the open convoys in the gastown ri",
+				"g and say which are stuck",
+				"⏺ Two convoys are stuck.",
+			},
+			message:    message,
+			want:       []string{"⏺ Two convoys are stuck."},
+			wantMethod: MethodAnchor,
+		},
+		{
+			name:       "multi-line message",
+			lines:      []string{"❯ first line", "  second line", "⏺ ok"},
+			message:    "first line\nsecond line",
+			want:       []string{"⏺ ok"},
+			wantMethod: MethodAnchor,
+		},
+		{
+			name:       "row after a complete echo is response",
+			lines:      []string{"❯ list rigs", "gastown and beads"},
+			message:    "list rigs",
+			want:       []string{"gastown and beads"},
+			wantMethod: MethodAnchor,
+		},
+		{
+			name:       "message absent falls back to baseline",
+			lines:      []string{"old 1", "old 2", "⏺ gastown", "❯ "},
+			beforeLen:  2,
+			message:    "list rigs",
+			want:       []string{"⏺ gastown"},
+			wantMethod: MethodBaseline,
+		},
+		{
+			name:       "baseline past the capture",
+			lines:      []string{"old 1"},
+			beforeLen:  5,
+			message:    "list rigs",
+			want:       []string{},
+			wantMethod: MethodBaseline,
+		},
+		{
+			name:       "empty message uses baseline",
+			lines:      []string{"old", "⏺ gastown"},
+			beforeLen:  1,
+			want:       []string{"⏺ gastown"},
+			wantMethod: MethodBaseline,
+		},
+		{
+			name:       "only UI artifacts",
+			lines:      []string{"❯ list rigs", "", "────────────────", "❯ ", "────────────────", "  ⏵⏵ bypass permissions on", "  ? for shortcuts"},
+			message:    "list rigs",
+			want:       []string{},
+			wantMethod: MethodAnchor,
+		},
+		{
+			name:       "CRLF line endings",
+			lines:      strings.Split("❯ list rigs\r\n\r\n⏺ gastown\r\n  beads\r\n\r\n❯ \r", "\n"),
+			message:    "list rigs",
+			want:       []string{"⏺ gastown", "  beads"},
+			wantMethod: MethodAnchor,
+		},
+		{
+			name:       "latest echo wins",
+			lines:      []string{"❯ list rigs", "⏺ stale answer", "❯ list rigs", "⏺ fresh answer"},
+			message:    "list rigs",
+			want:       []string{"⏺ fresh answer"},
+			wantMethod: MethodAnchor,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, method, complete := Extract(tt.lines, tt.beforeLen, tt.message, "")
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("response = %q, want %q", got, tt.want)
+			}
+			if method != tt.wantMethod {
+				t.Errorf("method = %q, want %q", method, tt.wantMethod)
+			}
+			if complete {
+				t.Error("complete = true without a sentinel")
+			}
+		})
+	}
+}
+
+func TestCleanLines(t *testing.T) {
+	tests := []struct {
+		name  string
+		lines []string
+		want  []string
+	}{
+		{"nil", nil, []string{}},
+		{"blank only", []string{"", "  ", "\t"}, []string{}},
+		{"trims surrounding blank lines", []string{"", "  ", "⏺ a", "", "  b", " ", ""}, []string{"⏺ a", "", "  b"}},
+		{"right-trims but keeps indentation", []string{"  code  \t", "⏺ done "}, []string{"  code", "⏺ done"}},
+		{"strips CR", []string{"⏺ a\r", "\r", "b\r"}, []string{"⏺ a", "", "b"}},
+		{"drops artifacts between content", []string{"⏺ a", "────────", "b"}, []string{"⏺ a", "b"}},
+		{"blank line left by a dropped artifact is trimmed", []string{"⏺ a", "", "❯ "}, []string{"⏺ a"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := CleanLines(tt.lines); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("CleanLines() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRenderCarriageReturns(t *testing.T) {
+	tests := []struct {
+		name string
+		line string
+		want string
+	}{
+		{"no CR", "⏺ gastown", "⏺ gastown"},
+		{"CRLF", "⏺ gastown\r", "⏺ gastown"},
+		{"spinner overwrites itself", "⠋ Fetching\r⠙ Fetching\r⠹ Fetching\r✔ Fetched ", "✔ Fetched "},
+		{"progress counter", "Syncing 9/10\rSyncing 10/10", "Syncing 10/10"},
+		{"shorter redraw leaves the tail", "Downloading...\rDone", "Doneloading..."},
+		{"padded redraw clears the tail", "Downloading...\rDone          ", "Done          "},
+		{"redraw then CRLF", "50%\r100%\r", "100%"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := renderCarriageReturns([]string{tt.line}); got[0] != tt.want {
+				t.Errorf("renderCarriageReturns(%q) = %q, want %q", tt.line, got[0], tt.want)
+			}
+		})
+	}
+}
+
+func TestExtract_SpinnerRedraws(t *testing.T) {
+	// The spinner line redraws in place while the agent works, then the
+	// busy indicator becomes the response bullet; CRLF endings throughout.
+	capture := "❯ check the gastown rig\r\n" +
+		"\r\n" +
+		"✻ Working… (esc to interrupt)\r⏺ Checking rigs…             \r⏺ The gastown rig is healthy.\r\n" +
+		"  Refinery: 3 merged today\r\n" +
+		"\r\n" +
+		"❯ \r"
+	got, method, _ := Extract(strings.Split(capture, "\n"), 0, "check the gastown rig", "")
+	want := []string{"⏺ The gastown rig is healthy.", "  Refinery: 3 merged today"}
+	if !reflect.DeepEqual(got, want) || method != MethodAnchor {
+		t.Errorf("Extract() = %q (%s), want %q (anchor)", got, method, want)
+	}
+}
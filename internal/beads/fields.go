@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"sort"
+	"strconv"
 	"strings"
 )
 
@@ -19,6 +20,7 @@ type AttachmentFields struct {
 	AttachedArgs     string // Natural language args passed via gt sling --args (no-tmux mode)
 	AttachedVars     []string // Formula variables passed via gt sling --var
 	DispatchedBy     string // Agent ID that dispatched this work (for completion notification)
+	AssignedAt       string // ISO 8601 timestamp of the latest sling to an assignee (staleness detection)
 	NoMerge          bool   // If true, gt done skips merge queue (for upstream PRs/human review)
 	ReviewOnly       bool   // If true, assignee must evaluate and report back — no merge/commit/push
 	Mode             string // Execution mode: "" (normal) or "ralph" (Ralph Wiggum loop)
@@ -76,6 +78,9 @@ func ParseAttachmentFields(issue *Issue) *AttachmentFields {
 		case "dispatched_by", "dispatched-by", "dispatchedby":
 			fields.DispatchedBy = value
 			hasFields = true
+		case "assigned_at", "assigned-at", "assignedat":
+			fields.AssignedAt = value
+			hasFields = true
 		case "no_merge", "no-merge", "nomerge":
 			fields.NoMerge = strings.ToLower(value) == "true"
 			hasFields = true
@@ -133,6 +138,9 @@ func FormatAttachmentFields(fields *AttachmentFields) string {
 	if fields.DispatchedBy != "" {
 		lines = append(lines, "dispatched_by: "+fields.DispatchedBy)
 	}
+	if fields.AssignedAt != "" {
+		lines = append(lines, "assigned_at: "+fields.AssignedAt)
+	}
 	if fields.NoMerge {
 		lines = append(lines, "no_merge: true")
 	}
@@ -182,6 +190,9 @@ func SetAttachmentFields(issue *Issue, fields *AttachmentFields) string {
 		"dispatched_by":     true,
 		"dispatched-by":     true,
 		"dispatchedby":      true,
+		"assigned_at":       true,
+		"assigned-at":       true,
+		"assignedat":        true,
 		"no_merge":          true,
 		"no-merge":          true,
 		"nomerge":           true,
@@ -262,6 +273,7 @@ type ConvoyFields struct {
 	BaseBranch    string // Target branch for polecats (e.g., "feat/extraction-review")
 	Watchers      string // Comma-separated mail notification addresses (added via gt convoy watch)
 	NudgeWatchers string // Comma-separated nudge notification addresses (added via gt convoy watch --nudge)
+	Stages        string // Comma-separated issue=stage ordinals (added via gt convoy add --stage); see ParseConvoyStages
 }
 
 // ParseConvoyFields extracts convoy fields from an issue's description.
@@ -313,6 +325,9 @@ func ParseConvoyFields(issue *Issue) *ConvoyFields {
 		case "nudge_watchers", "nudge-watchers", "nudgewatchers":
 			fields.NudgeWatchers = value
 			hasFields = true
+		case "stages":
+			fields.Stages = value
+			hasFields = true
 		}
 	}
 
@@ -322,6 +337,43 @@ func ParseConvoyFields(issue *Issue) *ConvoyFields {
 	return fields
 }
 
+// ParseConvoyStages parses a convoy Stages field ("gt-a=1,gt-b=2") into a
+// map of issue ID to stage ordinal. Malformed or non-positive entries are
+// skipped.
+func ParseConvoyStages(s string) map[string]int {
+	stages := make(map[string]int)
+	for _, entry := range strings.Split(s, ",") {
+		id, ord, ok := strings.Cut(strings.TrimSpace(entry), "=")
+		if !ok || id == "" {
+			continue
+		}
+		if n, err := strconv.Atoi(ord); err == nil && n > 0 {
+			stages[id] = n
+		}
+	}
+	return stages
+}
+
+// FormatConvoyStages is the inverse of ParseConvoyStages, ordered by stage
+// and then issue ID.
+func FormatConvoyStages(stages map[string]int) string {
+	ids := make([]string, 0, len(stages))
+	for id := range stages {
+		ids = append(ids, id)
+	}
+	sort.Slice(ids, func(i, j int) bool {
+		if stages[ids[i]] != stages[ids[j]] {
+			return stages[ids[i]] < stages[ids[j]]
+		}
+		return ids[i] < ids[j]
+	})
+	entries := make([]string, len(ids))
+	for i, id := range ids {
+		entries[i] = id + "=" + strconv.Itoa(stages[id])
+	}
+	return strings.Join(entries, ",")
+}
+
 // NotificationAddresses returns deduplicated mail notification addresses from convoy fields.
 // Includes Owner, Notify, and all Watchers addresses.
 func (f *ConvoyFields) NotificationAddresses() []string {
@@ -470,6 +522,9 @@ func FormatConvoyFields(fields *ConvoyFields) string {
 	if fields.NudgeWatchers != "" {
 		lines = append(lines, "nudge_watchers: "+fields.NudgeWatchers)
 	}
+	if fields.Stages != "" {
+		lines = append(lines, "stages: "+fields.Stages)
+	}
 
 	return strings.Join(lines, "\n")
 }
@@ -519,6 +574,7 @@ func SetConvoyFields(issue *Issue, fields *ConvoyFields) string {
 		"nudge_watchers":  true,
 		"nudge-watchers":  true,
 		"nudgewatchers":   true,
+		"stages":          true,
 	}
 
 	// Collect non-convoy lines from existing description
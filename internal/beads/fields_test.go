@@ -61,6 +61,30 @@ func TestAttachmentFieldsModeRoundTrip(t *testing.T) {
 	}
 }
 
+func TestAttachmentFieldsAssignedAtRoundTrip(t *testing.T) {
+	original := &AttachmentFields{
+		DispatchedBy: "mayor",
+		AssignedAt:   "2026-02-18T12:00:00Z",
+	}
+
+	formatted := FormatAttachmentFields(original)
+	if !strings.Contains(formatted, "assigned_at: 2026-02-18T12:00:00Z") {
+		t.Errorf("FormatAttachmentFields missing assigned_at field, got:\n%s", formatted)
+	}
+
+	// A re-sling replaces the old timestamp rather than appending a second one.
+	issue := &Issue{Description: "Fix the thing\n\n" + formatted}
+	desc := SetAttachmentFields(issue, &AttachmentFields{DispatchedBy: "mayor", AssignedAt: "2026-02-18T13:00:00Z"})
+	if strings.Count(desc, "assigned_at:") != 1 {
+		t.Errorf("expected exactly one assigned_at line, got:\n%s", desc)
+	}
+
+	parsed := ParseAttachmentFields(&Issue{Description: desc})
+	if parsed == nil || parsed.AssignedAt != "2026-02-18T13:00:00Z" {
+		t.Errorf("AssignedAt after re-sling: got %+v, want 2026-02-18T13:00:00Z", parsed)
+	}
+}
+
 func TestSetAttachmentFieldsPreservesMode(t *testing.T) {
 	issue := &Issue{
 		Description: "mode: ralph\nattached_molecule: gt-wisp-old\nSome other content",
@@ -689,3 +713,24 @@ func TestSetConvoyFieldsPreservesWatchers(t *testing.T) {
 		t.Errorf("lost prose, got:\n%s", got)
 	}
 }
+
+func TestConvoyStagesRoundTrip(t *testing.T) {
+	stages := ParseConvoyStages("gt-b=2, gt-a=1,bad, gt-c=0,gt-d=x,gt-e=2")
+	want := map[string]int{"gt-a": 1, "gt-b": 2, "gt-e": 2}
+	if len(stages) != len(want) {
+		t.Fatalf("ParseConvoyStages = %v, want %v", stages, want)
+	}
+	for id, n := range want {
+		if stages[id] != n {
+			t.Errorf("stage of %s = %d, want %d", id, stages[id], n)
+		}
+	}
+	if got := FormatConvoyStages(stages); got != "gt-a=1,gt-b=2,gt-e=2" {
+		t.Errorf("FormatConvoyStages = %q", got)
+	}
+
+	desc := SetConvoyFields(&Issue{Description: "Owner: mayor/"}, &ConvoyFields{Owner: "mayor/", Stages: "gt-a=1"})
+	if f := ParseConvoyFields(&Issue{Description: desc}); f == nil || f.Stages != "gt-a=1" {
+		t.Errorf("stages not preserved through description, got:\n%s", desc)
+	}
+}
@@ -524,7 +524,7 @@ func runAgents(cmd *cobra.Command, args []string) error {
 	}
 
 	// Execute tmux display-menu
-	tmuxPath, err := exec.LookPath("tmux")
+	tmuxPath, err := exec.LookPath(tmux.Binary())
 	if err != nil {
 		return fmt.Errorf("tmux not found: %w", err)
 	}
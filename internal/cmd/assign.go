@@ -167,7 +167,7 @@ func runAssign(_ *cobra.Command, args []string) error {
 
 	// Step 4: Log event
 	if err := events.LogFeed(events.TypeHook, agentID, events.HookPayload(beadID)); err != nil {
-		fmt.Fprintf(os.Stderr, "%s Warning: failed to log event: %v\n", style.Dim.Render("⚠"), err)
+		fmt.Fprintf(os.Stderr, "%s Warning: failed to log event: %v\n", style.RenderStderr(style.Dim, "⚠"), err)
 	}
 
 	fmt.Printf("%s Assigned %s to %s — %q\n", style.Bold.Render("✓"), beadID, agentID, title)
@@ -180,7 +180,7 @@ func runAssign(_ *cobra.Command, args []string) error {
 		nudgeCmd := exec.Command("gt", "nudge", agentID, "-m", nudgeMsg)
 		nudgeCmd.Stderr = os.Stderr
 		if out, err := nudgeCmd.Output(); err != nil {
-			fmt.Fprintf(os.Stderr, "%s Warning: nudge failed: %v\n", style.Warning.Render("⚠"), err)
+			fmt.Fprintf(os.Stderr, "%s Warning: nudge failed: %v\n", style.RenderStderr(style.Warning, "⚠"), err)
 		} else if len(out) > 0 {
 			fmt.Print(string(out))
 		} else {
@@ -11,6 +11,7 @@ import (
 
 	beadsdk "github.com/steveyegge/beads"
 	"github.com/steveyegge/gastown/internal/convoy"
+	"github.com/steveyegge/gastown/internal/style"
 	"github.com/steveyegge/gastown/internal/workspace"
 
 	"github.com/spf13/cobra"
@@ -27,7 +28,8 @@ all arguments and flags preserved.
 
 When an issue is closed, any convoys tracking it are checked for
 completion. If all tracked issues in a convoy are closed, the convoy
-is auto-closed.
+is auto-closed. Completed convoys and any next issues dispatched are
+reported on stderr.
 
 Examples:
   gt close gt-abc              # Close bead gt-abc
@@ -270,6 +272,18 @@ func checkConvoyCompletion(beadIDs []string) {
 	}
 
 	for _, beadID := range beadIDs {
-		convoy.CheckConvoysForIssue(ctx, store, townRoot, beadID, "Close", nil, gtPath, nil)
+		result := convoy.CheckConvoysForIssue(ctx, store, townRoot, beadID, "Close", nil, gtPath, nil)
+		reportConvoyProgress(beadID, result)
+	}
+}
+
+// reportConvoyProgress prints convoy transitions caused by closing an issue.
+// Output goes to stderr so bd's stdout (e.g. --json) stays parseable.
+func reportConvoyProgress(issueID string, result convoy.CheckResult) {
+	for _, convoyID := range result.Completed {
+		fmt.Fprintf(os.Stderr, "%s %s completed convoy %s\n", style.RenderStderr(style.Success, "✓"), issueID, convoyID)
+	}
+	for _, fed := range result.Fed {
+		fmt.Fprintf(os.Stderr, "%s convoy %s: dispatched %s to %s\n", style.RenderStderr(style.Dim, "→"), fed.ConvoyID, fed.IssueID, fed.Rig)
 	}
 }
@@ -0,0 +1,148 @@
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"path/filepath"
+	"strings"
+
+	"github.com/spf13/cobra"
+	beadsdk "github.com/steveyegge/beads"
+	"github.com/steveyegge/gastown/internal/convoy"
+	"github.com/steveyegge/gastown/internal/session"
+	"github.com/steveyegge/gastown/internal/tmux"
+)
+
+// Dynamic shell completion for argument values. Cobra provides the
+// `gt completion <shell>` command itself; these functions are registered as
+// ValidArgsFunction on commands whose arguments are issue IDs or agents.
+// Any lookup failure yields no suggestions rather than an error, since a
+// completion script has nowhere to show one.
+
+func init() {
+	for _, c := range []*cobra.Command{convoyStatusCmd, convoyFeedCmd, convoyStaleCmd, convoyCheckCmd} {
+		c.ValidArgsFunction = completeFirstArg(completeConvoyIDs)
+	}
+	convoyReassignCmd.ValidArgsFunction = completeReassignArgs
+	convoyAddCmd.ValidArgsFunction = completeConvoyAddArgs
+	issueShowCmd.ValidArgsFunction = completeFirstArg(completeIssueIDs)
+	for _, c := range []*cobra.Command{issueBlockCmd, issueUnblockCmd} {
+		c.ValidArgsFunction = completeUpToArgs(2, completeIssueIDs)
+	}
+}
+
+// maxIssueCompletions caps the IDs offered for one completion.
+const maxIssueCompletions = 50
+
+// completeFirstArg adapts a completion function so it only fires for the
+// first positional argument.
+func completeFirstArg(fn func(toComplete string) []string) func(*cobra.Command, []string, string) ([]string, cobra.ShellCompDirective) {
+	return completeUpToArgs(1, fn)
+}
+
+// completeUpToArgs adapts a completion function so it fires for each of the
+// first n positional arguments.
+func completeUpToArgs(n int, fn func(toComplete string) []string) func(*cobra.Command, []string, string) ([]string, cobra.ShellCompDirective) {
+	return func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+		if len(args) >= n {
+			return nil, cobra.ShellCompDirectiveNoFileComp
+		}
+		return fn(toComplete), cobra.ShellCompDirectiveNoFileComp
+	}
+}
+
+// completeConvoyAddArgs completes gt convoy add <convoy-id> <issue-id>...
+func completeConvoyAddArgs(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+	if len(args) == 0 {
+		return completeConvoyIDs(toComplete), cobra.ShellCompDirectiveNoFileComp
+	}
+	return completeIssueIDs(toComplete), cobra.ShellCompDirectiveNoFileComp
+}
+
+// completeReassignArgs completes gt convoy reassign <issue-id> <target>.
+func completeReassignArgs(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+	switch len(args) {
+	case 0:
+		return completeIssueIDs(toComplete), cobra.ShellCompDirectiveNoFileComp
+	case 1:
+		return completeAgentAddresses(toComplete), cobra.ShellCompDirectiveNoFileComp
+	}
+	return nil, cobra.ShellCompDirectiveNoFileComp
+}
+
+// completeConvoyIDs suggests open convoy IDs, described by title.
+func completeConvoyIDs(toComplete string) []string {
+	return completeBdList(toComplete, "list", "--type=convoy", "--status=open", "--json")
+}
+
+// completeIssueIDs suggests IDs of issues that aren't closed, described by
+// title. The store is the one the typed prefix routes to (gt- completes from
+// the gastown rig, anything else from the town beads), queried for IDs
+// starting with what's typed.
+func completeIssueIDs(toComplete string) []string {
+	ctx := context.Background()
+	store, err := beadsdk.Open(ctx, filepath.Join(resolveBeadDir(toComplete), ".beads"))
+	if err != nil {
+		return nil
+	}
+	defer func() { _ = store.Close() }()
+	issues, err := convoy.IssuesWithPrefix(ctx, store, toComplete, maxIssueCompletions)
+	if err != nil {
+		return nil
+	}
+	return issueCompletions(issues)
+}
+
+// issueCompletions formats issues as completion candidates: "id\ttitle".
+func issueCompletions(issues []*beadsdk.Issue) []string {
+	completions := make([]string, 0, len(issues))
+	for _, iss := range issues {
+		completions = append(completions, iss.ID+"\t"+iss.Title)
+	}
+	return completions
+}
+
+func completeBdList(toComplete string, args ...string) []string {
+	townBeads, err := getTownBeadsDir()
+	if err != nil {
+		return nil
+	}
+	out, err := runBdJSON(townBeads, args...)
+	if err != nil {
+		return nil
+	}
+	var issues []struct {
+		ID    string `json:"id"`
+		Title string `json:"title"`
+	}
+	if err := json.Unmarshal(out, &issues); err != nil {
+		return nil
+	}
+	var completions []string
+	for _, iss := range issues {
+		if strings.HasPrefix(iss.ID, toComplete) {
+			completions = append(completions, iss.ID+"\t"+iss.Title)
+		}
+	}
+	return completions
+}
+
+// completeAgentAddresses suggests addresses (gastown/polecats/alpha) of agents
+// with a running tmux session.
+func completeAgentAddresses(toComplete string) []string {
+	sessions, err := tmux.NewTmux().ListSessions()
+	if err != nil {
+		return nil
+	}
+	var completions []string
+	for _, name := range sessions {
+		identity, err := session.ParseSessionName(name)
+		if err != nil {
+			continue // not a Gas Town session
+		}
+		if addr := identity.Address(); strings.HasPrefix(addr, toComplete) {
+			completions = append(completions, addr+"\t"+name)
+		}
+	}
+	return completions
+}
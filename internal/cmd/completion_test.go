@@ -0,0 +1,59 @@
+package cmd
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/spf13/cobra"
+	beadsdk "github.com/steveyegge/beads"
+)
+
+func TestCompleteFirstArg(t *testing.T) {
+	complete := completeFirstArg(func(toComplete string) []string {
+		return []string{toComplete + "-abc"}
+	})
+
+	got, directive := complete(nil, nil, "hq-cv")
+	if !reflect.DeepEqual(got, []string{"hq-cv-abc"}) {
+		t.Errorf("first arg completions = %q, want [hq-cv-abc]", got)
+	}
+	if directive != cobra.ShellCompDirectiveNoFileComp {
+		t.Errorf("directive = %v, want NoFileComp", directive)
+	}
+
+	if got, _ := complete(nil, []string{"hq-cv-abc"}, ""); got != nil {
+		t.Errorf("second arg completions = %q, want none", got)
+	}
+}
+
+func TestCompleteUpToArgs(t *testing.T) {
+	complete := completeUpToArgs(2, func(toComplete string) []string {
+		return []string{toComplete + "1"}
+	})
+	if got, _ := complete(nil, []string{"gt-a"}, "gt-"); !reflect.DeepEqual(got, []string{"gt-1"}) {
+		t.Errorf("second arg completions = %q, want [gt-1]", got)
+	}
+	if got, _ := complete(nil, []string{"gt-a", "gt-b"}, "gt-"); got != nil {
+		t.Errorf("third arg completions = %q, want none", got)
+	}
+}
+
+func TestIssueCompletions(t *testing.T) {
+	got := issueCompletions([]*beadsdk.Issue{{ID: "gt-abc", Title: "Fix the feed"}})
+	if want := []string{"gt-abc\tFix the feed"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("issueCompletions() = %q, want %q", got, want)
+	}
+}
+
+func TestConvoyCommandsRegisterCompletion(t *testing.T) {
+	for _, c := range []*cobra.Command{convoyStatusCmd, convoyFeedCmd, convoyStaleCmd, convoyCheckCmd, convoyReassignCmd, convoyAddCmd} {
+		if c.ValidArgsFunction == nil {
+			t.Errorf("gt convoy %s has no ValidArgsFunction", c.Name())
+		}
+	}
+	for _, c := range []*cobra.Command{issueShowCmd, issueBlockCmd, issueUnblockCmd} {
+		if c.ValidArgsFunction == nil {
+			t.Errorf("gt issue %s has no ValidArgsFunction", c.Name())
+		}
+	}
+}
@@ -4,6 +4,7 @@ package cmd
 import (
 	"encoding/json"
 	"fmt"
+	"net/url"
 	"os"
 	"sort"
 	"strconv"
@@ -12,6 +13,7 @@ import (
 
 	"github.com/spf13/cobra"
 	"github.com/steveyegge/gastown/internal/config"
+	"github.com/steveyegge/gastown/internal/convoy"
 	"github.com/steveyegge/gastown/internal/daemon"
 	"github.com/steveyegge/gastown/internal/scheduler/capacity"
 	"github.com/steveyegge/gastown/internal/style"
@@ -619,6 +621,22 @@ var configSetCmd = &cobra.Command{
 Supported keys:
   convoy.notify_on_complete   Push notification to Mayor session on convoy
                               completion (true/false, default: false)
+  convoy.slingable_types      Comma-separated bead types convoys may dispatch
+                              (default: task,bug,feature,chore; "" resets)
+  convoy.rig_strategy         Rig choice for convoy feeds: order (default),
+                              least-loaded, or round-robin
+  convoy.max_per_rig          In-flight issues per rig before feeding pauses
+                              (default: 0 = no limit)
+  convoy.type_routes          Comma-separated type=rig rules that override
+                              prefix routing (e.g. bug=firefighter; "" resets)
+  convoy.timeouts.default     In-progress limit before the feed --watch
+                              watchdog acts (e.g. 4h; "" = no limit)
+  convoy.timeouts.by_type     Per-type limits (e.g. bug=2h,feature=8h)
+  convoy.timeouts.action      On timeout: warn (default), reassign, or kill
+  convoy.event_log            JSON-lines file for convoy events, relative to
+                              the town root ("" disables, the default)
+  convoy.webhook.url          POST each convoy event as JSON here ("" disables)
+  convoy.webhook.secret       HMAC-SHA256 key for the X-Gastown-Signature header
   cli_theme                   CLI color scheme ("dark", "light", "auto")
   default_agent               Default agent preset name
   dolt.port                   Dolt SQL server port (default: 3307). Set this when
@@ -645,6 +663,14 @@ Supported keys:
 
 Examples:
   gt config set convoy.notify_on_complete true
+  gt config set convoy.slingable_types task,bug,spike,research
+  gt config set convoy.rig_strategy least-loaded
+  gt config set convoy.max_per_rig 2
+  gt config set convoy.type_routes bug=firefighter,feature=build
+  gt config set convoy.timeouts.by_type bug=2h,feature=8h
+  gt config set convoy.timeouts.action reassign
+  gt config set convoy.event_log logs/convoy-events.jsonl
+  gt config set convoy.webhook.url https://ci.example.com/hooks/gastown
   gt config set cli_theme dark
   gt config set default_agent claude
   gt config set dolt.port 3308
@@ -666,6 +692,15 @@ var configGetCmd = &cobra.Command{
 Supported keys:
   convoy.notify_on_complete   Push notification to Mayor session on convoy
                               completion (true/false, default: false)
+  convoy.slingable_types      Bead types convoys may dispatch
+  convoy.rig_strategy         Rig choice for convoy feeds
+  convoy.max_per_rig          In-flight issues per rig before feeding pauses
+  convoy.type_routes          Issue type → rig rules for convoy feeds
+  convoy.timeouts.default     In-progress limit for types without a rule
+  convoy.timeouts.by_type     Per-type in-progress limits
+  convoy.timeouts.action      Watchdog action on timeout
+  convoy.event_log            JSON-lines file for convoy events
+  convoy.webhook.url          Webhook URL for convoy events
   cli_theme                   CLI color scheme
   default_agent               Default agent preset name
   scheduler.max_polecats      Dispatch mode (-1 = direct, N > 0 = deferred)
@@ -722,6 +757,107 @@ func runConfigSet(cmd *cobra.Command, args []string) error {
 		}
 		townSettings.Convoy.NotifyOnComplete = b
 
+	case "convoy.slingable_types":
+		var types []string
+		for _, t := range strings.Split(value, ",") {
+			if t = strings.TrimSpace(t); t != "" {
+				types = append(types, t)
+			}
+		}
+		if townSettings.Convoy == nil {
+			townSettings.Convoy = &config.ConvoyConfig{}
+		}
+		townSettings.Convoy.SlingableTypes = types
+
+	case "convoy.rig_strategy":
+		strategy, err := convoy.ParseRigStrategy(value)
+		if err != nil {
+			return fmt.Errorf("invalid value for %s: %w", key, err)
+		}
+		if townSettings.Convoy == nil {
+			townSettings.Convoy = &config.ConvoyConfig{}
+		}
+		townSettings.Convoy.RigStrategy = string(strategy)
+
+	case "convoy.max_per_rig":
+		n, err := strconv.Atoi(value)
+		if err != nil || n < 0 {
+			return fmt.Errorf("invalid value for %s: expected non-negative integer", key)
+		}
+		if townSettings.Convoy == nil {
+			townSettings.Convoy = &config.ConvoyConfig{}
+		}
+		townSettings.Convoy.MaxPerRig = n
+
+	case "convoy.type_routes":
+		routes, err := parseTypeMap(value)
+		if err != nil {
+			return fmt.Errorf("invalid value for %s: %w", key, err)
+		}
+		if townSettings.Convoy == nil {
+			townSettings.Convoy = &config.ConvoyConfig{}
+		}
+		townSettings.Convoy.TypeRoutes = routes
+
+	case "convoy.timeouts.default", "convoy.timeouts.by_type", "convoy.timeouts.action":
+		if townSettings.Convoy == nil {
+			townSettings.Convoy = &config.ConvoyConfig{}
+		}
+		if townSettings.Convoy.Timeouts == nil {
+			townSettings.Convoy.Timeouts = &config.ConvoyTimeoutConfig{}
+		}
+		tc := townSettings.Convoy.Timeouts
+		switch key {
+		case "convoy.timeouts.default":
+			if value != "" {
+				if d, err := time.ParseDuration(value); err != nil || d <= 0 {
+					return fmt.Errorf("invalid value for %s: expected a positive duration (e.g. 4h)", key)
+				}
+			}
+			tc.Default = value
+		case "convoy.timeouts.by_type":
+			limits, err := parseTypeMap(value)
+			if err != nil {
+				return fmt.Errorf("invalid value for %s: %w", key, err)
+			}
+			for issueType, limit := range limits {
+				if d, err := time.ParseDuration(limit); err != nil || d <= 0 {
+					return fmt.Errorf("invalid value for %s: %s limit %q is not a positive duration", key, issueType, limit)
+				}
+			}
+			tc.ByType = limits
+		default:
+			action, err := convoy.ParseTimeoutAction(value)
+			if err != nil {
+				return fmt.Errorf("invalid value for %s: %w", key, err)
+			}
+			tc.Action = string(action)
+		}
+
+	case "convoy.event_log":
+		if townSettings.Convoy == nil {
+			townSettings.Convoy = &config.ConvoyConfig{}
+		}
+		townSettings.Convoy.EventLog = value
+
+	case "convoy.webhook.url", "convoy.webhook.secret":
+		if value != "" && key == "convoy.webhook.url" {
+			if u, err := url.Parse(value); err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+				return fmt.Errorf("invalid value for %s: expected an http(s) URL", key)
+			}
+		}
+		if townSettings.Convoy == nil {
+			townSettings.Convoy = &config.ConvoyConfig{}
+		}
+		if townSettings.Convoy.Webhook == nil {
+			townSettings.Convoy.Webhook = &config.ConvoyWebhookConfig{}
+		}
+		if key == "convoy.webhook.url" {
+			townSettings.Convoy.Webhook.URL = value
+		} else {
+			townSettings.Convoy.Webhook.Secret = value
+		}
+
 	case "cli_theme":
 		switch value {
 		case "dark", "light", "auto":
@@ -794,7 +930,7 @@ func runConfigSet(cmd *cobra.Command, args []string) error {
 		if strings.HasPrefix(key, "lifecycle.") {
 			return setLifecycleConfig(townRoot, key, value)
 		}
-		return fmt.Errorf("unknown config key: %q\n\nSupported keys:\n  convoy.notify_on_complete\n  cli_theme\n  default_agent\n  dolt.port\n  scheduler.max_polecats\n  scheduler.batch_size\n  scheduler.spawn_delay\n  maintenance.window\n  maintenance.interval\n  maintenance.threshold\n  lifecycle.reaper.*\n  lifecycle.compactor.*\n  lifecycle.doctor.*\n  lifecycle.backup.*", key)
+		return fmt.Errorf("unknown config key: %q\n\nSupported keys:\n  convoy.notify_on_complete\n  convoy.slingable_types\n  convoy.rig_strategy\n  convoy.max_per_rig\n  convoy.type_routes\n  convoy.timeouts.*\n  convoy.event_log\n  convoy.webhook.url\n  convoy.webhook.secret\n  cli_theme\n  default_agent\n  dolt.port\n  scheduler.max_polecats\n  scheduler.batch_size\n  scheduler.spawn_delay\n  maintenance.window\n  maintenance.interval\n  maintenance.threshold\n  lifecycle.reaper.*\n  lifecycle.compactor.*\n  lifecycle.doctor.*\n  lifecycle.backup.*", key)
 	}
 
 	if err := config.SaveTownSettings(settingsPath, townSettings); err != nil {
@@ -828,6 +964,58 @@ func runConfigGet(cmd *cobra.Command, args []string) error {
 			value = "false"
 		}
 
+	case "convoy.slingable_types":
+		types := []string{"task", "bug", "feature", "chore"}
+		if townSettings.Convoy != nil && len(townSettings.Convoy.SlingableTypes) > 0 {
+			types = townSettings.Convoy.SlingableTypes
+		}
+		value = strings.Join(types, ",")
+
+	case "convoy.rig_strategy":
+		value = string(convoy.RigByOrder)
+		if townSettings.Convoy != nil && townSettings.Convoy.RigStrategy != "" {
+			value = townSettings.Convoy.RigStrategy
+		}
+
+	case "convoy.max_per_rig":
+		n := 0
+		if townSettings.Convoy != nil {
+			n = townSettings.Convoy.MaxPerRig
+		}
+		value = strconv.Itoa(n)
+
+	case "convoy.type_routes":
+		if townSettings.Convoy != nil {
+			value = formatTypeMap(townSettings.Convoy.TypeRoutes)
+		}
+
+	case "convoy.timeouts.default", "convoy.timeouts.by_type", "convoy.timeouts.action":
+		tc := &config.ConvoyTimeoutConfig{}
+		if townSettings.Convoy != nil && townSettings.Convoy.Timeouts != nil {
+			tc = townSettings.Convoy.Timeouts
+		}
+		switch key {
+		case "convoy.timeouts.default":
+			value = tc.Default
+		case "convoy.timeouts.by_type":
+			value = formatTypeMap(tc.ByType)
+		default:
+			value = string(convoy.TimeoutWarn)
+			if tc.Action != "" {
+				value = tc.Action
+			}
+		}
+
+	case "convoy.event_log":
+		if townSettings.Convoy != nil {
+			value = townSettings.Convoy.EventLog
+		}
+
+	case "convoy.webhook.url":
+		if townSettings.Convoy != nil && townSettings.Convoy.Webhook != nil {
+			value = townSettings.Convoy.Webhook.URL
+		}
+
 	case "cli_theme":
 		value = townSettings.CLITheme
 		if value == "" {
@@ -879,7 +1067,7 @@ func runConfigGet(cmd *cobra.Command, args []string) error {
 		if strings.HasPrefix(key, "lifecycle.") {
 			return getLifecycleConfig(townRoot, key)
 		}
-		return fmt.Errorf("unknown config key: %q\n\nSupported keys:\n  convoy.notify_on_complete\n  cli_theme\n  default_agent\n  dolt.port\n  scheduler.max_polecats\n  scheduler.batch_size\n  scheduler.spawn_delay\n  maintenance.window\n  maintenance.interval\n  maintenance.threshold\n  lifecycle.reaper.*\n  lifecycle.compactor.*\n  lifecycle.doctor.*\n  lifecycle.backup.*", key)
+		return fmt.Errorf("unknown config key: %q\n\nSupported keys:\n  convoy.notify_on_complete\n  convoy.slingable_types\n  convoy.rig_strategy\n  convoy.max_per_rig\n  convoy.type_routes\n  convoy.timeouts.*\n  convoy.event_log\n  convoy.webhook.url\n  cli_theme\n  default_agent\n  dolt.port\n  scheduler.max_polecats\n  scheduler.batch_size\n  scheduler.spawn_delay\n  maintenance.window\n  maintenance.interval\n  maintenance.threshold\n  lifecycle.reaper.*\n  lifecycle.compactor.*\n  lifecycle.doctor.*\n  lifecycle.backup.*", key)
 	}
 
 	fmt.Println(value)
@@ -1225,6 +1413,38 @@ func parseBool(s string) (bool, error) {
 	}
 }
 
+// parseTypeMap parses "bug=firefighter,feature=build" into a per-type map.
+// An empty value yields nil, which resets the setting.
+func parseTypeMap(s string) (map[string]string, error) {
+	var routes map[string]string
+	for _, rule := range strings.Split(s, ",") {
+		rule = strings.TrimSpace(rule)
+		if rule == "" {
+			continue
+		}
+		issueType, value, ok := strings.Cut(rule, "=")
+		issueType, value = strings.TrimSpace(issueType), strings.TrimSpace(value)
+		if !ok || issueType == "" || value == "" {
+			return nil, fmt.Errorf("rule %q is not type=value", rule)
+		}
+		if routes == nil {
+			routes = make(map[string]string)
+		}
+		routes[issueType] = value
+	}
+	return routes, nil
+}
+
+// formatTypeMap is the inverse of parseTypeMap, sorted by type.
+func formatTypeMap(routes map[string]string) string {
+	rules := make([]string, 0, len(routes))
+	for issueType, value := range routes {
+		rules = append(rules, issueType+"="+value)
+	}
+	sort.Strings(rules)
+	return strings.Join(rules, ",")
+}
+
 func init() {
 	presets := config.BuiltInAgentPresetSummary()
 
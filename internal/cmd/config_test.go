@@ -3,6 +3,7 @@ package cmd
 import (
 	"os"
 	"path/filepath"
+	"reflect"
 	"strings"
 	"testing"
 	"time"
@@ -1002,3 +1003,26 @@ func TestParseBool(t *testing.T) {
 		})
 	}
 }
+
+func TestParseTypeMap(t *testing.T) {
+	got, err := parseTypeMap(" bug = firefighter, feature=build,")
+	if err != nil {
+		t.Fatalf("parseTypeMap: %v", err)
+	}
+	want := map[string]string{"bug": "firefighter", "feature": "build"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("parseTypeMap() = %v, want %v", got, want)
+	}
+	if s := formatTypeMap(got); s != "bug=firefighter,feature=build" {
+		t.Errorf("formatTypeMap() = %q", s)
+	}
+
+	if got, err := parseTypeMap(""); err != nil || got != nil {
+		t.Errorf("parseTypeMap(\"\") = %v, %v; want nil, nil", got, err)
+	}
+	for _, bad := range []string{"bug", "bug=", "=firefighter"} {
+		if _, err := parseTypeMap(bad); err == nil {
+			t.Errorf("parseTypeMap(%q) should fail", bad)
+		}
+	}
+}
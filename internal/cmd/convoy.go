@@ -2,6 +2,7 @@ package cmd
 
 import (
 	"bytes"
+	"context"
 	"crypto/rand"
 	"encoding/json"
 	"fmt"
@@ -16,6 +17,7 @@ import (
 
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/spf13/cobra"
+	beadsdk "github.com/steveyegge/beads"
 	"github.com/steveyegge/gastown/internal/beads"
 	"github.com/steveyegge/gastown/internal/config"
 	convoyops "github.com/steveyegge/gastown/internal/convoy"
@@ -78,6 +80,7 @@ var (
 	convoyMerge        string
 	convoyBaseBranch   string
 	convoyStatusJSON   bool
+	convoyStatusFormat string
 	convoyListJSON     bool
 	convoyListStatus   string
 	convoyListAll      bool
@@ -92,6 +95,7 @@ var (
 	convoyLandKeep     bool
 	convoyLandDryRun   bool
 	convoyFromEpic     string
+	convoyAddStage     int
 )
 
 const (
@@ -212,7 +216,9 @@ var convoyCreateCmd = &cobra.Command{
 	Long: `Create a new convoy that tracks the specified issues.
 
 The convoy is created in town-level beads (hq-* prefix) and can track
-issues across any rig.
+issues across any rig. Tracked issues must be slingable types (see
+convoy.slingable_types), epics, or messages, decisions, and events (which
+the feed delivers to the Mayor or records, then closes).
 
 The --owner flag specifies who requested the convoy (receives completion
 notification by default). If not specified, defaults to created_by.
@@ -245,8 +251,15 @@ var convoyStatusCmd = &cobra.Command{
 	Short: "Show convoy status",
 	Long: `Show detailed status for a convoy.
 
-Displays convoy metadata, tracked issues, and completion progress.
-Without an ID, shows status of all active convoys.`,
+Displays convoy metadata, completion progress, and tracked issues grouped
+by status (in progress, open, closed). Issues waiting on open blocking
+dependencies are flagged. The convoy ID may be given wrapped, as bd dep list
+prints it (external:hq:hq-cv-xyz).
+
+Without an ID, shows status of all active convoys.
+
+--format=table or csv prints just the tracked issues, one row each (or,
+without an ID, one row per active convoy); json is the same as --json.`,
 	Args:         cobra.MaximumNArgs(1),
 	SilenceUsage: true,
 	RunE:         runConvoyStatus,
@@ -272,11 +285,20 @@ var convoyAddCmd = &cobra.Command{
 	Short: "Add issues to an existing convoy",
 	Long: `Add issues to an existing convoy.
 
-If the convoy is closed, it will be automatically reopened.
+If the convoy is closed, it will be automatically reopened. IDs may be given
+wrapped, as bd dep list prints them (external:gt:gt-abc). Each issue must be
+a slingable type (see convoy.slingable_types), an epic, or a message,
+decision, or event; otherwise nothing is added.
+
+With --stage N, the added issues join ordered stage N. The convoy only feeds
+its lowest stage that still has open work: stage 2 waits until every stage 1
+issue closes, and so on. Issues without a stage are in stage 1. Re-adding an
+issue with --stage moves it to that stage.
 
 Examples:
   gt convoy add hq-cv-abc gt-new-issue
-  gt convoy add hq-cv-abc gt-issue1 gt-issue2 gt-issue3`,
+  gt convoy add hq-cv-abc gt-issue1 gt-issue2 gt-issue3
+  gt convoy add hq-cv-abc gt-deploy --stage 2`,
 	Args:         cobra.MinimumNArgs(2),
 	SilenceUsage: true,
 	RunE:         runConvoyAdd,
@@ -385,6 +407,7 @@ func init() {
 
 	// Status flags
 	convoyStatusCmd.Flags().BoolVar(&convoyStatusJSON, "json", false, "Output as JSON")
+	addFormatFlag(convoyStatusCmd, &convoyStatusFormat)
 
 	// List flags
 	convoyListCmd.Flags().BoolVar(&convoyListJSON, "json", false, "Output as JSON")
@@ -395,6 +418,9 @@ func init() {
 	// Interactive TUI flag (on parent command)
 	convoyCmd.Flags().BoolVarP(&convoyInteractive, "interactive", "i", false, "Interactive tree view")
 
+	// Add flags
+	convoyAddCmd.Flags().IntVar(&convoyAddStage, "stage", 0, "Put the added issues in this ordered stage (1 = first)")
+
 	// Check flags
 	convoyCheckCmd.Flags().BoolVar(&convoyCheckDryRun, "dry-run", false, "Preview what would close without acting")
 
@@ -646,6 +672,12 @@ func runConvoyCreate(cmd *cobra.Command, args []string) error {
 		if len(trackedIssues) == 0 {
 			return fmt.Errorf("at least one issue ID is required\nUsage: gt convoy create <name> <issue-id> [issue-id...]")
 		}
+		for i, id := range trackedIssues {
+			trackedIssues[i] = beads.ExtractIssueID(id)
+		}
+		if err := validateConvoyMembers(trackedIssues); err != nil {
+			return err
+		}
 	}
 
 	townBeads, err := getTownBeadsDir()
@@ -768,8 +800,14 @@ func runConvoyCreate(cmd *cobra.Command, args []string) error {
 }
 
 func runConvoyAdd(cmd *cobra.Command, args []string) error {
-	convoyID := args[0]
-	issuesToAdd := args[1:]
+	convoyID := beads.ExtractIssueID(args[0])
+	issuesToAdd := make([]string, len(args)-1)
+	for i, id := range args[1:] {
+		issuesToAdd[i] = beads.ExtractIssueID(id)
+	}
+	if convoyAddStage < 0 {
+		return fmt.Errorf("--stage must be 1 or more")
+	}
 
 	townBeads, err := getTownBeadsDir()
 	if err != nil {
@@ -786,10 +824,11 @@ func runConvoyAdd(cmd *cobra.Command, args []string) error {
 	}
 
 	var convoys []struct {
-		ID     string `json:"id"`
-		Title  string `json:"title"`
-		Status string `json:"status"`
-		Type   string `json:"issue_type"`
+		ID          string `json:"id"`
+		Title       string `json:"title"`
+		Status      string `json:"status"`
+		Type        string `json:"issue_type"`
+		Description string `json:"description"`
 	}
 	if err := json.Unmarshal(showOut, &convoys); err != nil {
 		return fmt.Errorf("parsing convoy data: %w", err)
@@ -809,6 +848,10 @@ func runConvoyAdd(cmd *cobra.Command, args []string) error {
 		return fmt.Errorf("convoy '%s' has invalid lifecycle state: %w", convoyID, err)
 	}
 
+	if err := validateConvoyMembers(issuesToAdd); err != nil {
+		return err
+	}
+
 	// If convoy is closed, reopen it
 	reopened := false
 	if normalizeConvoyStatus(convoy.Status) == convoyStatusClosed {
@@ -825,12 +868,20 @@ func runConvoyAdd(cmd *cobra.Command, args []string) error {
 	}
 
 	// Add 'tracks' relations for each issue
-	addedCount := 0
+	var added []string
 	for _, issueID := range issuesToAdd {
 		if err := addTrackingRelationFn(townBeads, convoyID, issueID); err != nil {
 			style.PrintWarning("couldn't add %s: %s", issueID, err)
 		} else {
-			addedCount++
+			added = append(added, issueID)
+		}
+	}
+	addedCount := len(added)
+
+	if convoyAddStage > 0 && addedCount > 0 {
+		newDesc := setConvoyStages(convoy.Description, added, convoyAddStage)
+		if err := updateConvoyDescription(townBeads, convoyID, newDesc); err != nil {
+			return fmt.Errorf("recording stage %d: %w", convoyAddStage, err)
 		}
 	}
 
@@ -840,12 +891,56 @@ func runConvoyAdd(cmd *cobra.Command, args []string) error {
 	}
 	fmt.Printf("%s Added %d issue(s) to convoy 🚚 %s\n", style.Bold.Render("✓"), addedCount, convoyID)
 	if addedCount > 0 {
-		fmt.Printf("  Issues: %s\n", strings.Join(issuesToAdd[:addedCount], ", "))
+		fmt.Printf("  Issues: %s\n", strings.Join(added, ", "))
+	}
+	if convoyAddStage > 0 && addedCount > 0 {
+		fmt.Printf("  Stage:  %d\n", convoyAddStage)
 	}
 
 	return nil
 }
 
+// setConvoyStages returns the convoy description with issueIDs moved to
+// stage n in its stages field, preserving every other field.
+func setConvoyStages(description string, issueIDs []string, n int) string {
+	issue := &beads.Issue{Description: description}
+	fields := beads.ParseConvoyFields(issue)
+	if fields == nil {
+		fields = &beads.ConvoyFields{}
+	}
+	stages := beads.ParseConvoyStages(fields.Stages)
+	for _, id := range issueIDs {
+		stages[id] = n
+	}
+	fields.Stages = beads.FormatConvoyStages(stages)
+	return beads.SetConvoyFields(issue, fields)
+}
+
+// convoyContainerTypes are non-slingable types a convoy may still track:
+// it follows an epic's progress without dispatching the epic itself.
+var convoyContainerTypes = map[string]bool{"epic": true}
+
+// validateConvoyMembers rejects issues a convoy can neither dispatch, handle,
+// nor treat as a container, naming every offender at once so nothing is tracked
+// from a partly bad list. Issues whose type can't be looked up are allowed;
+// tracking them may still succeed through cross-rig routing.
+func validateConvoyMembers(issueIDs []string) error {
+	details := getIssueDetailsBatch(issueIDs)
+	var rejected []string
+	for _, id := range issueIDs {
+		d := details[id]
+		if d == nil || convoyops.IsSlingableType(d.IssueType) || convoyops.IsActionableType(d.IssueType) || convoyContainerTypes[d.IssueType] {
+			continue
+		}
+		rejected = append(rejected, fmt.Sprintf("%s (%s)", id, d.IssueType))
+	}
+	if len(rejected) > 0 {
+		return fmt.Errorf("cannot track %s: convoys track slingable types (see convoy.slingable_types), epics, messages, decisions, and events",
+			strings.Join(rejected, ", "))
+	}
+	return nil
+}
+
 func runConvoyCheck(cmd *cobra.Command, args []string) error {
 	townBeads, err := getTownBeadsDir()
 	if err != nil {
@@ -1331,8 +1426,7 @@ func findConvoyWorktrees(tracked []trackedIssueInfo) []convoyWorktreeInfo {
 			}
 
 			// Check if this polecat's assignee matches any tracked issue assignee
-			// Assignees have format: rig/polecats/name
-			polecatAssignee := fmt.Sprintf("%s/polecats/%s", rigName, entry.Name())
+			polecatAssignee := session.FormatAssignee(rigName, entry.Name())
 			if assignees[polecatAssignee] {
 				worktrees = append(worktrees, convoyWorktreeInfo{
 					rigName:     rigName,
@@ -1609,8 +1703,8 @@ func isReadyIssue(t trackedIssueInfo, scheduledSet map[string]bool) bool {
 	}
 
 	// Check if tmux session exists
-	checkCmd := tmux.BuildCommand("has-session", "-t", sessionName)
-	if err := checkCmd.Run(); err != nil {
+	exists, err := tmux.NewTmux().HasSession(sessionName)
+	if err != nil || !exists {
 		// Session doesn't exist = orphaned molecule or dead worker
 		// This is the key fix: issues with in_progress/hooked status but
 		// dead workers are now correctly detected as stranded
@@ -1740,7 +1834,23 @@ func notifyMayorSession(townBeads, convoyID, title string) {
 	}
 }
 
+// findConvoyCycles returns the dependency cycles that keep a convoy's members
+// blocked, or nil if the town store can't be opened.
+func findConvoyCycles(townRoot, convoyID string) [][]string {
+	ctx := context.Background()
+	store, err := beadsdk.Open(ctx, filepath.Join(townRoot, ".beads"))
+	if err != nil {
+		return nil
+	}
+	defer func() { _ = store.Close() }()
+	return convoyops.FindConvoyCycles(ctx, store, townRoot, convoyID, nil)
+}
+
 func runConvoyStatus(cmd *cobra.Command, args []string) error {
+	format, err := resolveListFormat(convoyStatusFormat, convoyStatusJSON)
+	if err != nil {
+		return err
+	}
 	townBeads, err := getTownBeadsDir()
 	if err != nil {
 		return err
@@ -1748,10 +1858,11 @@ func runConvoyStatus(cmd *cobra.Command, args []string) error {
 
 	// If no ID provided, show all active convoys
 	if len(args) == 0 {
-		return showAllConvoyStatus(townBeads)
+		return showAllConvoyStatus(townBeads, format)
 	}
 
-	convoyID := args[0]
+	// Accept wrapped IDs as printed by bd dep list (external:hq:hq-cv-xyz).
+	convoyID := beads.ExtractIssueID(args[0])
 
 	// Check if it's a numeric shortcut (e.g., "1" instead of "hq-cv-xyz")
 	if n, err := strconv.Atoi(convoyID); err == nil && n > 0 {
@@ -1797,15 +1908,28 @@ func runConvoyStatus(cmd *cobra.Command, args []string) error {
 		return fmt.Errorf("getting tracked issues for %s: %w", convoyID, err)
 	}
 
-	// Count completed
-	completed := 0
+	// Count completed and blocked
+	completed, blocked := 0, 0
+	byStatus := make(map[string]int)
 	for _, t := range tracked {
+		byStatus[t.Status]++
 		if t.Status == "closed" {
 			completed++
+		} else if t.Blocked {
+			blocked++
 		}
 	}
+	percent := percentComplete(completed, len(tracked))
+	activeStage, lastStage := applyConvoyStages(tracked, convoy.Description)
+	var cycles [][]string
+	if blocked > 0 {
+		cycles = findConvoyCycles(townBeads, convoyID)
+	}
 
-	if convoyStatusJSON {
+	if format == formatTable || format == formatCSV {
+		return writeListing(os.Stdout, format, trackedIssuesTable(tracked), tracked)
+	}
+	if format == formatJSON {
 		lifecycle := "system-managed"
 		if isOwned {
 			lifecycle = "caller-managed"
@@ -1817,9 +1941,15 @@ func runConvoyStatus(cmd *cobra.Command, args []string) error {
 			Owned         bool               `json:"owned"`
 			Lifecycle     string             `json:"lifecycle"`
 			MergeStrategy string             `json:"merge_strategy,omitempty"`
+			ActiveStage   int                `json:"active_stage,omitempty"`
+			Stages        int                `json:"stages,omitempty"`
 			Tracked       []trackedIssueInfo `json:"tracked"`
+			ByStatus      map[string]int     `json:"by_status"`
 			Completed     int                `json:"completed"`
+			Blocked       int                `json:"blocked"`
+			Cycles        [][]string         `json:"cycles,omitempty"`
 			Total         int                `json:"total"`
+			Percent       int                `json:"percent"`
 		}
 		out := jsonStatus{
 			ID:            convoy.ID,
@@ -1828,9 +1958,15 @@ func runConvoyStatus(cmd *cobra.Command, args []string) error {
 			Owned:         isOwned,
 			Lifecycle:     lifecycle,
 			MergeStrategy: convoyMergeFromFields(convoy.Description),
+			ActiveStage:   activeStage,
+			Stages:        lastStage,
 			Tracked:       tracked,
+			ByStatus:      byStatus,
 			Completed:     completed,
+			Blocked:       blocked,
+			Cycles:        cycles,
 			Total:         len(tracked),
+			Percent:       percent,
 		}
 		enc := json.NewEncoder(os.Stdout)
 		enc.SetIndent("", "  ")
@@ -1850,7 +1986,20 @@ func runConvoyStatus(cmd *cobra.Command, args []string) error {
 	if merge != "" {
 		fmt.Printf("  Merge:     %s\n", merge)
 	}
-	fmt.Printf("  Progress:  %d/%d completed\n", completed, len(tracked))
+	fmt.Printf("  Progress:  %d/%d completed (%d%%)\n", completed, len(tracked), percent)
+	if lastStage > 0 {
+		if activeStage > 0 {
+			fmt.Printf("  Stage:     %d of %d\n", activeStage, lastStage)
+		} else {
+			fmt.Printf("  Stage:     all %d closed\n", lastStage)
+		}
+	}
+	if blocked > 0 {
+		fmt.Printf("  Blocked:   %s\n", style.Warning.Render(fmt.Sprintf("%d waiting on open dependencies", blocked)))
+	}
+	for _, c := range cycles {
+		fmt.Printf("  Cycle:     %s %s\n", style.ErrorPrefix, style.Error.Render(convoyops.FormatCycle(c)))
+	}
 	fmt.Printf("  Created:   %s\n", convoy.CreatedAt)
 	if convoy.ClosedAt != "" {
 		fmt.Printf("  Closed:    %s\n", convoy.ClosedAt)
@@ -1858,34 +2007,11 @@ func runConvoyStatus(cmd *cobra.Command, args []string) error {
 
 	if len(tracked) > 0 {
 		fmt.Printf("\n  %s\n", style.Bold.Render("Tracked Issues:"))
-		for _, t := range tracked {
-			// Status symbol: ✓ closed, ▶ in_progress/hooked, ○ other
-			status := "○"
-			switch t.Status {
-			case "closed":
-				status = "✓"
-			case "in_progress", "hooked":
-				status = "▶"
-			}
-
-			// Show assignee in brackets (extract short name from path like gastown/polecats/goose -> goose)
-			bracketContent := t.IssueType
-			if t.Assignee != "" {
-				parts := strings.Split(t.Assignee, "/")
-				bracketContent = parts[len(parts)-1] // Last part of path
-			} else if bracketContent == "" {
-				bracketContent = "unassigned"
+		for _, g := range groupTrackedByStatus(tracked) {
+			fmt.Printf("\n    %s\n", style.Dim.Render(fmt.Sprintf("%s (%d)", g.Label, len(g.Issues))))
+			for _, t := range g.Issues {
+				fmt.Println(formatTrackedIssueLine(t))
 			}
-
-			line := fmt.Sprintf("    %s %s: %s [%s]", status, t.ID, t.Title, bracketContent)
-			if t.Worker != "" {
-				workerDisplay := "@" + t.Worker
-				if t.WorkerAge != "" {
-					workerDisplay += fmt.Sprintf(" (%s)", t.WorkerAge)
-				}
-				line += fmt.Sprintf("  %s", style.Dim.Render(workerDisplay))
-			}
-			fmt.Println(line)
 		}
 	}
 
@@ -1897,33 +2023,29 @@ func runConvoyStatus(cmd *cobra.Command, args []string) error {
 	return nil
 }
 
-func showAllConvoyStatus(townBeads string) error {
+func showAllConvoyStatus(townBeads, format string) error {
 	// List all convoy-type issues
 	out, err := runBdJSON(townBeads, "list", "--type=convoy", "--status=open", "--json")
 	if err != nil {
 		return fmt.Errorf("listing convoys: %w", err)
 	}
 
-	var convoys []struct {
-		ID     string   `json:"id"`
-		Title  string   `json:"title"`
-		Status string   `json:"status"`
-		Labels []string `json:"labels"`
-	}
+	var convoys []convoySummary
 	if err := json.Unmarshal(out, &convoys); err != nil {
 		return fmt.Errorf("parsing convoy list: %w", err)
 	}
 
+	if format == formatCSV {
+		return writeListing(os.Stdout, format, convoySummaryTable(convoys), convoys)
+	}
 	if len(convoys) == 0 {
 		fmt.Println("No active convoys.")
 		fmt.Println("Create a convoy with: gt convoy create <name> [issues...]")
 		return nil
 	}
 
-	if convoyStatusJSON {
-		enc := json.NewEncoder(os.Stdout)
-		enc.SetIndent("", "  ")
-		return enc.Encode(convoys)
+	if format != "" {
+		return writeListing(os.Stdout, format, convoySummaryTable(convoys), convoys)
 	}
 
 	fmt.Printf("%s\n\n", style.Bold.Render("Active Convoys"))
@@ -1939,6 +2061,54 @@ func showAllConvoyStatus(townBeads string) error {
 	return nil
 }
 
+// convoySummary is one active convoy as listed by gt convoy status.
+type convoySummary struct {
+	ID     string   `json:"id"`
+	Title  string   `json:"title"`
+	Status string   `json:"status"`
+	Labels []string `json:"labels"`
+}
+
+// convoySummaryTable lays out the active convoys for --format=table and csv.
+func convoySummaryTable(convoys []convoySummary) *style.Table {
+	tbl := style.NewTable(
+		style.Column{Name: "ID"},
+		style.Column{Name: "TITLE", Width: 50},
+		style.Column{Name: "STATUS"},
+		style.Column{Name: "OWNED"},
+	)
+	for _, c := range convoys {
+		tbl.AddRow(c.ID, c.Title, c.Status, formatYesNo(hasLabel(c.Labels, "gt:owned")))
+	}
+	return tbl
+}
+
+// trackedIssuesTable lays out a convoy's tracked issues for --format=table
+// and csv. STAGE is empty for unstaged convoys.
+func trackedIssuesTable(tracked []trackedIssueInfo) *style.Table {
+	tbl := style.NewTable(
+		style.Column{Name: "ID"},
+		style.Column{Name: "STATUS"},
+		style.Column{Name: "TYPE"},
+		style.Column{Name: "ASSIGNEE"},
+		style.Column{Name: "STAGE", Align: style.AlignRight},
+		style.Column{Name: "BLOCKED"},
+		style.Column{Name: "TITLE", Width: 50},
+	)
+	for _, t := range tracked {
+		stage := ""
+		if t.Stage > 0 {
+			stage = strconv.Itoa(t.Stage)
+		}
+		blocked := ""
+		if t.Blocked && t.Status != "closed" {
+			blocked = "yes"
+		}
+		tbl.AddRow(t.ID, style.Status(t.Status), t.IssueType, t.Assignee, stage, blocked, t.Title)
+	}
+	return tbl
+}
+
 func runConvoyList(cmd *cobra.Command, args []string) error {
 	townBeads, err := getTownBeadsDir()
 	if err != nil {
@@ -2114,6 +2284,32 @@ func hasLabel(labels []string, target string) bool { //nolint:unparam // target
 // convoyMergeFromFields extracts the merge strategy from a convoy description
 // using the typed ConvoyFields accessor.
 // Returns the strategy string ("direct", "mr", "local") or empty string if not set.
+// applyConvoyStages fills in each tracked issue's stage from the convoy's
+// stages field. Returns the active stage (0 once all are closed) and the
+// highest stage, or 0, 0 for a convoy without stages.
+func applyConvoyStages(tracked []trackedIssueInfo, description string) (active, last int) {
+	fields := beads.ParseConvoyFields(&beads.Issue{Description: description})
+	if fields == nil || fields.Stages == "" {
+		return 0, 0
+	}
+	statuses := make(map[string]string, len(tracked))
+	for _, t := range tracked {
+		statuses[t.ID] = t.Status
+	}
+	stages := convoyops.MemberStages(fields.Stages, statuses)
+	for i := range tracked {
+		ms := stages[tracked[i].ID]
+		tracked[i].Stage, tracked[i].Waiting = ms.Stage, ms.Waiting
+		if ms.Stage > last {
+			last = ms.Stage
+		}
+		if tracked[i].Status != "closed" && tracked[i].Status != "tombstone" && (active == 0 || ms.Stage < active) {
+			active = ms.Stage
+		}
+	}
+	return active, last
+}
+
 func convoyMergeFromFields(description string) string {
 	fields := beads.ParseConvoyFields(&beads.Issue{Description: description})
 	if fields == nil {
@@ -2143,6 +2339,92 @@ func formatConvoyStatus(status string) string {
 	}
 }
 
+// trackedStatusGroup is a run of tracked issues shown under one heading in
+// gt convoy status.
+type trackedStatusGroup struct {
+	Label  string
+	Issues []trackedIssueInfo
+}
+
+// groupTrackedByStatus buckets tracked issues for display: in-progress work
+// (in_progress, hooked) first, then open, then any other status, then closed.
+// Issue order within a group is preserved and empty groups are omitted.
+func groupTrackedByStatus(tracked []trackedIssueInfo) []trackedStatusGroup {
+	groups := []trackedStatusGroup{{Label: "In progress"}, {Label: "Open"}, {Label: "Other"}, {Label: "Closed"}}
+	for _, t := range tracked {
+		i := 2
+		switch t.Status {
+		case "in_progress", "hooked":
+			i = 0
+		case "open":
+			i = 1
+		case "closed":
+			i = 3
+		}
+		groups[i].Issues = append(groups[i].Issues, t)
+	}
+
+	result := groups[:0]
+	for _, g := range groups {
+		if len(g.Issues) > 0 {
+			result = append(result, g)
+		}
+	}
+	return result
+}
+
+// formatTrackedIssueLine renders one tracked issue for gt convoy status.
+func formatTrackedIssueLine(t trackedIssueInfo) string {
+	// Status symbol: ✓ closed, ▶ in_progress/hooked, ⊘ blocked, ○ other
+	status := "○"
+	switch {
+	case t.Status == "closed":
+		status = style.Closed.Render("✓")
+	case t.Status == "in_progress" || t.Status == "hooked":
+		status = style.InProgress.Render("▶")
+	case t.Blocked:
+		status = style.Blocked.Render("⊘")
+	}
+
+	// Show assignee in brackets (extract short name from path like gastown/polecats/goose -> goose)
+	bracketContent := t.IssueType
+	if t.Assignee != "" {
+		parts := strings.Split(t.Assignee, "/")
+		bracketContent = parts[len(parts)-1] // Last part of path
+	} else if bracketContent == "" {
+		bracketContent = "unassigned"
+	}
+
+	line := fmt.Sprintf("    %s %s: %s [%s]", status, t.ID, t.Title, bracketContent)
+	if t.Blocked && t.Status != "closed" {
+		line += "  " + style.Status("blocked")
+	}
+	if t.Stage > 0 {
+		stage := fmt.Sprintf("stage %d", t.Stage)
+		if t.Waiting && t.Status != "closed" {
+			stage += " (waiting)"
+		}
+		line += "  " + style.Dim.Render(stage)
+	}
+	if t.Worker != "" {
+		workerDisplay := "@" + t.Worker
+		if t.WorkerAge != "" {
+			workerDisplay += fmt.Sprintf(" (%s)", t.WorkerAge)
+		}
+		line += fmt.Sprintf("  %s", style.Dim.Render(workerDisplay))
+	}
+	return line
+}
+
+// percentComplete returns completed/total as a whole percentage, rounding
+// down so a convoy only shows 100% once every issue is closed.
+func percentComplete(completed, total int) int {
+	if total == 0 {
+		return 0
+	}
+	return completed * 100 / total
+}
+
 // trackedIssueInfo holds info about an issue being tracked by a convoy.
 type trackedIssueInfo struct {
 	ID        string   `json:"id"`
@@ -2150,11 +2432,13 @@ type trackedIssueInfo struct {
 	Status    string   `json:"status"`
 	Type      string   `json:"dependency_type"`
 	IssueType string   `json:"issue_type"`
-	Blocked   bool     `json:"blocked,omitempty"`    // True if issue currently has blockers
-	Assignee  string   `json:"assignee,omitempty"`   // Assigned agent (e.g., gastown/polecats/goose)
-	Labels    []string `json:"labels,omitempty"`     // Bead labels (propagated from trackedDependency)
-	Worker    string   `json:"worker,omitempty"`     // Worker currently assigned (e.g., gastown/nux)
-	WorkerAge string   `json:"worker_age,omitempty"` // How long worker has been on this issue
+	Blocked   bool     `json:"blocked,omitempty"`       // True if issue currently has blockers
+	Assignee  string   `json:"assignee,omitempty"`      // Assigned agent (e.g., gastown/polecats/goose)
+	Labels    []string `json:"labels,omitempty"`        // Bead labels (propagated from trackedDependency)
+	Worker    string   `json:"worker,omitempty"`        // Worker currently assigned (e.g., gastown/nux)
+	WorkerAge string   `json:"worker_age,omitempty"`    // How long worker has been on this issue
+	Stage     int      `json:"stage,omitempty"`         // Convoy stage, if the convoy is staged
+	Waiting   bool     `json:"stage_waiting,omitempty"` // Stage is held until an earlier stage closes
 }
 
 // trackedDependency is dep-list data enriched with fresh issue details.
@@ -0,0 +1,211 @@
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+
+	"github.com/spf13/cobra"
+	beadsdk "github.com/steveyegge/beads"
+	"github.com/steveyegge/gastown/internal/convoy"
+	"github.com/steveyegge/gastown/internal/doltserver"
+	"github.com/steveyegge/gastown/internal/style"
+)
+
+var (
+	convoyExportOut     string
+	convoyExportRig     string
+	convoyImportReplace bool
+	convoyImportDryRun  bool
+	convoyImportRig     string
+	convoyImportJSON    bool
+)
+
+func init() {
+	convoyExportCmd.Flags().StringVarP(&convoyExportOut, "out", "o", "", "Write the archive to this file instead of stdout")
+	convoyExportCmd.Flags().StringVar(&convoyExportRig, "rig", "", "Export a rig's beads instead of the town's (hq)")
+
+	convoyImportCmd.Flags().BoolVar(&convoyImportReplace, "replace", false, "Make the store hold exactly the archive, deleting issues it doesn't name")
+	convoyImportCmd.Flags().BoolVar(&convoyImportDryRun, "dry-run", false, "Validate the archive and report the changes without making them")
+	convoyImportCmd.Flags().StringVar(&convoyImportRig, "rig", "", "Import into a rig's beads instead of the town's (hq)")
+	convoyImportCmd.Flags().BoolVar(&convoyImportJSON, "json", false, "Output the import counts as JSON")
+
+	convoyCmd.AddCommand(convoyExportCmd)
+	convoyCmd.AddCommand(convoyImportCmd)
+}
+
+var convoyExportCmd = &cobra.Command{
+	Use:   "export",
+	Short: "Write the store's issues and convoys to a JSON archive",
+	Long: `Write every issue in the town's beads (or a rig's, with --rig) to a JSON
+archive, for backup or for moving work to another town.
+
+Each issue is saved with its status, assignee, priority, labels, and its
+dependencies: blockers, and for a convoy the issues it tracks. Closed
+issues are included; ephemeral wisps are not. Cross-rig references are kept
+as written (external:<prefix>:<id>). Reload an archive with gt convoy
+import.
+
+Examples:
+  gt convoy export --out town-backup.json
+  gt convoy export --rig gastown > gastown.json`,
+	Args:         cobra.NoArgs,
+	SilenceUsage: true,
+	RunE:         runConvoyExport,
+}
+
+var convoyImportCmd = &cobra.Command{
+	Use:   "import <file>",
+	Short: "Load issues and convoys from a JSON archive",
+	Long: `Load an archive written by gt convoy export into the town's beads (or a
+rig's, with --rig). Use - to read the archive from stdin.
+
+By default the archive is merged in: issues it names are created, or
+updated to match if they already exist (title, description, type, status,
+priority, assignee, and labels), and dependencies missing from the store
+are added. Issues not in the archive are left alone, and no dependency is
+removed. With --replace, the store ends up holding exactly the archive:
+dependencies the archive doesn't list are removed too, and issues not in
+the archive are deleted, last of all.
+
+The archive is validated first. Every issue needs a unique ID, and every
+blocker or tracked issue must be in the archive, in the store (when
+merging), or an external:<prefix>:<id> reference to another rig. Problems
+are all reported together and nothing is imported. Use --dry-run to run
+the check and see the counts without changing the store.
+
+The writes are not one transaction. If one fails partway, the issues
+written so far stay; with --replace nothing has been deleted yet. Fix the
+cause and run the same import again to finish it.
+
+Examples:
+  gt convoy import town-backup.json --dry-run
+  gt convoy import town-backup.json
+  gt convoy import --rig gastown --replace gastown.json`,
+	Args:         cobra.ExactArgs(1),
+	SilenceUsage: true,
+	RunE:         runConvoyImport,
+}
+
+func runConvoyExport(cmd *cobra.Command, args []string) error {
+	ctx := context.Background()
+	store, err := openBeadsStore(ctx, convoyExportRig)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = store.Close() }()
+
+	archive, err := convoy.ExportArchive(ctx, store)
+	if err != nil {
+		return err
+	}
+
+	if convoyExportOut == "" {
+		return writeArchive(os.Stdout, archive)
+	}
+	f, err := os.Create(convoyExportOut)
+	if err != nil {
+		return fmt.Errorf("creating %s: %w", convoyExportOut, err)
+	}
+	if err := writeArchive(f, archive); err != nil {
+		_ = f.Close()
+		return err
+	}
+	if err := f.Close(); err != nil {
+		return fmt.Errorf("writing %s: %w", convoyExportOut, err)
+	}
+	fmt.Printf("%s Exported %d issues to %s\n", style.Success.Render("✓"), len(archive.Issues), convoyExportOut)
+	return nil
+}
+
+// writeArchive encodes an archive as indented JSON.
+func writeArchive(w io.Writer, archive *convoy.Archive) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	if err := enc.Encode(archive); err != nil {
+		return fmt.Errorf("writing archive: %w", err)
+	}
+	return nil
+}
+
+func runConvoyImport(cmd *cobra.Command, args []string) error {
+	archive, err := readArchive(args[0])
+	if err != nil {
+		return err
+	}
+
+	ctx := context.Background()
+	store, err := openBeadsStore(ctx, convoyImportRig)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = store.Close() }()
+
+	result, err := convoy.ImportArchive(ctx, store, archive, convoy.ImportOptions{
+		Replace: convoyImportReplace,
+		DryRun:  convoyImportDryRun,
+		Actor:   dependencyActor(),
+	})
+	if err != nil {
+		return err
+	}
+
+	if convoyImportJSON {
+		enc := json.NewEncoder(os.Stdout)
+		enc.SetIndent("", "  ")
+		return enc.Encode(result)
+	}
+	printImportResult(result)
+	return nil
+}
+
+// readArchive decodes an archive from path, or from stdin for "-".
+func readArchive(path string) (*convoy.Archive, error) {
+	var r io.Reader = os.Stdin
+	if path != "-" {
+		f, err := os.Open(path)
+		if err != nil {
+			return nil, err
+		}
+		defer f.Close()
+		r = f
+	}
+	var archive convoy.Archive
+	if err := json.NewDecoder(r).Decode(&archive); err != nil {
+		return nil, fmt.Errorf("reading archive %s: %w", path, err)
+	}
+	return &archive, nil
+}
+
+// openBeadsStore opens the town's beads, or a rig's when rig is set.
+func openBeadsStore(ctx context.Context, rig string) (beadsdk.Storage, error) {
+	townRoot, err := getTownBeadsDir()
+	if err != nil {
+		return nil, err
+	}
+	dir := filepath.Join(townRoot, ".beads")
+	if rig != "" {
+		dir = doltserver.FindRigBeadsDir(townRoot, rig)
+	}
+	store, err := beadsdk.Open(ctx, dir)
+	if err != nil {
+		return nil, fmt.Errorf("opening beads at %s: %w", dir, err)
+	}
+	return store, nil
+}
+
+// printImportResult reports an import's counts.
+func printImportResult(r *convoy.ImportResult) {
+	verb, marker := "Imported", style.Success.Render("✓")
+	if r.DryRun {
+		verb, marker = "Would import", style.Dim.Render("○")
+	}
+	fmt.Printf("%s %s: %d created, %d updated, %d dependencies added", marker, verb, r.Created, r.Updated, r.Dependencies)
+	if r.Deleted > 0 {
+		fmt.Printf(", %d deleted first", r.Deleted)
+	}
+	fmt.Println()
+}
@@ -330,3 +330,59 @@ esac
 		t.Errorf("tracking helper issues = %q, want %q", got, "ag-95s.1,ag-95s.2")
 	}
 }
+
+func TestConvoyAdd_NormalizesIDsAndRejectsNonSlingable(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("skipping on windows - shell stubs")
+	}
+
+	townRoot, _ := makeRoutingTownWorkspace(t)
+	chdirConvoyTest(t, townRoot)
+
+	var helperIssues []string
+	oldAddTracking := addTrackingRelationFn
+	addTrackingRelationFn = func(townRoot, convoyID, issueID string) error {
+		helperIssues = append(helperIssues, issueID)
+		return nil
+	}
+	t.Cleanup(func() { addTrackingRelationFn = oldAddTracking })
+
+	writeRoutingBdStub(t, `
+case "$*" in
+  "show hq-cv-test --json")
+    echo '[{"id":"hq-cv-test","title":"Test Convoy","status":"open","issue_type":"convoy"}]'
+    ;;
+  "show gt-task gt-epic --json")
+    echo '[{"id":"gt-task","title":"Task","status":"open","issue_type":"task"},{"id":"gt-epic","title":"Epic","status":"open","issue_type":"epic"}]'
+    ;;
+  "show gt-task gt-mol --json")
+    echo '[{"id":"gt-task","title":"Task","status":"open","issue_type":"task"},{"id":"gt-mol","title":"Mol","status":"open","issue_type":"molecule"}]'
+    ;;
+  *)
+    echo "unexpected bd args: $*" >&2
+    exit 1
+    ;;
+esac
+`)
+
+	_, err := captureConvoyStdoutErr(t, func() error {
+		return runConvoyAdd(nil, []string{"external:hq:hq-cv-test", "gt-task", "external:gt:gt-epic"})
+	})
+	if err != nil {
+		t.Fatalf("runConvoyAdd: %v", err)
+	}
+	if got := strings.Join(helperIssues, ","); got != "gt-task,gt-epic" {
+		t.Errorf("tracked issues = %q, want %q", got, "gt-task,gt-epic")
+	}
+
+	helperIssues = nil
+	_, err = captureConvoyStdoutErr(t, func() error {
+		return runConvoyAdd(nil, []string{"hq-cv-test", "gt-task", "gt-mol"})
+	})
+	if err == nil || !strings.Contains(err.Error(), "gt-mol (molecule)") {
+		t.Fatalf("runConvoyAdd error = %v, want rejection of gt-mol", err)
+	}
+	if len(helperIssues) != 0 {
+		t.Errorf("tracked %v despite a rejected issue", helperIssues)
+	}
+}
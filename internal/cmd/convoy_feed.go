@@ -0,0 +1,548 @@
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net"
+	"net/http"
+	"os"
+	"os/exec"
+	"os/signal"
+	"path/filepath"
+	"syscall"
+	"time"
+
+	"github.com/spf13/cobra"
+	beadsdk "github.com/steveyegge/beads"
+	"github.com/steveyegge/gastown/internal/convoy"
+	"github.com/steveyegge/gastown/internal/session"
+	"github.com/steveyegge/gastown/internal/style"
+	"github.com/steveyegge/gastown/internal/tmux"
+)
+
+// convoy feed flags
+var (
+	convoyFeedDryRun    bool
+	convoyFeedJSON      bool
+	convoyFeedStrategy  string
+	convoyFeedRigStrat  string
+	convoyFeedMaxPerRig int
+	convoyFeedAssignee  string
+	convoyFeedLabels    []string
+	convoyFeedExclude   []string
+	convoyFeedOnce      bool
+	convoyFeedLimit     int
+	convoyFeedWatch     bool
+	convoyFeedInterval  time.Duration
+	convoyFeedMetrics   string
+)
+
+func init() {
+	convoyFeedCmd.Flags().BoolVar(&convoyFeedDryRun, "dry-run", false, "Show which issue would be dispatched and where, without slinging")
+	convoyFeedCmd.Flags().BoolVar(&convoyFeedJSON, "json", false, "Stream each dispatch decision to stdout as a JSON line")
+	convoyFeedCmd.Flags().StringVar(&convoyFeedStrategy, "strategy", string(convoy.FeedByPriority), "Candidate order: priority (most urgent first) or fifo (tracked order)")
+
+	convoyFeedCmd.Flags().StringVar(&convoyFeedRigStrat, "rig-strategy", "", "Rig choice when ready issues span rigs: order, least-loaded, round-robin (default: convoy.rig_strategy)")
+	convoyFeedCmd.Flags().IntVar(&convoyFeedMaxPerRig, "max-per-rig", -1, "Skip rigs with this many in-flight convoy issues (0 = no limit; default: convoy.max_per_rig)")
+	convoyFeedCmd.Flags().StringVar(&convoyFeedAssignee, "assignee", "", "Sling to this worker (rig/polecats/name or rig/crew/name) instead of the routed rig")
+	convoyFeedCmd.Flags().StringSliceVar(&convoyFeedLabels, "label", nil, "Only feed issues carrying this label (repeatable; all must match)")
+	convoyFeedCmd.Flags().StringSliceVar(&convoyFeedExclude, "exclude-label", nil, "Skip issues carrying this label (repeatable)")
+	convoyFeedCmd.MarkFlagsMutuallyExclusive("assignee", "rig-strategy")
+	convoyFeedCmd.MarkFlagsMutuallyExclusive("assignee", "max-per-rig")
+
+	convoyFeedCmd.Flags().BoolVar(&convoyFeedOnce, "once", false, "Run a single feed and exit (the default)")
+	convoyFeedCmd.Flags().IntVar(&convoyFeedLimit, "limit", 1, "Dispatch up to this many ready issues in this run")
+	convoyFeedCmd.Flags().BoolVar(&convoyFeedWatch, "watch", false, "Keep feeding ready issues on an interval until interrupted")
+	convoyFeedCmd.Flags().DurationVar(&convoyFeedInterval, "interval", 30*time.Second, "Polling interval for --watch")
+	convoyFeedCmd.Flags().StringVar(&convoyFeedMetrics, "metrics-addr", "", "Serve Prometheus metrics for --watch at this address (e.g. :9464), under /metrics")
+	convoyFeedCmd.MarkFlagsMutuallyExclusive("once", "watch")
+	convoyFeedCmd.MarkFlagsMutuallyExclusive("dry-run", "watch")
+	convoyFeedCmd.MarkFlagsMutuallyExclusive("limit", "watch")
+
+	convoyCmd.AddCommand(convoyFeedCmd)
+}
+
+var convoyFeedCmd = &cobra.Command{
+	Use:   "feed <convoy-id>",
+	Short: "Dispatch the next ready issue in a convoy",
+	Long: `Dispatch the next ready issue in a convoy via gt sling.
+
+Uses the same selection rules as the daemon's reactive feeding: the
+first open, unassigned, unblocked, slingable issue whose rig is not parked. At
+most one issue is dispatched unless --limit is given.
+
+--strategy controls which ready issue goes first: "priority" (default) picks
+the most urgent issue, breaking ties by ID; "fifo" takes issues in the order
+the convoy tracks them.
+
+Issues skipped because of unclosed blocking dependencies are listed
+separately; they are picked up by a later feed once their blockers close.
+
+When ready issues route to different rigs, --rig-strategy decides which rig
+gets the next dispatch: "order" takes the first candidate, "least-loaded"
+prefers the rig with the fewest in-flight issues from this convoy, and
+"round-robin" rotates between rigs. With --max-per-rig, rigs already at the
+limit are skipped; if every candidate's rig is full, nothing is dispatched
+and the command reports that no rig is available.
+
+--assignee sends the dispatch to a specific worker instead of the rig the
+issue routes to: gt sling is given the address (rig/polecats/name, or
+rig/crew/name for a crew member) and the cross-rig guard is overridden.
+The worker must have a running tmux session. Blocked, staged, and
+non-slingable issues are still passed over; --rig-strategy and --max-per-rig
+do not apply. A forced dispatch is reported as such, and its JSON result and
+issue_dispatched event carry an "assignee" field.
+
+--label and --exclude-label let a feeder specialize: only issues carrying
+every --label and none of the --exclude-label labels are fed. Other issues
+are skipped (reason "missing label" or "excluded label") and left for
+another feeder. Both flags repeat or take comma-separated lists.
+
+With --dry-run, the routing decision is printed but nothing is slung. Use
+this to debug where an issue would go.
+
+With --json, stdout is JSON lines for automation. Each decision is written
+as it is made, in the convoy event format (see convoy.event_log) with a
+"type" of issue_dispatched, issue_skipped (with a "reason"), issue_handled,
+or capacity_throttled; dry-run decisions carry "dry_run": true. The last
+line is the run's result: the feed result, the --limit batch, or on exit
+from --watch the list of dispatches. Works with --watch and --dry-run.
+
+--limit N feeds up to N ready issues in one run, stopping early when none is
+left or no rig has room under --max-per-rig, and reports how many went out.
+With --dry-run it lists the next N issues that would be dispatched, counting
+each against its rig's capacity as a real dispatch would.
+
+By default (or with --once) a single feed runs and the command exits, which
+suits cron jobs and manual stepping. With --watch, the convoy is fed every
+--interval until Ctrl+C: each round dispatches ready issues until none is
+left or no rig has room, so work goes out as polecats free up. On Ctrl+C or
+SIGTERM no new dispatch starts, a gt sling already running is allowed to
+finish, pending convoy events are flushed, and the command exits 0 after
+printing a summary of everything dispatched.
+
+With --metrics-addr, --watch serves Prometheus metrics at /metrics on that
+address: counters for issues dispatched, ready issues skipped as blocked or
+for rig capacity, and failed slings, plus gauges for the ready queue left
+after the last feed and the convoy's busy polecats. Every series carries a
+convoy label. Alert on a stalled convoy with, for example, ready issues and
+no dispatches over a window. Off by default.
+
+When convoy.timeouts sets limits, --watch also runs a watchdog before each
+round: an in-progress issue assigned longer than its type's limit is
+reported (action "warn"), reopened for re-slinging ("reassign"), or has its
+polecat interrupted with Ctrl-C and is then reopened ("kill"). Each timeout
+emits an issue_timed_out convoy event.
+
+Examples:
+  gt convoy feed hq-cv-abc
+  gt convoy feed hq-cv-abc --watch --interval=1m --max-per-rig=2
+  gt convoy feed hq-cv-abc --watch --metrics-addr=127.0.0.1:9464
+  gt convoy feed hq-cv-abc --dry-run
+  gt convoy feed hq-cv-abc --limit 5 --max-per-rig=2
+  gt convoy feed hq-cv-abc --strategy fifo
+  gt convoy feed hq-cv-abc --assignee gastown/polecats/Toast
+  gt convoy feed hq-cv-abc --watch --label frontend --exclude-label urgent
+  gt convoy feed hq-cv-abc --dry-run --json
+  gt convoy feed hq-cv-abc --watch --json | jq -c 'select(.type == "issue_skipped")'`,
+	Args:         cobra.ExactArgs(1),
+	SilenceUsage: true,
+	RunE:         runConvoyFeed,
+}
+
+func runConvoyFeed(cmd *cobra.Command, args []string) error {
+	convoyID := args[0]
+	strategy, err := convoy.ParseFeedStrategy(convoyFeedStrategy)
+	if err != nil {
+		return err
+	}
+	if convoyFeedLimit < 1 {
+		return fmt.Errorf("--limit must be at least 1")
+	}
+	if convoyFeedMetrics != "" && !convoyFeedWatch {
+		return fmt.Errorf("--metrics-addr requires --watch")
+	}
+
+	townRoot, err := getTownBeadsDir()
+	if err != nil {
+		return err
+	}
+
+	ctx := context.Background()
+	store, err := beadsdk.Open(ctx, filepath.Join(townRoot, ".beads"))
+	if err != nil {
+		return fmt.Errorf("opening town beads: %w", err)
+	}
+	defer func() { _ = store.Close() }()
+
+	gtPath, err := os.Executable()
+	if err != nil {
+		if gtPath, err = exec.LookPath("gt"); err != nil {
+			return fmt.Errorf("locating gt binary: %w", err)
+		}
+	}
+
+	var logger func(format string, args ...interface{})
+	if !convoyFeedJSON {
+		logger = func(format string, args ...interface{}) {
+			fmt.Fprintln(os.Stderr, style.RenderStderr(style.Dim, fmt.Sprintf(format, args...)))
+		}
+	}
+	isRigParked := func(rigName string) bool { return IsRigParked(townRoot, rigName) }
+
+	opts := convoy.LoadFeedOptions(townRoot)
+	opts.DryRun = convoyFeedDryRun
+	opts.Strategy = strategy
+	if convoyFeedRigStrat != "" {
+		if opts.RigStrategy, err = convoy.ParseRigStrategy(convoyFeedRigStrat); err != nil {
+			return err
+		}
+	}
+	if convoyFeedMaxPerRig >= 0 {
+		opts.MaxPerRig = convoyFeedMaxPerRig
+	}
+	opts.Labels = convoy.LabelSelector{Include: convoyFeedLabels, Exclude: convoyFeedExclude}
+	if convoyFeedAssignee != "" {
+		if err := checkFeedAssignee(tmux.NewTmux(), convoyFeedAssignee); err != nil {
+			return err
+		}
+		opts.Assignee = convoyFeedAssignee
+	}
+	if convoyFeedLimit > 1 {
+		opts.Pending = make(map[string]string)
+	}
+	if convoyFeedJSON {
+		opts.Decisions = feedDecisionStream{enc: json.NewEncoder(os.Stdout)}
+	}
+	var metrics *convoy.FeedMetrics
+	if convoyFeedMetrics != "" {
+		metrics = &convoy.FeedMetrics{ConvoyID: convoyID}
+		if opts.Decisions != nil {
+			opts.Decisions = convoy.MultiSink{opts.Decisions, metrics}
+		} else {
+			opts.Decisions = metrics
+		}
+	}
+	// --watch feeds under a context that SIGINT/SIGTERM cancel: no new
+	// dispatch starts after the signal, but one under way finishes (Drain)
+	// so its issue isn't left half-claimed.
+	feedCtx := ctx
+	if convoyFeedWatch {
+		var stop context.CancelFunc
+		feedCtx, stop = signal.NotifyContext(ctx, os.Interrupt, syscall.SIGTERM)
+		defer stop()
+		opts.Drain = true
+	}
+	feed := func() *convoy.FeedResult {
+		result := convoy.FeedConvoy(feedCtx, store, townRoot, convoyID, "Feed", logger, gtPath, isRigParked, opts, nil)
+		if metrics != nil {
+			metrics.Observe(result)
+		}
+		return result
+	}
+	if convoyFeedWatch {
+		if metrics != nil {
+			stop, err := serveFeedMetrics(convoyFeedMetrics, metrics)
+			if err != nil {
+				return err
+			}
+			defer stop()
+		}
+		var watchdog func()
+		if policy := convoy.LoadTimeoutPolicy(townRoot); policy.Enabled() {
+			wd := &convoy.TimeoutWatchdog{Policy: policy, Handler: convoyTimeoutHandler{t: tmux.NewTmux()}}
+			watchdog = func() { wd.Check(feedCtx, store, townRoot, convoyID, time.Now(), logger, nil) }
+		}
+		return runConvoyFeedWatch(feedCtx, convoyID, feed, watchdog)
+	}
+	if convoyFeedLimit > 1 {
+		return printConvoyFeedBatch(convoyID, feedBatch(feed, convoyFeedLimit, opts.Pending), opts.MaxPerRig)
+	}
+	result := feed()
+
+	if convoyFeedJSON {
+		return json.NewEncoder(os.Stdout).Encode(result)
+	}
+
+	switch {
+	case result.NoRigAvailable:
+		fmt.Printf("No rig available in convoy %s: every ready issue's rig is at the in-flight limit (%d).\n", convoyID, opts.MaxPerRig)
+	case result.IssueID == "":
+		fmt.Printf("No ready issues to feed in convoy %s.\n", convoyID)
+	case result.DryRun:
+		fmt.Printf("Would dispatch %s to %s\n", style.Bold.Render(result.IssueID), feedTarget(result.Rig, result.Assignee))
+	default:
+		fmt.Printf("%s Dispatched %s to %s\n", style.Success.Render("✓"), style.Bold.Render(result.IssueID), feedTarget(result.Rig, result.Assignee))
+	}
+	printFeedPassedOver(result.Handled, result.Blocked, result.Cycles, result.DryRun)
+	return nil
+}
+
+// checkFeedAssignee validates a --assignee address and requires the worker's
+// tmux session to be running, so a forced dispatch doesn't go to nobody.
+func checkFeedAssignee(t *tmux.Tmux, assignee string) error {
+	rig, name, crew, ok := convoy.ParseFeedAssignee(assignee)
+	if !ok {
+		return fmt.Errorf("invalid --assignee %q: want rig/polecats/name or rig/crew/name", assignee)
+	}
+	sessionName := session.PolecatSessionName(session.PrefixFor(rig), name)
+	if crew {
+		sessionName = session.CrewSessionName(session.PrefixFor(rig), name)
+	}
+	running, err := t.HasSession(sessionName)
+	if err != nil {
+		return fmt.Errorf("checking session for %s: %w", assignee, err)
+	}
+	if !running {
+		return fmt.Errorf("assignee %s has no running session (%s)", assignee, sessionName)
+	}
+	return nil
+}
+
+// feedTarget describes where a dispatch went: the rig, or the worker when
+// --assignee forced it.
+func feedTarget(rig, assignee string) string {
+	if assignee != "" {
+		return assignee + " " + style.Dim.Render("(forced by --assignee)")
+	}
+	return rig
+}
+
+// printFeedPassedOver lists the issues a feed handled itself or skipped as
+// blocked, then any dependency cycles that keep blocked issues from ever
+// becoming ready.
+func printFeedPassedOver(handled, blocked []string, cycles [][]string, dryRun bool) {
+	for _, id := range handled {
+		note := "(message, decision, or event: handled and closed)"
+		if dryRun {
+			note = "(message, decision, or event: would be handled and closed)"
+		}
+		fmt.Printf("  %s %s %s\n", style.Success.Render("✓"), id, style.Dim.Render(note))
+	}
+	for _, id := range blocked {
+		fmt.Printf("  %s %s %s\n", style.Warning.Render("⊘"), id, style.Dim.Render("(blocked by open dependencies)"))
+	}
+	for _, c := range cycles {
+		fmt.Printf("%s Dependency cycle: %s\n", style.ErrorPrefix, convoy.FormatCycle(c))
+		fmt.Printf("  %s\n", style.Dim.Render("These issues block each other and will never be fed; remove one dependency (bd dep remove) to break it."))
+	}
+}
+
+// convoyFeedBatch is the outcome of gt convoy feed --limit.
+type convoyFeedBatch struct {
+	Dispatched     []convoyFeedDispatch `json:"dispatched"`
+	Limit          int                  `json:"limit"`
+	DryRun         bool                 `json:"dry_run"`
+	Handled        []string             `json:"handled,omitempty"`
+	Blocked        []string             `json:"blocked,omitempty"`
+	Cycles         [][]string           `json:"cycles,omitempty"`
+	NoRigAvailable bool                 `json:"no_rig_available,omitempty"`
+}
+
+// feedBatch feeds up to limit issues, stopping when nothing is ready, no rig
+// has room, or an issue comes back twice (its sling didn't take). A dry run
+// changes nothing between feeds, so each pick goes into pending for the
+// following feeds to skip and count against its rig.
+func feedBatch(feed func() *convoy.FeedResult, limit int, pending map[string]string) *convoyFeedBatch {
+	batch := &convoyFeedBatch{Dispatched: make([]convoyFeedDispatch, 0), Limit: limit}
+	seen := make(map[string]bool)
+	handled := make(map[string]bool)
+	for len(batch.Dispatched) < limit {
+		result := feed()
+		batch.DryRun = result.DryRun
+		batch.Blocked = result.Blocked
+		batch.Cycles = result.Cycles
+		for _, id := range result.Handled {
+			if !handled[id] {
+				handled[id] = true
+				batch.Handled = append(batch.Handled, id)
+			}
+		}
+		if result.NoRigAvailable {
+			batch.NoRigAvailable = true
+			break
+		}
+		if result.IssueID == "" || seen[result.IssueID] {
+			break
+		}
+		seen[result.IssueID] = true
+		batch.Dispatched = append(batch.Dispatched, convoyFeedDispatch{IssueID: result.IssueID, Rig: result.Rig, Assignee: result.Assignee, At: time.Now()})
+		if result.DryRun && pending != nil {
+			pending[result.IssueID] = result.Rig
+		}
+	}
+	return batch
+}
+
+func printConvoyFeedBatch(convoyID string, batch *convoyFeedBatch, maxPerRig int) error {
+	if convoyFeedJSON {
+		return json.NewEncoder(os.Stdout).Encode(batch)
+	}
+
+	for _, d := range batch.Dispatched {
+		if batch.DryRun {
+			fmt.Printf("Would dispatch %s to %s\n", style.Bold.Render(d.IssueID), feedTarget(d.Rig, d.Assignee))
+		} else {
+			fmt.Printf("%s Dispatched %s to %s\n", style.Success.Render("✓"), style.Bold.Render(d.IssueID), feedTarget(d.Rig, d.Assignee))
+		}
+	}
+	verb := "Dispatched"
+	if batch.DryRun {
+		verb = "Would dispatch"
+	}
+	fmt.Printf("%s %d of up to %d issue(s) from convoy %s", verb, len(batch.Dispatched), batch.Limit, convoyID)
+	switch {
+	case batch.NoRigAvailable:
+		fmt.Printf(": stopped, every ready issue's rig is at the in-flight limit (%d).\n", maxPerRig)
+	case len(batch.Dispatched) < batch.Limit:
+		fmt.Printf(": no more ready issues.\n")
+	default:
+		fmt.Printf(".\n")
+	}
+	printFeedPassedOver(batch.Handled, batch.Blocked, batch.Cycles, batch.DryRun)
+	return nil
+}
+
+// serveFeedMetrics serves metrics at /metrics on addr until the returned
+// stop function is called. Listening happens up front so a bad or busy
+// address fails the command instead of a background goroutine.
+func serveFeedMetrics(addr string, metrics *convoy.FeedMetrics) (stop func(), err error) {
+	ln, err := net.Listen("tcp", addr)
+	if err != nil {
+		return nil, fmt.Errorf("--metrics-addr: %w", err)
+	}
+	mux := http.NewServeMux()
+	mux.Handle("/metrics", metrics)
+	server := &http.Server{
+		Handler:           mux,
+		ReadHeaderTimeout: 10 * time.Second,
+		WriteTimeout:      10 * time.Second,
+	}
+	go func() {
+		if err := server.Serve(ln); err != nil && err != http.ErrServerClosed {
+			style.PrintWarning("metrics server: %v", err)
+		}
+	}()
+	if !convoyFeedJSON {
+		fmt.Printf("Serving metrics at http://%s/metrics\n", ln.Addr())
+	}
+	return func() {
+		shutCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		_ = server.Shutdown(shutCtx)
+	}, nil
+}
+
+// convoyFeedDispatch is one issue sent out by gt convoy feed --watch.
+type convoyFeedDispatch struct {
+	IssueID  string    `json:"issue_id"`
+	Rig      string    `json:"rig"`
+	Assignee string    `json:"assignee,omitempty"`
+	At       time.Time `json:"at"`
+}
+
+// runConvoyFeedWatch feeds the convoy every --interval until ctx is
+// cancelled, then prints what it dispatched and returns nil; main flushes
+// the event sink on the way out. A non-nil watchdog runs before each round,
+// so issues it reopens are fed again in the same round.
+func runConvoyFeedWatch(ctx context.Context, convoyID string, feed func() *convoy.FeedResult, watchdog func()) error {
+	if convoyFeedInterval <= 0 {
+		return fmt.Errorf("--interval must be positive")
+	}
+
+	ticker := time.NewTicker(convoyFeedInterval)
+	defer ticker.Stop()
+
+	if !convoyFeedJSON {
+		fmt.Printf("Feeding convoy %s every %s (Ctrl+C to stop)\n", convoyID, convoyFeedInterval)
+	}
+
+	dispatched := make([]convoyFeedDispatch, 0)
+	for {
+		if watchdog != nil && ctx.Err() == nil {
+			watchdog()
+		}
+		dispatched = append(dispatched, feedRound(ctx, feed)...)
+
+		select {
+		case <-ctx.Done():
+			return printConvoyFeedSummary(convoyID, dispatched)
+		case <-ticker.C:
+		}
+	}
+}
+
+// feedRound dispatches ready issues until a feed sends nothing out or ctx is
+// cancelled. An issue fed twice in one round means the sling didn't take (it
+// is still open and unassigned), so the round stops rather than spinning on
+// it.
+func feedRound(ctx context.Context, feed func() *convoy.FeedResult) []convoyFeedDispatch {
+	var round []convoyFeedDispatch
+	seen := make(map[string]bool)
+	for {
+		if ctx.Err() != nil {
+			return round
+		}
+		result := feed()
+		if result.IssueID == "" || result.DryRun || seen[result.IssueID] {
+			return round
+		}
+		seen[result.IssueID] = true
+		d := convoyFeedDispatch{IssueID: result.IssueID, Rig: result.Rig, Assignee: result.Assignee, At: time.Now()}
+		round = append(round, d)
+		if !convoyFeedJSON {
+			fmt.Printf("%s %s Dispatched %s to %s\n", style.Dim.Render(d.At.Format("15:04:05")),
+				style.Success.Render("✓"), style.Bold.Render(d.IssueID), feedTarget(d.Rig, d.Assignee))
+		}
+	}
+}
+
+// feedDecisionStream writes feed decisions to stdout as JSON lines for
+// gt convoy feed --json.
+type feedDecisionStream struct {
+	enc *json.Encoder
+}
+
+// Emit implements convoy.EventSink.
+func (s feedDecisionStream) Emit(e convoy.Event) {
+	_ = s.enc.Encode(e)
+}
+
+// convoyTimeoutHandler carries out convoy.timeouts actions with tmux and bd.
+type convoyTimeoutHandler struct {
+	t *tmux.Tmux
+}
+
+// Interrupt implements convoy.TimeoutHandler.
+func (h convoyTimeoutHandler) Interrupt(assignee string) error {
+	sessionName, err := resolveRoleToSession(assignee)
+	if err != nil {
+		return err
+	}
+	return h.t.SendKeysRaw(sessionName, "C-c")
+}
+
+// Reopen implements convoy.TimeoutHandler.
+func (h convoyTimeoutHandler) Reopen(issueID string) error {
+	return BdCmd("update", issueID, "--status=open", "--unassign").
+		Dir(resolveBeadDir(issueID)).
+		WithAutoCommit().
+		Run()
+}
+
+func printConvoyFeedSummary(convoyID string, dispatched []convoyFeedDispatch) error {
+	if convoyFeedJSON {
+		return json.NewEncoder(os.Stdout).Encode(dispatched)
+	}
+
+	fmt.Printf("\nStopped feeding convoy %s: dispatched %d issue(s)\n", convoyID, len(dispatched))
+	for _, d := range dispatched {
+		target := d.Rig
+		if d.Assignee != "" {
+			target = d.Assignee
+		}
+		fmt.Printf("  %s → %s\n", d.IssueID, target)
+	}
+	return nil
+}
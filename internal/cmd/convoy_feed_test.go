@@ -0,0 +1,140 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/steveyegge/gastown/internal/convoy"
+)
+
+func TestFeedRound(t *testing.T) {
+	oldJSON := convoyFeedJSON
+	convoyFeedJSON = true // silence per-dispatch output
+	t.Cleanup(func() { convoyFeedJSON = oldJSON })
+
+	tests := []struct {
+		name    string
+		results []convoy.FeedResult
+		want    []string
+	}{
+		{"drains ready issues", []convoy.FeedResult{{IssueID: "gt-1", Rig: "gastown"}, {IssueID: "bd-1", Rig: "beads"}, {}}, []string{"gt-1", "bd-1"}},
+		{"nothing ready", []convoy.FeedResult{{}}, nil},
+		{"stops when no rig has room", []convoy.FeedResult{{IssueID: "gt-1", Rig: "gastown"}, {NoRigAvailable: true}}, []string{"gt-1"}},
+		{"stops on repeated issue", []convoy.FeedResult{{IssueID: "gt-1", Rig: "gastown"}, {IssueID: "gt-1", Rig: "gastown"}}, []string{"gt-1"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			calls := 0
+			feed := func() *convoy.FeedResult {
+				if calls >= len(tt.results) {
+					t.Fatalf("feed called %d times, want at most %d", calls+1, len(tt.results))
+				}
+				r := tt.results[calls]
+				calls++
+				return &r
+			}
+			var got []string
+			for _, d := range feedRound(context.Background(), feed) {
+				got = append(got, d.IssueID)
+			}
+			if len(got) != len(tt.want) {
+				t.Fatalf("feedRound() = %v, want %v", got, tt.want)
+			}
+			for i := range got {
+				if got[i] != tt.want[i] {
+					t.Errorf("feedRound()[%d] = %s, want %s", i, got[i], tt.want[i])
+				}
+			}
+		})
+	}
+}
+
+func TestFeedRound_StopsOnCancel(t *testing.T) {
+	oldJSON := convoyFeedJSON
+	convoyFeedJSON = true
+	t.Cleanup(func() { convoyFeedJSON = oldJSON })
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	calls := 0
+	feed := func() *convoy.FeedResult {
+		calls++
+		if calls == 1 {
+			cancel() // the signal arrives while the first dispatch runs
+		}
+		return &convoy.FeedResult{IssueID: fmt.Sprintf("gt-%d", calls), Rig: "gastown"}
+	}
+
+	round := feedRound(ctx, feed)
+	if calls != 1 || len(round) != 1 || round[0].IssueID != "gt-1" {
+		t.Errorf("feedRound() = %+v after %d feed(s), want only the in-flight gt-1", round, calls)
+	}
+	if round := feedRound(ctx, feed); len(round) != 0 || calls != 1 {
+		t.Errorf("feedRound() on a cancelled context fed %+v, want nothing", round)
+	}
+}
+
+func TestFeedBatch(t *testing.T) {
+	tests := []struct {
+		name    string
+		limit   int
+		results []convoy.FeedResult
+		want    []string
+		noRoom  bool
+	}{
+		{"stops at limit", 2, []convoy.FeedResult{{IssueID: "gt-1", Rig: "gastown"}, {IssueID: "gt-2", Rig: "gastown"}}, []string{"gt-1", "gt-2"}, false},
+		{"stops when none ready", 5, []convoy.FeedResult{{IssueID: "gt-1", Rig: "gastown"}, {}}, []string{"gt-1"}, false},
+		{"stops when no rig has room", 5, []convoy.FeedResult{{IssueID: "gt-1", Rig: "gastown"}, {NoRigAvailable: true}}, []string{"gt-1"}, true},
+		{"stops on repeated issue", 5, []convoy.FeedResult{{IssueID: "gt-1", Rig: "gastown"}, {IssueID: "gt-1", Rig: "gastown"}}, []string{"gt-1"}, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			calls := 0
+			feed := func() *convoy.FeedResult {
+				if calls >= len(tt.results) {
+					t.Fatalf("feed called %d times, want at most %d", calls+1, len(tt.results))
+				}
+				r := tt.results[calls]
+				calls++
+				return &r
+			}
+			batch := feedBatch(feed, tt.limit, nil)
+			var got []string
+			for _, d := range batch.Dispatched {
+				got = append(got, d.IssueID)
+			}
+			if strings.Join(got, ",") != strings.Join(tt.want, ",") {
+				t.Errorf("Dispatched = %v, want %v", got, tt.want)
+			}
+			if batch.NoRigAvailable != tt.noRoom {
+				t.Errorf("NoRigAvailable = %v, want %v", batch.NoRigAvailable, tt.noRoom)
+			}
+		})
+	}
+}
+
+func TestFeedBatch_DryRunRecordsPending(t *testing.T) {
+	pending := make(map[string]string)
+	backlog := []string{"gt-1", "gt-2", "gt-3"}
+	feed := func() *convoy.FeedResult {
+		for _, id := range backlog {
+			if _, ok := pending[id]; !ok {
+				return &convoy.FeedResult{IssueID: id, Rig: "gastown", DryRun: true, Handled: []string{"gt-msg"}}
+			}
+		}
+		return &convoy.FeedResult{DryRun: true}
+	}
+
+	batch := feedBatch(feed, 2, pending)
+	if len(batch.Dispatched) != 2 || batch.Dispatched[1].IssueID != "gt-2" {
+		t.Fatalf("Dispatched = %+v, want gt-1 then gt-2", batch.Dispatched)
+	}
+	if !batch.DryRun || len(pending) != 2 {
+		t.Errorf("DryRun = %v, pending = %v; want a dry run with two pending picks", batch.DryRun, pending)
+	}
+	if len(batch.Handled) != 1 {
+		t.Errorf("Handled = %v, want gt-msg once", batch.Handled)
+	}
+}
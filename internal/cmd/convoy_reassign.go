@@ -0,0 +1,125 @@
+package cmd
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/steveyegge/gastown/internal/beads"
+	"github.com/steveyegge/gastown/internal/cli"
+	"github.com/steveyegge/gastown/internal/convoy"
+	"github.com/steveyegge/gastown/internal/style"
+	"github.com/steveyegge/gastown/internal/tmux"
+)
+
+var convoyReassignNotifyOld bool
+
+func init() {
+	convoyReassignCmd.Flags().BoolVar(&convoyReassignNotifyOld, "notify-old", false, "Nudge the previous assignee's session that the issue was moved")
+
+	convoyCmd.AddCommand(convoyReassignCmd)
+}
+
+var convoyReassignCmd = &cobra.Command{
+	Use:   "reassign <issue-id> <target>",
+	Short: "Move an issue to a different agent",
+	Long: `Move an issue from its current assignee to another running agent.
+
+The target is an agent address or role (e.g. gastown/polecats/bravo). It must
+have a live tmux session. The issue is hooked to the target, its assigned_at
+timestamp is reset, and the target is nudged to pick it up. With
+--notify-old, the previous assignee's session is told the work moved.
+
+Only slingable issue types can be reassigned (see convoy.slingable_types).
+
+Examples:
+  gt convoy reassign gt-abc gastown/polecats/bravo
+  gt convoy reassign gt-abc gastown/polecats/bravo --notify-old`,
+	Args:         cobra.ExactArgs(2),
+	SilenceUsage: true,
+	RunE:         runConvoyReassign,
+}
+
+func runConvoyReassign(cmd *cobra.Command, args []string) error {
+	issueID := beads.ExtractIssueID(args[0])
+
+	info, err := getBeadInfo(issueID)
+	if err != nil {
+		return err
+	}
+
+	sessionName, err := resolveRoleToSession(args[1])
+	if err != nil {
+		return fmt.Errorf("resolving target %s: %w", args[1], err)
+	}
+	t := tmux.NewTmux()
+	if ok, err := t.HasSession(sessionName); err != nil {
+		return fmt.Errorf("checking session %s: %w", sessionName, err)
+	} else if !ok {
+		return fmt.Errorf("target %s has no running session (%s)", args[1], sessionName)
+	}
+	newAssignee := sessionToAgentID(sessionName)
+	oldAssignee := info.Assignee
+	if err := checkReassignable(issueID, info, newAssignee); err != nil {
+		return err
+	}
+
+	if err := BdCmd("update", issueID, "--status=hooked", "--assignee="+newAssignee).
+		Dir(resolveBeadDir(issueID)).
+		StripBeadsDir().
+		WithAutoCommit().
+		Run(); err != nil {
+		return fmt.Errorf("updating %s: %w", issueID, err)
+	}
+	if err := storeFieldsInBead(issueID, beadFieldUpdates{AssignedAt: time.Now().UTC().Format(time.RFC3339)}); err != nil {
+		style.PrintWarning("could not reset assigned_at on %s: %v", issueID, err)
+	}
+
+	msg := fmt.Sprintf("Work reassigned to you: %s (%s). Run `"+cli.Name()+" hook` to see it, then begin.", issueID, info.Title)
+	if err := t.NudgeSession(sessionName, msg); err != nil {
+		style.PrintWarning("could not nudge %s: %v", newAssignee, err)
+	}
+
+	if convoyReassignNotifyOld && oldAssignee != "" {
+		notifyReassignedAway(t, oldAssignee, issueID, newAssignee)
+	}
+
+	before := oldAssignee
+	if before == "" {
+		before = "unassigned"
+	}
+	fmt.Printf("%s Reassigned %s: %s → %s\n", style.Success.Render("✓"), style.Bold.Render(issueID), before, newAssignee)
+	return nil
+}
+
+// checkReassignable refuses to move an issue that isn't a slingable type, is
+// already closed, or already belongs to newAssignee.
+func checkReassignable(issueID string, info *beadInfo, newAssignee string) error {
+	if !convoy.IsSlingableType(info.IssueType) {
+		return fmt.Errorf("%s is a %s, which is not slingable and cannot be reassigned", issueID, info.IssueType)
+	}
+	if info.Status == "closed" {
+		return fmt.Errorf("%s is already closed", issueID)
+	}
+	if info.Assignee == newAssignee {
+		return fmt.Errorf("%s is already assigned to %s", issueID, newAssignee)
+	}
+	return nil
+}
+
+// notifyReassignedAway tells the previous assignee to stop working an issue.
+// Failures are warnings: the reassignment itself has already happened.
+func notifyReassignedAway(t *tmux.Tmux, oldAssignee, issueID, newAssignee string) {
+	sessionName, err := resolveRoleToSession(oldAssignee)
+	if err != nil {
+		style.PrintWarning("could not resolve session for %s: %v", oldAssignee, err)
+		return
+	}
+	if ok, _ := t.HasSession(sessionName); !ok {
+		return // old session is gone; nothing to tell
+	}
+	msg := fmt.Sprintf("%s was reassigned to %s. Stop working on it.", issueID, newAssignee)
+	if err := t.NudgeSession(sessionName, msg); err != nil {
+		style.PrintWarning("could not nudge %s: %v", oldAssignee, err)
+	}
+}
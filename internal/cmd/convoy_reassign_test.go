@@ -0,0 +1,34 @@
+package cmd
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestCheckReassignable(t *testing.T) {
+	tests := []struct {
+		name    string
+		info    *beadInfo
+		wantErr string
+	}{
+		{"open task", &beadInfo{Status: "open", IssueType: "task", Assignee: "gastown/polecats/alpha"}, ""},
+		{"unassigned bug", &beadInfo{Status: "hooked", IssueType: "bug"}, ""},
+		{"not slingable", &beadInfo{Status: "open", IssueType: "convoy"}, "not slingable"},
+		{"closed", &beadInfo{Status: "closed", IssueType: "task"}, "already closed"},
+		{"same assignee", &beadInfo{Status: "hooked", IssueType: "task", Assignee: "gastown/polecats/bravo"}, "already assigned"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := checkReassignable("gt-abc", tt.info, "gastown/polecats/bravo")
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Fatalf("checkReassignable() error = %v, want nil", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Fatalf("checkReassignable() error = %v, want one containing %q", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,169 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/steveyegge/gastown/internal/beads"
+	"github.com/steveyegge/gastown/internal/config"
+	"github.com/steveyegge/gastown/internal/convoy"
+	"github.com/steveyegge/gastown/internal/style"
+	"github.com/steveyegge/gastown/internal/workspace"
+)
+
+var convoyRoutesJSON bool
+
+func init() {
+	convoyRoutesCmd.Flags().BoolVar(&convoyRoutesJSON, "json", false, "Output as JSON")
+
+	convoyCmd.AddCommand(convoyRoutesCmd)
+}
+
+var convoyRoutesCmd = &cobra.Command{
+	Use:   "routes",
+	Short: "Show which rig convoy feeds send each issue to",
+	Long: `Show the effective routing convoy feeds use to pick a rig.
+
+Type routes (convoy.type_routes) are checked first: an issue whose type has a
+rule goes to that rig. All other issues go to the rig their ID prefix maps to
+in .beads/routes.jsonl. The rig strategy and per-rig limit then decide which
+rig receives the next dispatch, but never change an issue's rig.
+
+Warnings flag type routes to unregistered rigs and rules for types convoys
+never dispatch.
+
+Examples:
+  gt convoy routes
+  gt config set convoy.type_routes bug=firefighter,feature=build
+  gt convoy routes --json`,
+	Args:         cobra.NoArgs,
+	SilenceUsage: true,
+	RunE:         runConvoyRoutes,
+}
+
+// convoyRoute is one routing rule: an issue type or ID prefix and its rig.
+// Rig is empty for town-level prefixes, which convoys never dispatch.
+type convoyRoute struct {
+	Match   string `json:"match"`
+	Rig     string `json:"rig"`
+	Warning string `json:"warning,omitempty"`
+}
+
+type convoyRoutesReport struct {
+	TypeRoutes   []convoyRoute `json:"type_routes"`
+	PrefixRoutes []convoyRoute `json:"prefix_routes"`
+	RigStrategy  string        `json:"rig_strategy"`
+	MaxPerRig    int           `json:"max_per_rig"`
+}
+
+func runConvoyRoutes(cmd *cobra.Command, args []string) error {
+	townRoot, err := workspace.FindFromCwdOrError()
+	if err != nil {
+		return err
+	}
+	settings, err := config.LoadOrCreateTownSettings(config.TownSettingsPath(townRoot))
+	if err != nil {
+		return fmt.Errorf("loading town settings: %w", err)
+	}
+	prefixRoutes, err := beads.LoadRoutes(filepath.Join(townRoot, ".beads"))
+	if err != nil {
+		return fmt.Errorf("loading routes: %w", err)
+	}
+	// A missing rig registry just means no rig can be confirmed.
+	var rigs map[string]config.RigEntry
+	if rc, err := config.LoadRigsConfig(filepath.Join(townRoot, "mayor", "rigs.json")); err == nil {
+		rigs = rc.Rigs
+	}
+
+	report := buildConvoyRoutes(settings.ConvoySettings(), prefixRoutes, rigs)
+
+	if convoyRoutesJSON {
+		enc := json.NewEncoder(os.Stdout)
+		enc.SetIndent("", "  ")
+		return enc.Encode(report)
+	}
+	printConvoyRoutes(report)
+	return nil
+}
+
+// buildConvoyRoutes assembles the effective routing from convoy settings,
+// the town's prefix routes, and the registered rigs.
+func buildConvoyRoutes(cc config.ConvoyConfig, prefixRoutes []beads.Route, rigs map[string]config.RigEntry) convoyRoutesReport {
+	report := convoyRoutesReport{
+		TypeRoutes:   make([]convoyRoute, 0, len(cc.TypeRoutes)),
+		PrefixRoutes: make([]convoyRoute, 0, len(prefixRoutes)),
+		RigStrategy:  string(convoy.RigByOrder),
+		MaxPerRig:    cc.MaxPerRig,
+	}
+	if strategy, err := convoy.ParseRigStrategy(cc.RigStrategy); err == nil {
+		report.RigStrategy = string(strategy)
+	}
+
+	types := make([]string, 0, len(cc.TypeRoutes))
+	for t := range cc.TypeRoutes {
+		types = append(types, t)
+	}
+	sort.Strings(types)
+	for _, t := range types {
+		r := convoyRoute{Match: t, Rig: cc.TypeRoutes[t]}
+		switch {
+		case !convoy.IsSlingableType(t):
+			r.Warning = "type is not slingable, so convoys never dispatch it"
+		case rigs != nil && !hasRig(rigs, r.Rig):
+			r.Warning = "rig is not registered in mayor/rigs.json"
+		}
+		report.TypeRoutes = append(report.TypeRoutes, r)
+	}
+
+	for _, pr := range prefixRoutes {
+		r := convoyRoute{Match: pr.Prefix}
+		if pr.Path != "." {
+			r.Rig = strings.SplitN(pr.Path, "/", 2)[0]
+		}
+		report.PrefixRoutes = append(report.PrefixRoutes, r)
+	}
+	return report
+}
+
+func hasRig(rigs map[string]config.RigEntry, name string) bool {
+	_, ok := rigs[name]
+	return ok
+}
+
+func printConvoyRoutes(report convoyRoutesReport) {
+	fmt.Println(style.Bold.Render("Type routes") + style.Dim.Render(" (convoy.type_routes)"))
+	if len(report.TypeRoutes) == 0 {
+		fmt.Println(style.Dim.Render("  none; every issue routes by prefix"))
+	}
+	for _, r := range report.TypeRoutes {
+		line := fmt.Sprintf("  %-12s → %s", r.Match, r.Rig)
+		if r.Warning != "" {
+			line += "  " + style.Warning.Render("⚠ "+r.Warning)
+		}
+		fmt.Println(line)
+	}
+
+	fmt.Println()
+	fmt.Println(style.Bold.Render("Prefix routes") + style.Dim.Render(" (.beads/routes.jsonl, for all other types)"))
+	if len(report.PrefixRoutes) == 0 {
+		fmt.Println(style.Dim.Render("  none"))
+	}
+	for _, r := range report.PrefixRoutes {
+		rig := r.Rig
+		if rig == "" {
+			rig = style.Dim.Render("town (not dispatched)")
+		}
+		fmt.Printf("  %-12s → %s\n", r.Match, rig)
+	}
+
+	limit := "no limit"
+	if report.MaxPerRig > 0 {
+		limit = fmt.Sprintf("%d in flight", report.MaxPerRig)
+	}
+	fmt.Printf("\nRig strategy: %s, max per rig: %s\n", report.RigStrategy, limit)
+}
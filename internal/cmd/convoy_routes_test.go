@@ -0,0 +1,47 @@
+package cmd
+
+import (
+	"testing"
+
+	"github.com/steveyegge/gastown/internal/beads"
+	"github.com/steveyegge/gastown/internal/config"
+)
+
+func TestBuildConvoyRoutes(t *testing.T) {
+	cc := config.ConvoyConfig{
+		RigStrategy: "round-robin",
+		MaxPerRig:   2,
+		TypeRoutes:  map[string]string{"bug": "firefighter", "feature": "ghost", "epic": "gastown"},
+	}
+	prefixes := []beads.Route{
+		{Prefix: "hq-", Path: "."},
+		{Prefix: "gt-", Path: "gastown/mayor/rig"},
+	}
+	rigs := map[string]config.RigEntry{"gastown": {}, "firefighter": {}}
+
+	report := buildConvoyRoutes(cc, prefixes, rigs)
+
+	if report.RigStrategy != "round-robin" || report.MaxPerRig != 2 {
+		t.Errorf("strategy = %q, max = %d", report.RigStrategy, report.MaxPerRig)
+	}
+	wantTypes := []convoyRoute{
+		{Match: "bug", Rig: "firefighter"},
+		{Match: "epic", Rig: "gastown", Warning: "type is not slingable, so convoys never dispatch it"},
+		{Match: "feature", Rig: "ghost", Warning: "rig is not registered in mayor/rigs.json"},
+	}
+	if len(report.TypeRoutes) != len(wantTypes) {
+		t.Fatalf("TypeRoutes = %+v, want %+v", report.TypeRoutes, wantTypes)
+	}
+	for i, want := range wantTypes {
+		if report.TypeRoutes[i] != want {
+			t.Errorf("TypeRoutes[%d] = %+v, want %+v", i, report.TypeRoutes[i], want)
+		}
+	}
+
+	wantPrefixes := []convoyRoute{{Match: "hq-"}, {Match: "gt-", Rig: "gastown"}}
+	for i, want := range wantPrefixes {
+		if report.PrefixRoutes[i] != want {
+			t.Errorf("PrefixRoutes[%d] = %+v, want %+v", i, report.PrefixRoutes[i], want)
+		}
+	}
+}
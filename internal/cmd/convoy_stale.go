@@ -0,0 +1,149 @@
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/spf13/cobra"
+	beadsdk "github.com/steveyegge/beads"
+	"github.com/steveyegge/gastown/internal/beads"
+	"github.com/steveyegge/gastown/internal/convoy"
+	"github.com/steveyegge/gastown/internal/style"
+)
+
+// convoy stale flags
+var (
+	convoyStaleOlderThan time.Duration
+	convoyStaleReset     bool
+	convoyStaleJSON      bool
+)
+
+func init() {
+	convoyStaleCmd.Flags().DurationVar(&convoyStaleOlderThan, "older-than", 30*time.Minute, "Report assignments older than this")
+	convoyStaleCmd.Flags().BoolVar(&convoyStaleReset, "reset", false, "Clear the assignee and reopen stale issues so the convoy re-slings them")
+	convoyStaleCmd.Flags().BoolVar(&convoyStaleJSON, "json", false, "Output as JSON")
+
+	convoyCmd.AddCommand(convoyStaleCmd)
+}
+
+var convoyStaleCmd = &cobra.Command{
+	Use:   "stale [convoy-id]",
+	Short: "List convoy issues assigned too long without closing",
+	Long: `List tracked issues that have been in_progress or hooked for longer than
+--older-than, measured from the assigned_at timestamp gt sling records.
+
+When a polecat crashes, its issue stays assigned and the convoy never
+re-slings it. With --reset, stale issues are unassigned and set back to
+open; the next convoy feed dispatches them again.
+
+Issues slung before assigned_at was recorded are not reported.
+Without an ID, checks all open convoys.
+
+Examples:
+  gt convoy stale
+  gt convoy stale hq-cv-abc --older-than=2h
+  gt convoy stale hq-cv-abc --reset`,
+	Args:         cobra.MaximumNArgs(1),
+	SilenceUsage: true,
+	RunE:         runConvoyStale,
+}
+
+// convoyStaleEntry is a stale assignment together with its convoy.
+type convoyStaleEntry struct {
+	ConvoyID string `json:"convoy_id"`
+	convoy.StaleAssignment
+	Reset bool `json:"reset,omitempty"`
+}
+
+func runConvoyStale(cmd *cobra.Command, args []string) error {
+	if convoyStaleOlderThan <= 0 {
+		return fmt.Errorf("--older-than must be positive")
+	}
+
+	townRoot, err := getTownBeadsDir()
+	if err != nil {
+		return err
+	}
+
+	var convoyIDs []string
+	if len(args) == 1 {
+		convoyIDs = []string{beads.ExtractIssueID(args[0])}
+	} else {
+		out, err := runBdJSON(townRoot, "list", "--type=convoy", "--status=open", "--json")
+		if err != nil {
+			return fmt.Errorf("listing convoys: %w", err)
+		}
+		var open []struct {
+			ID string `json:"id"`
+		}
+		if err := json.Unmarshal(out, &open); err != nil {
+			return fmt.Errorf("parsing convoy list: %w", err)
+		}
+		for _, c := range open {
+			convoyIDs = append(convoyIDs, c.ID)
+		}
+	}
+
+	ctx := context.Background()
+	store, err := beadsdk.Open(ctx, filepath.Join(townRoot, ".beads"))
+	if err != nil {
+		return fmt.Errorf("opening town beads: %w", err)
+	}
+	defer func() { _ = store.Close() }()
+
+	now := time.Now()
+	entries := make([]convoyStaleEntry, 0)
+	for _, id := range convoyIDs {
+		for _, s := range convoy.FindStaleAssignments(ctx, store, townRoot, id, convoyStaleOlderThan, now, nil) {
+			entries = append(entries, convoyStaleEntry{ConvoyID: id, StaleAssignment: s})
+		}
+	}
+
+	if convoyStaleReset {
+		for i := range entries {
+			if err := resetStaleIssue(entries[i].IssueID); err != nil {
+				style.PrintWarning("could not reset %s: %v", entries[i].IssueID, err)
+				continue
+			}
+			entries[i].Reset = true
+		}
+	}
+
+	if convoyStaleJSON {
+		enc := json.NewEncoder(os.Stdout)
+		enc.SetIndent("", "  ")
+		return enc.Encode(entries)
+	}
+
+	if len(entries) == 0 {
+		fmt.Printf("No assignments older than %s.\n", convoyStaleOlderThan)
+		return nil
+	}
+	for _, e := range entries {
+		marker := style.Warning.Render("⏱")
+		if e.Reset {
+			marker = style.Success.Render("↺")
+		}
+		fmt.Printf("  %s %s %s %s %s\n", marker, e.IssueID, e.Assignee,
+			style.Dim.Render(fmt.Sprintf("(%s for %s)", e.Status, e.Age.Round(time.Minute))),
+			style.Dim.Render("convoy "+e.ConvoyID))
+	}
+	if !convoyStaleReset {
+		fmt.Printf("\nReopen them for re-slinging with: gt convoy stale --reset\n")
+	}
+	return nil
+}
+
+// resetStaleIssue unassigns an issue and reopens it so the convoy re-slings
+// it. bd only sees its local database, so the update runs in the store that
+// owns the issue's prefix, usually a rig's.
+func resetStaleIssue(issueID string) error {
+	return BdCmd("update", issueID, "--status=open", "--unassign").
+		Dir(resolveBeadDir(issueID)).
+		WithAutoCommit().
+		Run()
+}
@@ -0,0 +1,69 @@
+package cmd
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestResetStaleIssueRunsInOwningStore(t *testing.T) {
+	townRoot := t.TempDir()
+	rigDir := filepath.Join(townRoot, "gastown", "mayor", "rig")
+	for _, dir := range []string{filepath.Join(townRoot, "mayor", "rig"), filepath.Join(townRoot, ".beads"), rigDir} {
+		if err := os.MkdirAll(dir, 0755); err != nil {
+			t.Fatalf("mkdir %s: %v", dir, err)
+		}
+	}
+	routes := `{"prefix":"gt-","path":"gastown/mayor/rig"}` + "\n" + `{"prefix":"hq-","path":"."}` + "\n"
+	if err := os.WriteFile(filepath.Join(townRoot, ".beads", "routes.jsonl"), []byte(routes), 0644); err != nil {
+		t.Fatalf("write routes.jsonl: %v", err)
+	}
+
+	binDir := filepath.Join(townRoot, "bin")
+	if err := os.MkdirAll(binDir, 0755); err != nil {
+		t.Fatalf("mkdir binDir: %v", err)
+	}
+	logPath := filepath.Join(townRoot, "bd.log")
+	_ = writeBDStub(t, binDir, "#!/bin/sh\necho \"$(pwd)|$*\" >> \"${BD_LOG}\"\n", "@echo off\r\necho %CD%^|%*>>\"%BD_LOG%\"\r\n")
+	t.Setenv("BD_LOG", logPath)
+	t.Setenv("PATH", binDir+string(os.PathListSeparator)+os.Getenv("PATH"))
+
+	cwd, err := os.Getwd()
+	if err != nil {
+		t.Fatalf("getwd: %v", err)
+	}
+	t.Cleanup(func() { _ = os.Chdir(cwd) })
+	if err := os.Chdir(townRoot); err != nil {
+		t.Fatalf("chdir: %v", err)
+	}
+
+	for _, id := range []string{"gt-abc", "hq-xyz"} {
+		if err := resetStaleIssue(id); err != nil {
+			t.Fatalf("resetStaleIssue(%s): %v", id, err)
+		}
+	}
+
+	data, err := os.ReadFile(logPath)
+	if err != nil {
+		t.Fatalf("read bd log: %v", err)
+	}
+	var lines []string
+	for _, line := range strings.Split(strings.TrimSpace(string(data)), "\n") {
+		if strings.Contains(line, "|update ") {
+			lines = append(lines, line)
+		}
+	}
+	if len(lines) != 2 {
+		t.Fatalf("bd update ran %d times, want 2:\n%s", len(lines), data)
+	}
+	for i, want := range []struct{ dir, id string }{{rigDir, "gt-abc"}, {townRoot, "hq-xyz"}} {
+		dir, args, _ := strings.Cut(lines[i], "|")
+		if resolved, _ := filepath.EvalSymlinks(want.dir); dir != want.dir && dir != resolved {
+			t.Errorf("reset of %s ran in %s, want %s", want.id, dir, want.dir)
+		}
+		if !strings.Contains(args, "update "+want.id+" --status=open --unassign") {
+			t.Errorf("reset of %s ran bd %q", want.id, args)
+		}
+	}
+}
@@ -0,0 +1,61 @@
+package cmd
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestGroupTrackedByStatus(t *testing.T) {
+	tracked := []trackedIssueInfo{
+		{ID: "gt-1", Status: "closed"},
+		{ID: "gt-2", Status: "open"},
+		{ID: "gt-3", Status: "hooked"},
+		{ID: "gt-4", Status: "open", Blocked: true},
+		{ID: "gt-5", Status: "in_progress"},
+		{ID: "gt-6", Status: "deferred"},
+	}
+
+	var got []string
+	for _, g := range groupTrackedByStatus(tracked) {
+		ids := make([]string, 0, len(g.Issues))
+		for _, t := range g.Issues {
+			ids = append(ids, t.ID)
+		}
+		got = append(got, g.Label+": "+strings.Join(ids, ","))
+	}
+	want := []string{"In progress: gt-3,gt-5", "Open: gt-2,gt-4", "Other: gt-6", "Closed: gt-1"}
+	if strings.Join(got, "; ") != strings.Join(want, "; ") {
+		t.Errorf("groupTrackedByStatus() = %q, want %q", got, want)
+	}
+
+	if groups := groupTrackedByStatus([]trackedIssueInfo{{ID: "gt-1", Status: "closed"}}); len(groups) != 1 || groups[0].Label != "Closed" {
+		t.Errorf("empty groups should be omitted, got %+v", groups)
+	}
+}
+
+func TestFormatTrackedIssueLine_FlagsBlocked(t *testing.T) {
+	line := formatTrackedIssueLine(trackedIssueInfo{ID: "gt-4", Title: "Wire it up", Status: "open", Blocked: true})
+	if !strings.Contains(line, "blocked") {
+		t.Errorf("blocked issue line %q should say blocked", line)
+	}
+	line = formatTrackedIssueLine(trackedIssueInfo{ID: "gt-1", Title: "Done", Status: "closed", Blocked: true})
+	if strings.Contains(line, "blocked") {
+		t.Errorf("closed issue line %q should not say blocked", line)
+	}
+}
+
+func TestPercentComplete(t *testing.T) {
+	tests := []struct {
+		completed, total, want int
+	}{
+		{0, 0, 0},
+		{1, 3, 33},
+		{2, 3, 66},
+		{3, 3, 100},
+	}
+	for _, tt := range tests {
+		if got := percentComplete(tt.completed, tt.total); got != tt.want {
+			t.Errorf("percentComplete(%d, %d) = %d, want %d", tt.completed, tt.total, got, tt.want)
+		}
+	}
+}
@@ -1748,8 +1748,7 @@ func selfNukePolecat(roleInfo RoleInfo, _ string) error {
 // Polecat actors have format: rigname/polecats/polecatname
 // Non-polecat actors have formats like: gastown/crew/name, rigname/witness, etc.
 func isPolecatActor(actor string) bool {
-	parts := strings.Split(actor, "/")
-	return len(parts) >= 2 && parts[1] == "polecats"
+	return session.IsPolecat(actor)
 }
 
 // selfKillSession terminates the polecat's own tmux session after logging the event.
@@ -1779,7 +1778,7 @@ func selfKillSession(townRoot string, roleInfo RoleInfo) error {
 	}
 
 	sessionName := session.PolecatSessionName(session.PrefixFor(rigName), polecatName)
-	agentID := fmt.Sprintf("%s/polecats/%s", rigName, polecatName)
+	agentID := session.FormatAssignee(rigName, polecatName)
 
 	// Log to townlog (human-readable audit log)
 	if townRoot != "" {
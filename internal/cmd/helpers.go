@@ -107,6 +107,25 @@ func isInSameTmuxSocket() bool {
 	return tmux.IsInSameSocket()
 }
 
+// tmuxAttachArgs returns the tmux arguments (after the binary) that put the
+// terminal on a session: switch-client when already inside tmux on the same
+// socket, attach-session otherwise. A read-only view always attaches a new
+// client with -r, since switching would leave the user's own client read-only.
+func tmuxAttachArgs(sessionID string, readOnly bool) []string {
+	args := []string{"-u"}
+	if socket := tmux.GetDefaultSocket(); socket != "" {
+		args = append(args, "-L", socket)
+	}
+	switch {
+	case readOnly:
+		return append(args, "attach-session", "-r", "-t", sessionID)
+	case isInSameTmuxSocket():
+		return append(args, "switch-client", "-t", sessionID)
+	default:
+		return append(args, "attach-session", "-t", sessionID)
+	}
+}
+
 // isShellCommand checks if the command is a shell (meaning the runtime has exited).
 func isShellCommand(cmd string) bool {
 	shells := constants.SupportedShells
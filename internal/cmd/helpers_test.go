@@ -3,6 +3,7 @@ package cmd
 import (
 	"os"
 	"path/filepath"
+	"strings"
 	"testing"
 )
 
@@ -104,3 +105,20 @@ func TestIsShellCommand(t *testing.T) {
 		})
 	}
 }
+
+func TestTmuxAttachArgs(t *testing.T) {
+	t.Setenv("TMUX", "")
+	tests := []struct {
+		readOnly bool
+		want     string
+	}{
+		{false, "attach-session -t hq-mayor"},
+		{true, "attach-session -r -t hq-mayor"},
+	}
+	for _, tt := range tests {
+		got := strings.Join(tmuxAttachArgs("hq-mayor", tt.readOnly), " ")
+		if !strings.HasPrefix(got, "-u ") || !strings.HasSuffix(got, tt.want) {
+			t.Errorf("tmuxAttachArgs(readOnly=%v) = %q, want -u ... %s", tt.readOnly, got, tt.want)
+		}
+	}
+}
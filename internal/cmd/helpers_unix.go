@@ -19,27 +19,23 @@ import (
 // control, and passes -u for UTF-8 support regardless of locale settings.
 // See: https://github.com/steveyegge/gastown/issues/1219
 func attachToTmuxSession(sessionID string) error {
-	tmuxPath, err := exec.LookPath("tmux")
-	if err != nil {
-		return fmt.Errorf("tmux not found: %w", err)
-	}
+	return execTmuxAttach(sessionID, false)
+}
 
-	// Base args with UTF-8 and socket support
-	baseArgs := []string{"tmux", "-u"}
-	if socket := tmux.GetDefaultSocket(); socket != "" {
-		baseArgs = append(baseArgs, "-L", socket)
-	}
+// attachToTmuxSessionReadOnly attaches a read-only client (attach-session -r)
+// for observing a session without sending it keystrokes.
+func attachToTmuxSessionReadOnly(sessionID string) error {
+	return execTmuxAttach(sessionID, true)
+}
 
-	var args []string
-	if isInSameTmuxSocket() {
-		// Same tmux socket: switch to the target session
-		args = append(baseArgs, "switch-client", "-t", sessionID)
-	} else {
-		// Outside tmux or different socket: attach to the session
-		args = append(baseArgs, "attach-session", "-t", sessionID)
+func execTmuxAttach(sessionID string, readOnly bool) error {
+	tmuxPath, err := exec.LookPath(tmux.Binary())
+	if err != nil {
+		return fmt.Errorf("tmux not found: %w", err)
 	}
 
 	// Replace the Go process with tmux for direct terminal control
+	args := append([]string{"tmux"}, tmuxAttachArgs(sessionID, readOnly)...)
 	return syscall.Exec(tmuxPath, args, os.Environ())
 }
 
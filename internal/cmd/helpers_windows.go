@@ -16,25 +16,22 @@ import (
 // If already inside the multiplexer, uses switch-client instead of attach-session.
 // Uses os/exec.Command with stdio passthrough since syscall.Exec is Unix-only.
 func attachToTmuxSession(sessionID string) error {
-	tmuxPath, err := exec.LookPath("tmux")
-	if err != nil {
-		return fmt.Errorf("tmux not found: %w", err)
-	}
+	return runTmuxAttach(sessionID, false)
+}
 
-	// Base args with UTF-8 and socket support
-	var args []string
-	args = append(args, "-u")
-	if socket := tmux.GetDefaultSocket(); socket != "" {
-		args = append(args, "-L", socket)
-	}
+// attachToTmuxSessionReadOnly attaches a read-only client (attach-session -r)
+// for observing a session without sending it keystrokes.
+func attachToTmuxSessionReadOnly(sessionID string) error {
+	return runTmuxAttach(sessionID, true)
+}
 
-	if isInSameTmuxSocket() {
-		args = append(args, "switch-client", "-t", sessionID)
-	} else {
-		args = append(args, "attach-session", "-t", sessionID)
+func runTmuxAttach(sessionID string, readOnly bool) error {
+	tmuxPath, err := exec.LookPath(tmux.Binary())
+	if err != nil {
+		return fmt.Errorf("tmux not found: %w", err)
 	}
 
-	cmd := exec.Command(tmuxPath, args...)
+	cmd := exec.Command(tmuxPath, tmuxAttachArgs(sessionID, readOnly)...)
 	cmd.Stdin = os.Stdin
 	cmd.Stdout = os.Stdout
 	cmd.Stderr = os.Stderr
@@ -395,9 +395,9 @@ func runHook(_ *cobra.Command, args []string) error {
 	// This allows the ACP propeller to react to hook changes event-driven.
 	if agentID == "mayor/" {
 		if townRoot, err := workspace.FindFromCwd(); err == nil && townRoot != "" {
-			session := "hq-mayor"
+			mayorSession := session.MayorSessionName()
 			message := fmt.Sprintf("Hook updated: attached bead %s", beadID)
-			_ = nudge.Enqueue(townRoot, session, nudge.QueuedNudge{
+			_ = nudge.Enqueue(townRoot, mayorSession, nudge.QueuedNudge{
 				Sender:   "hook",
 				Message:  message,
 				Priority: nudge.PriorityNormal,
@@ -424,7 +424,7 @@ func runHook(_ *cobra.Command, args []string) error {
 
 	// Log hook event to activity feed (non-fatal)
 	if err := events.LogFeed(events.TypeHook, agentID, events.HookPayload(beadID)); err != nil {
-		fmt.Fprintf(os.Stderr, "%s Warning: failed to log hook event: %v\n", style.Dim.Render("⚠"), err)
+		fmt.Fprintf(os.Stderr, "%s Warning: failed to log hook event: %v\n", style.RenderStderr(style.Dim, "⚠"), err)
 	}
 
 	return nil
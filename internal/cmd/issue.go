@@ -12,11 +12,15 @@ import (
 var issueCmd = &cobra.Command{
 	Use:     "issue",
 	GroupID: GroupConfig,
-	Short:   "Manage current issue for status line display",
-	Long: `Manage the current issue displayed in the tmux status line.
+	Short:   "Inspect issues and manage the status line issue",
+	Long: `Inspect issues and manage the current issue displayed in the tmux status line.
 
 Sets, clears, or shows the active issue ID stored in the tmux session
-environment. The status line uses this to display what you're working on.`,
+environment. The status line uses this to display what you're working on.
+
+gt issue list, gt issue search <query>, and gt issue show <id> query the
+beads store directly, and gt issue block / unblock add and remove blocking
+dependencies.`,
 }
 
 var issueSetCmd = &cobra.Command{
@@ -40,12 +44,27 @@ Removes the issue ID so it no longer appears in the tmux status line.`,
 }
 
 var issueShowCmd = &cobra.Command{
-	Use:   "show",
-	Short: "Show the current issue",
+	Use:   "show [issue-id]",
+	Short: "Show the current issue, or details of a given issue",
 	Long: `Show the current issue ID from the tmux session environment.
 
-Displays the issue ID currently set for the tmux status line, or
-indicates that no issue is set.`,
+Without an argument, displays the issue ID currently set for the tmux
+status line, or indicates that no issue is set.
+
+With an issue ID, prints that issue's type, status, assignee, and priority,
+its blocking dependencies with their current statuses, and the convoys
+that track it. Wrapped IDs such as external:gt:gt-abc are accepted.
+
+References of the form external:<provider>:<id> keep their provider: it is
+shown for the issue and each blocker that has one, and --provider lists
+only the blockers from that provider (e.g. gh for GitHub).
+
+Examples:
+  gt issue show
+  gt issue show gt-abc
+  gt issue show external:gt:gt-abc --json
+  gt issue show gt-abc --provider gh`,
+	Args: cobra.MaximumNArgs(1),
 	RunE: runIssueShow,
 }
 
@@ -98,6 +117,10 @@ func runIssueClear(cmd *cobra.Command, args []string) error {
 }
 
 func runIssueShow(cmd *cobra.Command, args []string) error {
+	if len(args) == 1 {
+		return runIssueShowDetail(args[0])
+	}
+
 	session := os.Getenv("TMUX_PANE")
 	if session == "" {
 		session = detectCurrentSession()
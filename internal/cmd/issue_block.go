@@ -0,0 +1,129 @@
+package cmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+
+	"github.com/spf13/cobra"
+	"github.com/steveyegge/gastown/internal/beads"
+	"github.com/steveyegge/gastown/internal/convoy"
+	"github.com/steveyegge/gastown/internal/style"
+)
+
+var issueBlockCmd = &cobra.Command{
+	Use:   "block <issue-id> <blocker-id>",
+	Short: "Mark an issue as blocked by another",
+	Long: `Record that an issue is blocked by another issue.
+
+A convoy feed never dispatches an issue with an open blocker, so the issue
+waits until the blocker closes. A dependency that would make issues block
+each other in a cycle is refused, and the cycle is printed.
+
+Wrapped IDs such as external:gt:gt-abc are accepted.
+
+Examples:
+  gt issue block gt-def gt-abc     # gt-def waits for gt-abc`,
+	Args:         cobra.ExactArgs(2),
+	SilenceUsage: true,
+	RunE:         runIssueBlock,
+}
+
+var issueUnblockCmd = &cobra.Command{
+	Use:   "unblock <issue-id> <blocker-id>",
+	Short: "Remove a blocking dependency between two issues",
+	Long: `Remove the dependency of an issue on a blocker.
+
+If that leaves the issue ready, the convoys tracking it are fed right away
+instead of waiting for the next feed.
+
+Examples:
+  gt issue unblock gt-def gt-abc`,
+	Args:         cobra.ExactArgs(2),
+	SilenceUsage: true,
+	RunE:         runIssueUnblock,
+}
+
+func init() {
+	issueCmd.AddCommand(issueBlockCmd)
+	issueCmd.AddCommand(issueUnblockCmd)
+}
+
+func runIssueBlock(cmd *cobra.Command, args []string) error {
+	issueID, blockerID := beads.ExtractIssueID(args[0]), beads.ExtractIssueID(args[1])
+	townRoot, err := getTownBeadsDir()
+	if err != nil {
+		return err
+	}
+
+	ctx := context.Background()
+	home, _, closeStores, err := openIssueStores(ctx, townRoot, issueID)
+	if err != nil {
+		return err
+	}
+	defer closeStores()
+
+	err = convoy.BlockIssue(ctx, home, issueID, blockerID, blockerDependsOnID(townRoot, issueID, blockerID), dependencyActor(), nil)
+	if errors.Is(err, convoy.ErrDependencyCycle) {
+		return fmt.Errorf("%w\nissues in a cycle wait on each other and are never fed", err)
+	}
+	if err != nil {
+		return err
+	}
+	fmt.Printf("%s %s is blocked by %s\n", style.Success.Render("✓"), style.Bold.Render(issueID), blockerID)
+	return nil
+}
+
+func runIssueUnblock(cmd *cobra.Command, args []string) error {
+	issueID, blockerID := beads.ExtractIssueID(args[0]), beads.ExtractIssueID(args[1])
+	townRoot, err := getTownBeadsDir()
+	if err != nil {
+		return err
+	}
+
+	ctx := context.Background()
+	home, town, closeStores, err := openIssueStores(ctx, townRoot, issueID)
+	if err != nil {
+		return err
+	}
+	defer closeStores()
+
+	if err := convoy.UnblockIssue(ctx, home, issueID, blockerDependsOnID(townRoot, issueID, blockerID), dependencyActor()); err != nil {
+		return err
+	}
+	fmt.Printf("%s %s is no longer blocked by %s\n", style.Success.Render("✓"), style.Bold.Render(issueID), blockerID)
+
+	gtPath, err := os.Executable()
+	if err != nil {
+		if gtPath, err = exec.LookPath("gt"); err != nil {
+			style.PrintWarning("could not find gt to feed convoys tracking %s: %v", issueID, err)
+			return nil
+		}
+	}
+	result := convoy.FeedConvoysForIssue(ctx, town, townRoot, issueID, "Unblock", nil, gtPath, nil, nil)
+	for _, f := range result.Fed {
+		fmt.Printf("  %s Convoy %s dispatched %s to %s\n", style.ArrowPrefix, f.ConvoyID, style.Bold.Render(f.IssueID), f.Rig)
+	}
+	return nil
+}
+
+// blockerDependsOnID returns the ID a dependency stores for blockerID: an
+// external: reference when the blocker lives in a different database than
+// the issue, as bd dep add records it.
+func blockerDependsOnID(townRoot, issueID, blockerID string) string {
+	if filepath.Clean(resolveBeadDir(issueID)) == filepath.Clean(resolveBeadDir(blockerID)) {
+		return blockerID
+	}
+	return trackingDependsOnID(townRoot, blockerID)
+}
+
+// dependencyActor names who changed a dependency, as bd would record it.
+func dependencyActor() string {
+	if actor := os.Getenv("BD_ACTOR"); actor != "" {
+		return actor
+	}
+	return detectSender()
+}
@@ -0,0 +1,218 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strconv"
+
+	"github.com/spf13/cobra"
+	beadsdk "github.com/steveyegge/beads"
+	"github.com/steveyegge/gastown/internal/convoy"
+	"github.com/steveyegge/gastown/internal/style"
+)
+
+// issue list flags
+var (
+	issueListStatus   string
+	issueListType     string
+	issueListAssignee string
+	issueListReady    bool
+	issueListRig      string
+	issueListJSON     bool
+	issueListNoHeader bool
+	issueListFormat   string
+	issueListLimit    int
+	issueListOffset   int
+)
+
+// defaultIssueListLimit caps the table view when --limit is not given.
+const defaultIssueListLimit = 50
+
+func init() {
+	issueListCmd.Flags().StringVar(&issueListStatus, "status", "", "Only issues with this status (open, in_progress, hooked, closed, ...)")
+	issueListCmd.Flags().StringVar(&issueListType, "type", "", "Only issues of this type (task, bug, feature, ...)")
+	issueListCmd.Flags().StringVar(&issueListAssignee, "assignee", "", "Only issues assigned to this agent (e.g. gastown/polecats/alpha)")
+	issueListCmd.Flags().BoolVar(&issueListReady, "ready", false, "Only issues a convoy feed could dispatch: open, unassigned, slingable")
+	issueListCmd.Flags().StringVar(&issueListRig, "rig", "", "List a rig's issues instead of the town's (hq)")
+	issueListCmd.Flags().BoolVar(&issueListJSON, "json", false, "Output as JSON")
+	issueListCmd.Flags().BoolVar(&issueListNoHeader, "no-header", false, "Omit the table (or CSV) header")
+	addFormatFlag(issueListCmd, &issueListFormat)
+	issueListCmd.Flags().IntVar(&issueListLimit, "limit", defaultIssueListLimit, "Most issues to list (0 for all; json and csv list all unless set)")
+	issueListCmd.Flags().IntVar(&issueListOffset, "offset", 0, "Skip this many matching issues, for the next page")
+
+	issueCmd.AddCommand(issueListCmd)
+}
+
+var issueListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List issues with filters",
+	Long: `List issues from the town's beads, or a rig's with --rig.
+
+Filters combine: --status, --type, and --assignee must all match. --ready
+keeps only issues a convoy feed would consider dispatching (open,
+unassigned, and of a slingable type; see convoy.slingable_types). Blocking
+dependencies are not checked; use gt issue show to see an issue's blockers.
+
+--format picks the output: table (the default), json (the same as --json),
+or csv for spreadsheets. CSV has the table's columns, with titles in full.
+
+Issues are listed by priority, then newest first, then ID, so pages are
+stable. The table shows the first 50 (--limit) and a footer with the count
+left and the --offset for the next page; json and csv list every match
+unless --limit is given, and report a truncated page on stderr. Without
+--status, closed issues are left out.
+
+Examples:
+  gt issue list --rig gastown --ready
+  gt issue list --rig gastown --status in_progress --assignee gastown/polecats/alpha
+  gt issue list --type bug --json
+  gt issue list --rig gastown --limit 100 --offset 100
+  gt issue list --rig gastown --format=csv > issues.csv`,
+	Args:         cobra.NoArgs,
+	SilenceUsage: true,
+	RunE:         runIssueList,
+}
+
+// issueListEntry is one row of gt issue list.
+type issueListEntry struct {
+	ID       string `json:"id"`
+	Title    string `json:"title"`
+	Type     string `json:"issue_type"`
+	Status   string `json:"status"`
+	Assignee string `json:"assignee,omitempty"`
+	Priority int    `json:"priority"`
+}
+
+func newIssueListEntry(iss *beadsdk.Issue) issueListEntry {
+	return issueListEntry{
+		ID:       iss.ID,
+		Title:    iss.Title,
+		Type:     string(iss.IssueType),
+		Status:   string(iss.Status),
+		Assignee: iss.Assignee,
+		Priority: iss.Priority,
+	}
+}
+
+// issueListFilter holds the gt issue list filters.
+type issueListFilter struct {
+	status, issueType, assignee string
+	ready                       bool
+}
+
+// query narrows the store query where it can; matches is authoritative.
+// Without a status, every status but closed is asked for, as the store
+// would otherwise return only open and in-progress issues.
+func (f issueListFilter) query() beadsdk.IssueFilter {
+	ephemeral := false
+	q := beadsdk.IssueFilter{Ephemeral: &ephemeral}
+	switch {
+	case f.ready:
+		q.Statuses = []beadsdk.Status{beadsdk.StatusOpen}
+	case f.status != "":
+		q.Statuses = []beadsdk.Status{beadsdk.Status(f.status)}
+	default:
+		q.Statuses = convoy.ActiveStatuses
+	}
+	if f.issueType != "" {
+		issueType := beadsdk.IssueType(f.issueType)
+		q.IssueType = &issueType
+	}
+	if f.assignee != "" {
+		assignee := f.assignee
+		q.Assignee = &assignee
+	}
+	return q
+}
+
+func (f issueListFilter) matches(e issueListEntry) bool {
+	switch {
+	case f.status != "" && e.Status != f.status,
+		f.issueType != "" && e.Type != f.issueType,
+		f.assignee != "" && e.Assignee != f.assignee,
+		f.ready && !convoy.IsReadyCandidate(e.Status, e.Assignee, e.Type):
+		return false
+	}
+	return true
+}
+
+func runIssueList(cmd *cobra.Command, args []string) error {
+	format, err := resolveListFormat(issueListFormat, issueListJSON)
+	if err != nil {
+		return err
+	}
+	limit := issueListLimit
+	if format != "" && format != formatTable && !cmd.Flags().Changed("limit") {
+		limit = 0
+	}
+
+	ctx := context.Background()
+	store, err := openBeadsStore(ctx, issueListRig)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = store.Close() }()
+
+	filter := issueListFilter{status: issueListStatus, issueType: issueListType, assignee: issueListAssignee, ready: issueListReady}
+	page, err := convoy.ListIssuePage(ctx, store, filter.query(), func(iss *beadsdk.Issue) bool {
+		return filter.matches(newIssueListEntry(iss))
+	}, convoy.PageOptions{Limit: limit, Offset: issueListOffset})
+	if err != nil {
+		return err
+	}
+	issues := make([]issueListEntry, 0, len(page.Issues))
+	for _, iss := range page.Issues {
+		issues = append(issues, newIssueListEntry(iss))
+	}
+
+	if format == formatJSON || format == formatCSV {
+		if err := writeListing(os.Stdout, format, issueListTable(issues, !issueListNoHeader), issues); err != nil {
+			return err
+		}
+		if more := issueListFooter(page); more != "" {
+			fmt.Fprintln(os.Stderr, more)
+		}
+		return nil
+	}
+	if len(issues) == 0 {
+		if page.Total > 0 {
+			fmt.Printf("No issues past offset %d (%d match).\n", page.Offset, page.Total)
+		} else {
+			fmt.Println("No matching issues.")
+		}
+		return nil
+	}
+	if err := writeListing(os.Stdout, formatTable, issueListTable(issues, !issueListNoHeader), issues); err != nil {
+		return err
+	}
+	if more := issueListFooter(page); more != "" {
+		fmt.Println(style.Dim.Render(more))
+	}
+	return nil
+}
+
+// issueListFooter reports the issues left after a page, or "" for the last.
+func issueListFooter(page *convoy.IssuePage) string {
+	n := page.Remaining()
+	if n == 0 {
+		return ""
+	}
+	return fmt.Sprintf("%d more (use --offset %d for the next page)", n, page.NextOffset())
+}
+
+// issueListTable lays out gt issue list's rows.
+func issueListTable(issues []issueListEntry, header bool) *style.Table {
+	tbl := style.NewTable(
+		style.Column{Name: "ID"},
+		style.Column{Name: "PRI", Align: style.AlignRight},
+		style.Column{Name: "TYPE"},
+		style.Column{Name: "STATUS"},
+		style.Column{Name: "ASSIGNEE"},
+		style.Column{Name: "TITLE", Width: 50},
+	).SetHeader(header)
+	for _, e := range issues {
+		tbl.AddRow(e.ID, "P"+strconv.Itoa(e.Priority), e.Type, style.Status(e.Status), e.Assignee, e.Title)
+	}
+	return tbl
+}
@@ -0,0 +1,79 @@
+package cmd
+
+import (
+	"slices"
+	"testing"
+
+	beadsdk "github.com/steveyegge/beads"
+	"github.com/steveyegge/gastown/internal/convoy"
+)
+
+func TestIssueListFilter(t *testing.T) {
+	issues := []issueListEntry{
+		{ID: "gt-1", Type: "task", Status: "open"},
+		{ID: "gt-2", Type: "bug", Status: "open", Assignee: "gastown/polecats/alpha"},
+		{ID: "gt-3", Type: "epic", Status: "open"},
+		{ID: "gt-4", Type: "task", Status: "in_progress", Assignee: "gastown/polecats/alpha"},
+		{ID: "gt-5", Type: "bug", Status: "closed"},
+	}
+	tests := []struct {
+		name   string
+		filter issueListFilter
+		want   []string
+	}{
+		{"no filter", issueListFilter{}, []string{"gt-1", "gt-2", "gt-3", "gt-4", "gt-5"}},
+		{"status", issueListFilter{status: "open"}, []string{"gt-1", "gt-2", "gt-3"}},
+		{"type and status combine", issueListFilter{issueType: "bug", status: "open"}, []string{"gt-2"}},
+		{"assignee", issueListFilter{assignee: "gastown/polecats/alpha"}, []string{"gt-2", "gt-4"}},
+		{"ready skips assigned and non-slingable", issueListFilter{ready: true}, []string{"gt-1"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got []string
+			for _, e := range issues {
+				if tt.filter.matches(e) {
+					got = append(got, e.ID)
+				}
+			}
+			if len(got) != len(tt.want) {
+				t.Fatalf("matches = %v, want %v", got, tt.want)
+			}
+			for i := range got {
+				if got[i] != tt.want[i] {
+					t.Errorf("matches = %v, want %v", got, tt.want)
+					break
+				}
+			}
+		})
+	}
+}
+
+func TestIssueListFilterQuery(t *testing.T) {
+	q := issueListFilter{}.query()
+	if len(q.Statuses) == 0 || slices.Contains(q.Statuses, beadsdk.StatusClosed) {
+		t.Errorf("default statuses = %v, want every status but closed", q.Statuses)
+	}
+	if q.Ephemeral == nil || *q.Ephemeral {
+		t.Error("default query includes wisps")
+	}
+
+	q = issueListFilter{status: "closed", issueType: "bug", assignee: "gastown/polecats/alpha"}.query()
+	if !slices.Equal(q.Statuses, []beadsdk.Status{beadsdk.StatusClosed}) || q.IssueType == nil || *q.IssueType != "bug" || q.Assignee == nil || *q.Assignee != "gastown/polecats/alpha" {
+		t.Errorf("query = %+v, want closed bugs for alpha", q)
+	}
+
+	if q = (issueListFilter{ready: true, status: "closed"}).query(); !slices.Equal(q.Statuses, []beadsdk.Status{beadsdk.StatusOpen}) {
+		t.Errorf("ready statuses = %v, want open only", q.Statuses)
+	}
+}
+
+func TestIssueListFooter(t *testing.T) {
+	last := &convoy.IssuePage{Issues: make([]*beadsdk.Issue, 3), Offset: 50, Total: 53}
+	if got := issueListFooter(last); got != "" {
+		t.Errorf("footer on the last page = %q, want none", got)
+	}
+	first := &convoy.IssuePage{Issues: make([]*beadsdk.Issue, 50), Total: 53}
+	if got, want := issueListFooter(first), "3 more (use --offset 50 for the next page)"; got != want {
+		t.Errorf("footer = %q, want %q", got, want)
+	}
+}
@@ -0,0 +1,113 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/steveyegge/gastown/internal/convoy"
+	"github.com/steveyegge/gastown/internal/style"
+)
+
+// issue search flags
+var (
+	issueSearchLimit  int
+	issueSearchRig    string
+	issueSearchJSON   bool
+	issueSearchFormat string
+)
+
+func init() {
+	issueSearchCmd.Flags().IntVar(&issueSearchLimit, "limit", 20, "Most matches to show (0 for all)")
+	issueSearchCmd.Flags().StringVar(&issueSearchRig, "rig", "", "Search a rig's issues instead of the town's (hq)")
+	issueSearchCmd.Flags().BoolVar(&issueSearchJSON, "json", false, "Output as JSON")
+	addFormatFlag(issueSearchCmd, &issueSearchFormat)
+
+	issueCmd.AddCommand(issueSearchCmd)
+}
+
+var issueSearchCmd = &cobra.Command{
+	Use:   "search <query>",
+	Short: "Find issues by ID, title, or label",
+	Long: `Find issues whose ID, title, or labels match a query, ignoring case.
+Closed issues are searched too.
+
+Matches are ranked, best first:
+  id         the query is the issue's ID
+  id-prefix  the ID starts with the query
+  title      the title contains the query
+  label      a label contains the query
+  words      every word of the query is in the title or labels
+  fuzzy      the query's letters appear in order in the title (3+ letters)
+
+A pasted external:<prefix>:<id> reference or tracker URL is reduced to the
+bare ID first, so it finds the issue it names. Within a rank, issues are in
+gt issue list order.
+
+Examples:
+  gt issue search "login redirect"
+  gt issue search external:gt:gt-abc
+  gt issue search --rig gastown feed --limit 5`,
+	Args:         cobra.MinimumNArgs(1),
+	SilenceUsage: true,
+	RunE:         runIssueSearch,
+}
+
+// issueSearchEntry is one match printed by gt issue search.
+type issueSearchEntry struct {
+	issueListEntry
+	Match string `json:"match"`
+}
+
+func runIssueSearch(cmd *cobra.Command, args []string) error {
+	format, err := resolveListFormat(issueSearchFormat, issueSearchJSON)
+	if err != nil {
+		return err
+	}
+	if issueSearchLimit < 0 {
+		return fmt.Errorf("--limit must not be negative")
+	}
+
+	ctx := context.Background()
+	store, err := openBeadsStore(ctx, issueSearchRig)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = store.Close() }()
+
+	query := strings.Join(args, " ")
+	matches, err := convoy.SearchIssues(ctx, store, query, issueSearchLimit)
+	if err != nil {
+		return err
+	}
+	entries := make([]issueSearchEntry, 0, len(matches))
+	for _, m := range matches {
+		entries = append(entries, issueSearchEntry{issueListEntry: newIssueListEntry(m.Issue), Match: m.Kind.String()})
+	}
+
+	if len(entries) == 0 && format != formatJSON && format != formatCSV {
+		fmt.Printf("No issues match %q.\n", query)
+		return nil
+	}
+	if format == "" {
+		format = formatTable
+	}
+	return writeListing(os.Stdout, format, issueSearchTable(entries), entries)
+}
+
+// issueSearchTable lays out gt issue search's matches.
+func issueSearchTable(entries []issueSearchEntry) *style.Table {
+	tbl := style.NewTable(
+		style.Column{Name: "ID"},
+		style.Column{Name: "MATCH"},
+		style.Column{Name: "STATUS"},
+		style.Column{Name: "TYPE"},
+		style.Column{Name: "TITLE", Width: 60},
+	)
+	for _, e := range entries {
+		tbl.AddRow(e.ID, style.Dim.Render(e.Match), style.Status(e.Status), e.Type, e.Title)
+	}
+	return tbl
+}
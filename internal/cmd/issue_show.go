@@ -0,0 +1,128 @@
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	beadsdk "github.com/steveyegge/beads"
+	"github.com/steveyegge/gastown/internal/convoy"
+	"github.com/steveyegge/gastown/internal/style"
+)
+
+var (
+	issueShowJSON     bool
+	issueShowProvider string
+)
+
+func init() {
+	issueShowCmd.Flags().BoolVar(&issueShowJSON, "json", false, "Output as JSON (with an issue ID)")
+	issueShowCmd.Flags().StringVar(&issueShowProvider, "provider", "", "Only list blockers from this provider (with an issue ID)")
+}
+
+// runIssueShowDetail prints an issue with its blockers and convoys resolved.
+func runIssueShowDetail(arg string) error {
+	issueID := convoy.ParseIssueRef(arg).ID
+
+	townRoot, err := getTownBeadsDir()
+	if err != nil {
+		return err
+	}
+
+	ctx := context.Background()
+	home, town, closeStores, err := openIssueStores(ctx, townRoot, issueID)
+	if err != nil {
+		return err
+	}
+	defer closeStores()
+
+	detail, err := convoy.DescribeIssue(ctx, home, town, arg)
+	if err != nil {
+		return err
+	}
+	if issueShowProvider != "" {
+		detail.Blockers = blockersFromProvider(detail.Blockers, issueShowProvider)
+	}
+
+	if issueShowJSON {
+		enc := json.NewEncoder(os.Stdout)
+		enc.SetIndent("", "  ")
+		return enc.Encode(detail)
+	}
+	printIssueDetail(detail)
+	return nil
+}
+
+// openIssueStores opens the town's beads store and the store an issue lives
+// in. Rig issues live in their rig's database; convoys live in the town's.
+// For a town-level issue both are the same store.
+func openIssueStores(ctx context.Context, townRoot, issueID string) (home, town beadsdk.Storage, closeStores func(), err error) {
+	town, err = beadsdk.Open(ctx, filepath.Join(townRoot, ".beads"))
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("opening town beads: %w", err)
+	}
+	home = town
+	closeStores = func() { _ = town.Close() }
+	if dir := resolveBeadDir(issueID); filepath.Clean(dir) != filepath.Clean(townRoot) {
+		rigStore, err := beadsdk.Open(ctx, filepath.Join(dir, ".beads"))
+		if err != nil {
+			closeStores()
+			return nil, nil, nil, fmt.Errorf("opening beads for %s: %w", issueID, err)
+		}
+		home = rigStore
+		closeStores = func() { _ = rigStore.Close(); _ = town.Close() }
+	}
+	return home, town, closeStores, nil
+}
+
+// blockersFromProvider keeps the blockers referenced through provider.
+func blockersFromProvider(blockers []convoy.Blocker, provider string) []convoy.Blocker {
+	out := make([]convoy.Blocker, 0, len(blockers))
+	for _, b := range blockers {
+		if b.Provider == provider {
+			out = append(out, b)
+		}
+	}
+	return out
+}
+
+func printIssueDetail(d *convoy.IssueDetail) {
+	fmt.Printf("%s %s\n", style.Bold.Render(d.ID), d.Title)
+	if d.Provider != "" {
+		fmt.Printf("  Provider: %s\n", d.Provider)
+	}
+	fmt.Printf("  Type:     %s\n", d.Type)
+	fmt.Printf("  Status:   %s\n", style.Status(d.Status))
+	assignee := d.Assignee
+	if assignee == "" {
+		assignee = style.Dim.Render("unassigned")
+	}
+	fmt.Printf("  Assignee: %s\n", assignee)
+	fmt.Printf("  Priority: P%d\n", d.Priority)
+
+	if len(d.Blockers) == 0 {
+		fmt.Printf("  Blockers: %s\n", style.Dim.Render("none"))
+	} else {
+		fmt.Printf("  Blockers:\n")
+		for _, b := range d.Blockers {
+			marker := style.Closed.Render("✓")
+			if b.Open {
+				marker = style.Blocked.Render("⊘")
+			}
+			id := b.ID
+			if b.Provider != "" {
+				id = b.Provider + ":" + b.ID
+			}
+			fmt.Printf("    %s %s %s %s\n", marker, id, style.Status(b.Status), style.Dim.Render("("+b.Type+")"))
+		}
+	}
+
+	convoys := style.Dim.Render("none")
+	if len(d.Convoys) > 0 {
+		convoys = strings.Join(d.Convoys, ", ")
+	}
+	fmt.Printf("  Convoys:  %s\n", convoys)
+}
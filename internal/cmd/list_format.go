@@ -0,0 +1,55 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+
+	"github.com/spf13/cobra"
+	"github.com/steveyegge/gastown/internal/style"
+)
+
+// Output formats for listing commands' --format flag.
+const (
+	formatTable = "table"
+	formatJSON  = "json"
+	formatCSV   = "csv"
+)
+
+// addFormatFlag registers the shared --format flag on a listing command.
+func addFormatFlag(cmd *cobra.Command, target *string) {
+	cmd.Flags().StringVar(target, "format", "", "Output format: table, json, or csv")
+}
+
+// resolveListFormat combines --format with a command's older --json flag.
+// An empty result means the command's default view.
+func resolveListFormat(format string, jsonFlag bool) (string, error) {
+	switch format {
+	case "", formatTable, formatJSON, formatCSV:
+	default:
+		return "", fmt.Errorf("unknown format %q (want table, json, or csv)", format)
+	}
+	if jsonFlag {
+		if format != "" && format != formatJSON {
+			return "", fmt.Errorf("--json conflicts with --format=%s", format)
+		}
+		return formatJSON, nil
+	}
+	return format, nil
+}
+
+// writeListing writes a listing in the given format: v as JSON, or tbl as
+// CSV or an aligned table. Callers handle the empty-format default view.
+func writeListing(w io.Writer, format string, tbl *style.Table, v interface{}) error {
+	switch format {
+	case formatJSON:
+		enc := json.NewEncoder(w)
+		enc.SetIndent("", "  ")
+		return enc.Encode(v)
+	case formatCSV:
+		return tbl.RenderCSV(w)
+	default:
+		_, err := io.WriteString(w, tbl.Render())
+		return err
+	}
+}
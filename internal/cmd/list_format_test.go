@@ -0,0 +1,82 @@
+package cmd
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestResolveListFormat(t *testing.T) {
+	tests := []struct {
+		format  string
+		json    bool
+		want    string
+		wantErr bool
+	}{
+		{"", false, "", false},
+		{"table", false, "table", false},
+		{"csv", false, "csv", false},
+		{"", true, "json", false},
+		{"json", true, "json", false},
+		{"csv", true, "", true},
+		{"yaml", false, "", true},
+	}
+	for _, tt := range tests {
+		got, err := resolveListFormat(tt.format, tt.json)
+		if (err != nil) != tt.wantErr || got != tt.want {
+			t.Errorf("resolveListFormat(%q, %v) = %q, %v; want %q, error %v", tt.format, tt.json, got, err, tt.want, tt.wantErr)
+		}
+	}
+}
+
+func TestWriteListing(t *testing.T) {
+	issues := []issueListEntry{
+		{ID: "gt-1", Title: `Fix "quoted", comma title that runs well past the table's fifty-column limit`, Type: "bug", Status: "open", Priority: 1},
+		{ID: "gt-2", Title: "plain", Type: "task", Status: "closed", Assignee: "gastown/polecats/alpha", Priority: 2},
+	}
+
+	var buf strings.Builder
+	if err := writeListing(&buf, formatCSV, issueListTable(issues, true), issues); err != nil {
+		t.Fatalf("csv: %v", err)
+	}
+	want := "ID,PRI,TYPE,STATUS,ASSIGNEE,TITLE\n" +
+		"gt-1,P1,bug,open,,\"Fix \"\"quoted\"\", comma title that runs well past the table's fifty-column limit\"\n" +
+		"gt-2,P2,task,closed,gastown/polecats/alpha,plain\n"
+	if buf.String() != want {
+		t.Errorf("csv =\n%s\nwant\n%s", buf.String(), want)
+	}
+
+	buf.Reset()
+	if err := writeListing(&buf, formatJSON, issueListTable(issues, true), issues); err != nil {
+		t.Fatalf("json: %v", err)
+	}
+	if !strings.Contains(buf.String(), `"id": "gt-2"`) {
+		t.Errorf("json = %s, want the entries", buf.String())
+	}
+
+	buf.Reset()
+	if err := writeListing(&buf, formatTable, issueListTable(issues, false), issues); err != nil {
+		t.Fatalf("table: %v", err)
+	}
+	if strings.Contains(buf.String(), "ASSIGNEE") || !strings.Contains(buf.String(), "past t...") {
+		t.Errorf("table without header = %q, want rows only with the long title truncated", buf.String())
+	}
+}
+
+func TestPolecatListTable(t *testing.T) {
+	items := []PolecatListItem{
+		{Rig: "gastown", Name: "alpha", State: "working", Issue: "gt-1", SessionRunning: true, AgentIdle: true},
+		{Rig: "gastown", Name: "ghost", State: "zombie", SessionRunning: true, Zombie: true, SessionName: "gt-gastown-ghost"},
+		{Rig: "gastown", Name: "beta", State: "done"},
+	}
+	var buf strings.Builder
+	if err := polecatListTable(items).RenderCSV(&buf); err != nil {
+		t.Fatal(err)
+	}
+	want := "RIG,NAME,STATE,SESSION,AGENT,ISSUE\n" +
+		"gastown,alpha,working,running,at prompt,gt-1\n" +
+		"gastown,ghost,zombie,gt-gastown-ghost,busy,\n" +
+		"gastown,beta,done,stopped,,\n"
+	if buf.String() != want {
+		t.Errorf("csv =\n%s\nwant\n%s", buf.String(), want)
+	}
+}
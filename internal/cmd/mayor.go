@@ -2,12 +2,15 @@ package cmd
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"os"
+	"strings"
 	"syscall"
 	"time"
 
 	"github.com/spf13/cobra"
+	"github.com/steveyegge/gastown/internal/agentchat"
 	"github.com/steveyegge/gastown/internal/config"
 	"github.com/steveyegge/gastown/internal/constants"
 	"github.com/steveyegge/gastown/internal/daemon"
@@ -17,6 +20,7 @@ import (
 	"github.com/steveyegge/gastown/internal/style"
 	"github.com/steveyegge/gastown/internal/tmux"
 	"github.com/steveyegge/gastown/internal/workspace"
+	"golang.org/x/term"
 )
 
 var mayorCmd = &cobra.Command{
@@ -42,6 +46,11 @@ Role shortcuts: "mayor" in mail/nudge addresses resolves to this agent.`,
 var (
 	mayorAgentOverride string
 	mayorStatusRunning bool
+	mayorStatusJSON    bool
+	mayorStatusLines   int
+	mayorStartTimeout  time.Duration
+	mayorStopForce     bool
+	mayorAttachRO      bool
 )
 
 var mayorStartCmd = &cobra.Command{
@@ -50,7 +59,12 @@ var mayorStartCmd = &cobra.Command{
 	Long: `Start the Mayor tmux session.
 
 Creates a new detached tmux session for the Mayor and launches Claude.
-The session runs in the workspace root directory.`,
+The session runs in the workspace root directory.
+
+After launching, waits up to --timeout for the agent's input prompt to
+appear, and fails if it never does. Use --timeout=0 to return as soon as
+the session is created. If the Mayor is already running, reports that and
+exits successfully.`,
 	RunE: runMayorStart,
 }
 
@@ -59,7 +73,9 @@ var mayorStopCmd = &cobra.Command{
 	Short: "Stop the Mayor session",
 	Long: `Stop the Mayor tmux session.
 
-Attempts graceful shutdown first (Ctrl-C), then kills the tmux session.`,
+Attempts graceful shutdown first (Ctrl-C, then a few seconds for the agent
+to exit), then kills the tmux session and its processes. Use --force to skip
+the graceful phase. Succeeds with a note if the Mayor is not running.`,
 	RunE: runMayorStop,
 }
 
@@ -69,16 +85,42 @@ var mayorAttachCmd = &cobra.Command{
 	Short:   "Attach to the Mayor session",
 	Long: `Attach to the running Mayor tmux session.
 
-Attaches the current terminal to the Mayor's tmux session.
-Detach with Ctrl-B D.`,
+Attaches the current terminal to the Mayor's tmux session, starting the
+Mayor first if it is not running. Detach with Ctrl-B D. Stdout must be a
+terminal.
+
+With --read-only, attaches a read-only tmux client (attach-session -r) that
+shows the session without sending it keystrokes. A read-only attach never
+starts or restarts the Mayor, and must be run from outside tmux.
+
+Examples:
+  gt mayor attach
+  gt mayor attach --read-only`,
 	RunE: runMayorAttach,
 }
 
 var mayorStatusCmd = &cobra.Command{
 	Use:   "status",
 	Short: "Check Mayor session status",
-	Long:  `Check if the Mayor tmux session is currently running.`,
-	RunE:  runMayorStatus,
+	Long: `Check whether the Mayor is running and what it is doing.
+
+For a tmux session this reports:
+  - idle (at its input prompt) or busy
+  - uptime, from the session's creation time
+  - whether a terminal is attached
+  - the last few lines of output, with agent UI chrome removed
+
+This is the first thing to check when 'gt mayor chat' hangs: a busy Mayor
+is still working, and the last lines show on what.
+
+Use --lines to show more or fewer output lines (0 hides them), --json for a
+machine-readable report, or --running for just true/false.
+
+Examples:
+  gt mayor status
+  gt mayor status --lines 20
+  gt mayor status --json | jq .state`,
+	RunE: runMayorStatus,
 }
 
 var mayorRestartCmd = &cobra.Command{
@@ -86,7 +128,8 @@ var mayorRestartCmd = &cobra.Command{
 	Short: "Restart the Mayor session",
 	Long: `Restart the Mayor tmux session.
 
-Stops the current session (if running) and starts a fresh one.`,
+Stops the current session (if running) and starts a fresh one. Accepts the
+same --force flag as stop and --timeout flag as start.`,
 	RunE: runMayorRestart,
 }
 
@@ -124,10 +167,18 @@ func init() {
 	mayorCmd.AddCommand(mayorAcpCmd)
 
 	mayorStatusCmd.Flags().BoolVar(&mayorStatusRunning, "running", false, "Output only true/false for running status")
+	mayorStatusCmd.Flags().BoolVar(&mayorStatusJSON, "json", false, "Output status as JSON")
+	mayorStatusCmd.Flags().IntVar(&mayorStatusLines, "lines", 5, "Number of recent output lines to show")
 
 	mayorStartCmd.Flags().StringVar(&mayorAgentOverride, "agent", "", "Agent alias to run the Mayor with (overrides town default)")
+	mayorStartCmd.Flags().DurationVar(&mayorStartTimeout, "timeout", 90*time.Second, "How long to wait for the Mayor's prompt (0 = don't wait)")
 	mayorAttachCmd.Flags().StringVar(&mayorAgentOverride, "agent", "", "Agent alias to run the Mayor with (overrides town default)")
+	mayorAttachCmd.Flags().BoolVar(&mayorAttachRO, "read-only", false, "Attach read-only to observe without sending keystrokes")
 	mayorRestartCmd.Flags().StringVar(&mayorAgentOverride, "agent", "", "Agent alias to run the Mayor with (overrides town default)")
+	mayorRestartCmd.Flags().DurationVar(&mayorStartTimeout, "timeout", 90*time.Second, "How long to wait for the Mayor's prompt (0 = don't wait)")
+
+	mayorStopCmd.Flags().BoolVar(&mayorStopForce, "force", false, "Kill the session without waiting for the agent to exit")
+	mayorRestartCmd.Flags().BoolVar(&mayorStopForce, "force", false, "Kill the old session without waiting for the agent to exit")
 
 	mayorAcpCmd.Flags().StringVar(&acpRigOverride, "rig", "", "Rig name (overrides GT_RIG env)")
 	mayorAcpCmd.Flags().StringVar(&acpTownRootOverride, "town", "", "Town root directory (overrides GT_TOWN_ROOT env)")
@@ -159,11 +210,23 @@ func runMayorStart(cmd *cobra.Command, args []string) error {
 	fmt.Println("Starting Mayor session...")
 	if err := mgr.Start(mayorAgentOverride); err != nil {
 		if err == mayor.ErrAlreadyRunning {
-			return fmt.Errorf("Mayor session already running. Attach with: gt mayor attach")
+			fmt.Printf("%s Mayor session already running. Attach with: %s\n",
+				style.Bold.Render("✓"),
+				style.Dim.Render("gt mayor attach"))
+			return nil
 		}
 		return err
 	}
 
+	if mayorStartTimeout > 0 {
+		if err := mgr.WaitForPrompt(mayorStartTimeout); err != nil {
+			if err == mayor.ErrNotReady {
+				return fmt.Errorf("Mayor session started but the agent did not reach its prompt within %s. Inspect with: gt mayor attach", mayorStartTimeout)
+			}
+			return fmt.Errorf("waiting for Mayor: %w", err)
+		}
+	}
+
 	fmt.Printf("%s Mayor session started. Attach with: %s\n",
 		style.Bold.Render("✓"),
 		style.Dim.Render("gt mayor attach"))
@@ -178,9 +241,10 @@ func runMayorStop(cmd *cobra.Command, args []string) error {
 	}
 
 	fmt.Println("Stopping Mayor session...")
-	if err := mgr.Stop(); err != nil {
+	if err := stopMayor(mgr); err != nil {
 		if err == mayor.ErrNotRunning {
-			return fmt.Errorf("Mayor session is not running")
+			fmt.Printf("%s Mayor session is not running.\n", style.Dim.Render("○"))
+			return nil
 		}
 		return err
 	}
@@ -190,11 +254,22 @@ func runMayorStop(cmd *cobra.Command, args []string) error {
 }
 
 func runMayorAttach(cmd *cobra.Command, args []string) error {
+	if !term.IsTerminal(int(os.Stdout.Fd())) {
+		return fmt.Errorf("gt mayor attach needs a terminal (stdout is not a TTY)")
+	}
+	if err := tmux.Available(); err != nil {
+		return err
+	}
+
 	mgr, err := getMayorManager()
 	if err != nil {
 		return err
 	}
 
+	if mayorAttachRO {
+		return attachMayorReadOnly(mgr)
+	}
+
 	townRoot, err := workspace.FindFromCwdOrError()
 	if err != nil {
 		return fmt.Errorf("finding workspace: %w", err)
@@ -248,10 +323,17 @@ func runMayorAttach(cmd *cobra.Command, args []string) error {
 				Topic:     "attach",
 			})
 
-			// Build startup command with beacon
-			startupCmd, err := config.BuildAgentStartupCommandWithAgentOverride("mayor", "", townRoot, "", beacon, mayorAgentOverride)
-			if err != nil {
-				return fmt.Errorf("building startup command: %w", err)
+			// Build startup command with beacon, unless the town configured
+			// its own (mayor.command) and no --agent was given.
+			startupCmd := ""
+			if mayorAgentOverride == "" {
+				startupCmd = mgr.LaunchCommand()
+			}
+			if startupCmd == "" {
+				startupCmd, err = config.BuildAgentStartupCommandWithAgentOverride("mayor", "", townRoot, "", beacon, mayorAgentOverride)
+				if err != nil {
+					return fmt.Errorf("building startup command: %w", err)
+				}
 			}
 
 			// Resolve CLAUDE_CONFIG_DIR and prepend it so the respawned process
@@ -292,6 +374,24 @@ func runMayorAttach(cmd *cobra.Command, args []string) error {
 	return attachToTmuxSession(sessionID)
 }
 
+// attachMayorReadOnly attaches a read-only client to a running Mayor. It
+// leaves the session alone: no ACP handover, auto-start, or respawn.
+func attachMayorReadOnly(mgr *mayor.Manager) error {
+	running, err := mgr.IsRunning()
+	if err != nil {
+		return fmt.Errorf("checking session: %w", err)
+	}
+	if !running {
+		return fmt.Errorf("Mayor session is not running. Start with: gt mayor start")
+	}
+	// switch-client -r would make the user's own client read-only, so a
+	// read-only view needs its own client outside tmux.
+	if isInSameTmuxSocket() {
+		return fmt.Errorf("--read-only must be run outside tmux (or use: gt mayor attach)")
+	}
+	return attachToTmuxSessionReadOnly(mgr.SessionName())
+}
+
 // gracefullyShutdownACP removes the PID file to signal the ACP proxy to exit,
 // then waits for the process to terminate.
 func gracefullyShutdownACP(townRoot string) error {
@@ -333,6 +433,19 @@ func gracefullyShutdownACP(townRoot string) error {
 	return nil
 }
 
+// mayorStatusReport is gt mayor status's JSON output.
+type mayorStatusReport struct {
+	Running       bool     `json:"running"`
+	Mode          string   `json:"mode"`
+	Session       string   `json:"session,omitempty"`
+	State         string   `json:"state,omitempty"` // "idle" or "busy" (tmux only)
+	Attached      bool     `json:"attached"`
+	Created       string   `json:"created,omitempty"`
+	UptimeSeconds int64    `json:"uptime_seconds,omitempty"`
+	LastLines     []string `json:"last_lines,omitempty"`
+	ACPPid        int      `json:"acp_pid,omitempty"`
+}
+
 func runMayorStatus(cmd *cobra.Command, args []string) error {
 	townRoot, err := workspace.FindFromCwdOrError()
 	if err != nil {
@@ -350,6 +463,35 @@ func runMayorStatus(cmd *cobra.Command, args []string) error {
 		return nil
 	}
 
+	report := mayorStatusReport{Running: status.Active, Mode: string(status.Mode), ACPPid: status.ACPPid}
+	var uptime time.Duration
+	if status.Tmux != nil {
+		t := tmux.NewTmux()
+		report.Session = mgr.SessionName()
+		report.Attached = status.Tmux.Attached
+		report.Created = status.Tmux.Created
+		report.State = "busy"
+		if t.IsIdle(report.Session) {
+			report.State = "idle"
+		}
+		if d, ok := sessionUptime(status.Tmux.Created, time.Now()); ok {
+			uptime = d
+			report.UptimeSeconds = int64(d.Seconds())
+		}
+		if mayorStatusLines > 0 {
+			useTownArtifactPatterns()
+			if lines, err := t.CapturePaneLines(report.Session, agentchat.CaptureLines); err == nil {
+				report.LastLines = lastOutputLines(lines, mayorStatusLines)
+			}
+		}
+	}
+
+	if mayorStatusJSON {
+		enc := json.NewEncoder(os.Stdout)
+		enc.SetIndent("", "  ")
+		return enc.Encode(report)
+	}
+
 	if !status.Active {
 		fmt.Printf("%s Mayor session is %s\n",
 			style.Dim.Render("○"),
@@ -363,11 +505,25 @@ func runMayorStatus(cmd *cobra.Command, args []string) error {
 		if status.Tmux.Attached {
 			attachedStatus = "attached"
 		}
+		state := style.Success.Render("idle")
+		if report.State == "busy" {
+			state = style.Warning.Render("busy")
+		}
 		fmt.Printf("%s Mayor (tmux) is %s\n",
 			style.Bold.Render("●"),
 			style.Bold.Render("running"))
+		fmt.Printf("  State: %s\n", state)
 		fmt.Printf("  Status: %s\n", attachedStatus)
 		fmt.Printf("  Created: %s\n", status.Tmux.Created)
+		if report.UptimeSeconds > 0 {
+			fmt.Printf("  Uptime: %s\n", formatDuration(uptime))
+		}
+		if len(report.LastLines) > 0 {
+			fmt.Printf("\n  %s\n", style.Bold.Render("Recent output:"))
+			for _, line := range report.LastLines {
+				fmt.Printf("    %s\n", style.Dim.Render(line))
+			}
+		}
 	}
 
 	if status.ACPPid != 0 {
@@ -386,6 +542,31 @@ func runMayorStatus(cmd *cobra.Command, args []string) error {
 	return nil
 }
 
+// sessionUptime returns how long ago a session was created, given
+// tmux.SessionInfo's local-time Created string.
+func sessionUptime(created string, now time.Time) (time.Duration, bool) {
+	at, err := time.ParseInLocation("2006-01-02 15:04:05", created, time.Local)
+	if err != nil || at.After(now) {
+		return 0, false
+	}
+	return now.Sub(at), true
+}
+
+// lastOutputLines returns the last n lines of a pane capture with agent UI
+// chrome and blank lines removed.
+func lastOutputLines(lines []string, n int) []string {
+	var out []string
+	for _, line := range agentchat.CleanLines(lines) {
+		if strings.TrimSpace(line) != "" {
+			out = append(out, strings.TrimRight(line, " "))
+		}
+	}
+	if len(out) > n {
+		out = out[len(out)-n:]
+	}
+	return out
+}
+
 func runMayorRestart(cmd *cobra.Command, args []string) error {
 	mgr, err := getMayorManager()
 	if err != nil {
@@ -393,7 +574,7 @@ func runMayorRestart(cmd *cobra.Command, args []string) error {
 	}
 
 	// Stop if running (ignore not-running error)
-	if err := mgr.Stop(); err != nil && err != mayor.ErrNotRunning {
+	if err := stopMayor(mgr); err != nil && err != mayor.ErrNotRunning {
 		return fmt.Errorf("stopping session: %w", err)
 	}
 
@@ -401,6 +582,14 @@ func runMayorRestart(cmd *cobra.Command, args []string) error {
 	return runMayorStart(cmd, args)
 }
 
+// stopMayor stops the Mayor, skipping the graceful phase with --force.
+func stopMayor(mgr *mayor.Manager) error {
+	if mayorStopForce {
+		return mgr.Kill()
+	}
+	return mgr.Stop()
+}
+
 // ensureMayorInfra checks that daemon and dolt are running before attaching
 // to the Mayor session. Warns and auto-starts each if absent.
 // Returns an error if Dolt fails to start — a missing Dolt server is fatal
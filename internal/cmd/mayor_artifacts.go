@@ -0,0 +1,32 @@
+package cmd
+
+import (
+	"sync"
+
+	"github.com/steveyegge/gastown/internal/agentchat"
+	"github.com/steveyegge/gastown/internal/config"
+	"github.com/steveyegge/gastown/internal/gtlog"
+	"github.com/steveyegge/gastown/internal/workspace"
+)
+
+var townArtifactsOnce sync.Once
+
+// useTownArtifactPatterns adds the town's mayor.artifact_patterns (from
+// settings/config.json) to the UI chrome agentchat strips from captured
+// responses. It runs once per process. An invalid pattern is reported and
+// skipped, so one typo doesn't stop the rest from filtering.
+func useTownArtifactPatterns() {
+	townArtifactsOnce.Do(func() {
+		townRoot, err := workspace.FindFromCwd()
+		if err != nil || townRoot == "" {
+			return
+		}
+		settings, err := config.LoadOrCreateTownSettings(config.TownSettingsPath(townRoot))
+		if err != nil || settings.Mayor == nil || len(settings.Mayor.ArtifactPatterns) == 0 {
+			return
+		}
+		if err := agentchat.SetArtifactPatterns(settings.Mayor.ArtifactPatterns); err != nil {
+			gtlog.Warnf("mayor.artifact_patterns: %v", err)
+		}
+	})
+}
@@ -0,0 +1,219 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/steveyegge/gastown/internal/agentchat"
+	"github.com/steveyegge/gastown/internal/gtlog"
+	"github.com/steveyegge/gastown/internal/tmux"
+)
+
+var (
+	mayorAskChoices []string
+	mayorAskJSON    bool
+)
+
+// Exit codes for gt mayor ask when the Mayor replied but not usably.
+const (
+	askExitNoAnswer      = 2 // no ANSWER: line in the response
+	askExitInvalidChoice = 3 // answer is not one of --choices
+)
+
+// answerPrefix starts the line the Mayor is asked to put its answer on.
+const answerPrefix = "ANSWER:"
+
+var mayorAskCmd = &cobra.Command{
+	Use:   "ask [question]",
+	Short: "Ask the Mayor a question and print only its answer",
+	Long: `Ask the running Mayor a question and print just the answer, for scripts.
+
+The question is sent like 'gt mayor chat --sentinel', with an added
+instruction to put the answer on its own line as:
+  ANSWER: <value>
+The value on the last such line of the response is printed to stdout.
+
+With --choices, the Mayor is told the allowed answers and the reply must be
+one of them (compared case-insensitively; the choice is printed as given).
+
+If no question argument is given and stdin is not a terminal, the question is
+read from stdin. --file reads it from a file instead (--file - for stdin).
+
+Exit codes:
+  0  answer printed
+  1  command failed (Mayor not running, busy, tmux error)
+  2  the response had no ANSWER: line
+  3  the answer is not one of --choices
+  4  no response: the Mayor's pane never changed before --timeout
+  5  slow response: the Mayor was still writing when --timeout ran out
+On 2 and 3 the full response is written to stderr.
+
+With --json, a single JSON object is written to stdout:
+  answer      parsed answer (empty if none)
+  response    cleaned response text
+  elapsed_ms  time from send to return
+  session     tmux session that was messaged
+
+Examples:
+  gt mayor ask --choices yes,no "Is the gastown rig healthy?"
+  gt mayor ask --choices merge,hold,reject "What should happen to gt-abc?"
+  gt mayor ask "Which rig has the most open work?"`,
+	Args: cobra.MaximumNArgs(1),
+	RunE: runMayorAsk,
+}
+
+func init() {
+	mayorAskCmd.Flags().StringSliceVar(&mayorAskChoices, "choices", nil, "Allowed answers, comma-separated (e.g. yes,no)")
+	mayorAskCmd.Flags().BoolVar(&mayorAskJSON, "json", false, "Output the answer and response as a JSON object")
+	mayorAskCmd.Flags().DurationVar(&mayorChatTimeout, "timeout", defaultChatTimeout, "Maximum time to wait for an answer (mayor.chat_timeout overrides the default)")
+	mayorAskCmd.Flags().DurationVar(&mayorChatPollInterval, "poll-interval", defaultChatPollInterval, "Initial delay between captures of the Mayor's pane")
+	mayorAskCmd.Flags().DurationVar(&mayorChatMaxPoll, "max-poll-interval", defaultChatMaxPoll, "Longest delay between captures while the pane is quiet")
+	mayorAskCmd.Flags().DurationVar(&mayorChatStableFor, "stable-for", defaultChatStableFor, "How long output must stay unchanged to count as complete")
+	mayorAskCmd.Flags().IntVar(&mayorChatRetries, "capture-retries", defaultChatRetries, "Retries for a failed pane capture before giving up")
+	mayorAskCmd.Flags().DurationVar(&mayorChatGrace, "first-response-grace", defaultChatGrace, "Re-nudge the Mayor if its pane hasn't changed this long after the send (0 disables)")
+	mayorAskCmd.Flags().IntVar(&mayorChatRenudges, "renudges", defaultChatRenudges, "Most re-nudges to send while waiting for a first response")
+	mayorAskCmd.Flags().StringVar(&mayorChatRenudgeWith, "renudge-with", string(agentchat.RenudgeEnter), "How to re-nudge: enter or message")
+	mayorAskCmd.Flags().BoolVar(&mayorChatWaitIdle, "wait-for-idle", false, "Wait for the Mayor to become idle instead of refusing when busy")
+	mayorAskCmd.Flags().BoolVarP(&mayorChatQuiet, "quiet", "q", false, "Suppress status messages on stderr")
+	mayorAskCmd.Flags().StringVar(&mayorChatFile, "file", "", "Send this file's contents as the question (- for stdin)")
+
+	mayorCmd.AddCommand(mayorAskCmd)
+}
+
+// askResult is gt mayor ask's JSON output.
+type askResult struct {
+	Answer    string `json:"answer"`
+	Response  string `json:"response"`
+	ElapsedMs int64  `json:"elapsed_ms"`
+	Session   string `json:"session"`
+}
+
+func runMayorAsk(cmd *cobra.Command, args []string) error {
+	applyChatTimeoutSetting(cmd)
+	opts := agentchat.Options{
+		Timeout:      mayorChatTimeout,
+		PollInterval: mayorChatPollInterval,
+		MaxPoll:      mayorChatMaxPoll,
+		StableFor:    mayorChatStableFor,
+		Retries:      mayorChatRetries,
+		Sentinel:     agentchat.NewSentinel(),
+
+		FirstResponseGrace: mayorChatGrace,
+		Renudges:           mayorChatRenudges,
+		Renudge:            agentchat.RenudgeMode(mayorChatRenudgeWith),
+	}
+	if err := validateChatOptions(opts); err != nil {
+		return err
+	}
+	opts.Logger = gtlog.At(gtlog.Warn)
+	useTownArtifactPatterns()
+	choices := cleanChoices(mayorAskChoices)
+	question, err := readChatMessage(args, mayorChatFile)
+	if err != nil {
+		return err
+	}
+
+	mgr, err := readyMayorForChat(opts.Timeout)
+	if err != nil {
+		return err
+	}
+	opts.Nudge = mgr.NudgeOpts()
+	gtlog.Infof("Waiting for Mayor answer...")
+
+	result, err := agentchat.SendAndCapture(tmux.NewTmux(), mgr.SessionName(), withAnswerInstruction(question, choices), opts)
+	if err != nil {
+		return chatTimeoutExit("", err)
+	}
+
+	answer, found := parseAnswer(result.Response)
+	code := 0
+	switch {
+	case !found:
+		code = askExitNoAnswer
+	case len(choices) > 0:
+		if choice, ok := matchChoice(answer, choices); ok {
+			answer = choice
+		} else {
+			code = askExitInvalidChoice
+		}
+	}
+
+	if mayorAskJSON {
+		enc := json.NewEncoder(os.Stdout)
+		enc.SetIndent("", "  ")
+		if err := enc.Encode(askResult{Answer: answer, Response: result.Response, ElapsedMs: result.ElapsedMs, Session: result.Session}); err != nil {
+			return err
+		}
+	} else if code == 0 {
+		fmt.Println(answer)
+	}
+
+	switch code {
+	case askExitNoAnswer:
+		fmt.Fprintf(os.Stderr, "Mayor reply had no %s line:\n%s\n", answerPrefix, result.Response)
+	case askExitInvalidChoice:
+		fmt.Fprintf(os.Stderr, "Mayor answered %q, not one of %s:\n%s\n", answer, strings.Join(choices, ", "), result.Response)
+	}
+	if code != 0 {
+		return NewSilentExit(code)
+	}
+	return nil
+}
+
+// cleanChoices trims the --choices values and drops empty ones.
+func cleanChoices(choices []string) []string {
+	var out []string
+	for _, c := range choices {
+		if c = strings.TrimSpace(c); c != "" {
+			out = append(out, c)
+		}
+	}
+	return out
+}
+
+// withAnswerInstruction appends the ANSWER: line instruction to a question.
+// Like agentchat.WithSentinelInstruction, it stays on one line so send-keys
+// doesn't submit the prompt early.
+func withAnswerInstruction(question string, choices []string) string {
+	instruction := "(Put your final answer on its own line as " + answerPrefix + " <value>"
+	if len(choices) > 0 {
+		instruction += ", where <value> is exactly one of: " + strings.Join(choices, ", ")
+	}
+	return question + " " + instruction + ")"
+}
+
+// parseAnswer returns the value on the last ANSWER: line of a response.
+// The prefix is matched case-insensitively, and markdown emphasis, code
+// ticks, quotes, and a response bullet around the line or value are ignored.
+// The instruction's own "<value>" placeholder never counts as an answer.
+func parseAnswer(response string) (string, bool) {
+	lines := strings.Split(response, "\n")
+	for i := len(lines) - 1; i >= 0; i-- {
+		line := strings.TrimSpace(strings.TrimPrefix(strings.TrimSpace(lines[i]), "⏺"))
+		line = strings.Trim(line, "*_` ")
+		if len(line) < len(answerPrefix) || !strings.EqualFold(line[:len(answerPrefix)], answerPrefix) {
+			continue
+		}
+		value := strings.TrimSuffix(strings.TrimSpace(line[len(answerPrefix):]), ".")
+		value = strings.Trim(value, "*_`\"' ")
+		if value == "" || value == "<value>" {
+			continue
+		}
+		return value, true
+	}
+	return "", false
+}
+
+// matchChoice returns the choice an answer names, comparing
+// case-insensitively.
+func matchChoice(answer string, choices []string) (string, bool) {
+	for _, c := range choices {
+		if strings.EqualFold(answer, c) {
+			return c, true
+		}
+	}
+	return "", false
+}
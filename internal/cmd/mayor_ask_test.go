@@ -0,0 +1,65 @@
+package cmd
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/steveyegge/gastown/internal/agentchat"
+)
+
+func TestParseAnswer(t *testing.T) {
+	tests := []struct {
+		name     string
+		response string
+		want     string
+		wantOK   bool
+	}{
+		{"plain", "⏺ The rig looks healthy.\nANSWER: yes", "yes", true},
+		{"bullet and bold", "⏺ **ANSWER:** `merge`.", "merge", true},
+		{"lowercase prefix and quotes", "answer: \"hold\"", "hold", true},
+		{"last line wins", "ANSWER: no\nOn reflection:\nANSWER: yes", "yes", true},
+		{"placeholder from echoed instruction", "own line as ANSWER: <value>)\nANSWER: <value>", "", false},
+		{"no answer line", "⏺ I'm not sure, want me to check?", "", false},
+		{"empty value", "ANSWER:", "", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := parseAnswer(tt.response)
+			if got != tt.want || ok != tt.wantOK {
+				t.Errorf("parseAnswer = %q, %v; want %q, %v", got, ok, tt.want, tt.wantOK)
+			}
+		})
+	}
+}
+
+func TestMatchChoice(t *testing.T) {
+	choices := []string{"yes", "no"}
+	if got, ok := matchChoice("Yes", choices); !ok || got != "yes" {
+		t.Errorf("matchChoice(Yes) = %q, %v; want yes, true", got, ok)
+	}
+	if _, ok := matchChoice("maybe", choices); ok {
+		t.Error("matchChoice(maybe) matched, want no match")
+	}
+}
+
+func TestMayorAsk_ExtractsAnswerFromSentinelReply(t *testing.T) {
+	const sentinel = "<<GT-END:1a2b3c4d>>"
+	question := withAnswerInstruction("Is gastown healthy?", cleanChoices([]string{" yes", "no ", ""}))
+	if !strings.Contains(question, "exactly one of: yes, no)") {
+		t.Fatalf("instruction = %q, want the choices listed", question)
+	}
+	echo := "❯ " + agentchat.WithSentinelInstruction(question, sentinel)
+
+	lines := []string{"old", echo, "", "⏺ All agents are up.", "  ANSWER: Yes", "⏺ " + sentinel, "", "❯ "}
+	response, _, complete := agentchat.Extract(lines, 0, question, sentinel)
+	if !complete {
+		t.Fatal("sentinel not detected")
+	}
+	answer, ok := parseAnswer(strings.Join(response, "\n"))
+	if !ok {
+		t.Fatalf("no answer parsed from %q", response)
+	}
+	if got, ok := matchChoice(answer, []string{"yes", "no"}); !ok || got != "yes" {
+		t.Errorf("answer = %q (%q, %v), want yes", answer, got, ok)
+	}
+}
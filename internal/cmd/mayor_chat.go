@@ -0,0 +1,733 @@
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"os/signal"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/steveyegge/gastown/internal/agentchat"
+	"github.com/steveyegge/gastown/internal/gtlog"
+	"github.com/steveyegge/gastown/internal/mayor"
+	"github.com/steveyegge/gastown/internal/style"
+	"github.com/steveyegge/gastown/internal/tmux"
+	"github.com/steveyegge/gastown/internal/workspace"
+	"golang.org/x/term"
+)
+
+var (
+	mayorChatTimeout      time.Duration
+	mayorChatPollInterval time.Duration
+	mayorChatMaxPoll      time.Duration
+	mayorChatStableFor    time.Duration
+	mayorChatQuiet        bool
+	mayorChatStream       bool
+	mayorChatJSON         bool
+	mayorChatSentinel     bool
+	mayorChatRetries      int
+	mayorChatWaitIdle     bool
+	mayorChatBatch        bool
+	mayorChatDelimiter    string
+	mayorChatStrict       bool
+	mayorChatUnwrap       bool
+	mayorChatContext      bool
+	mayorChatSave         bool
+	mayorChatSaveConvoy   string
+	mayorChatGrace        time.Duration
+	mayorChatRenudges     int
+	mayorChatRenudgeWith  string
+	mayorChatDebugCapture string
+	mayorChatFile         string
+)
+
+// Default chat polling parameters. Polling starts at the poll interval and
+// backs off toward the max while the pane is quiet. The stability window is
+// how long the pane must stay unchanged before the response is considered
+// complete.
+const (
+	defaultChatTimeout      = 2 * time.Minute
+	defaultChatPollInterval = 100 * time.Millisecond
+	defaultChatMaxPoll      = time.Second
+	defaultChatStableFor    = 2 * time.Second
+	defaultChatRetries      = 3
+	defaultChatGrace        = 10 * time.Second
+	defaultChatRenudges     = 1
+)
+
+// Exit codes for gt mayor chat. Codes 2 and 3 are only used with --strict.
+const (
+	chatExitEmptyResponse = 2   // response was empty after UI cleanup
+	chatExitErrorResponse = 3   // response reports an error
+	chatExitNoResponse    = 4   // timed out with the pane unchanged since the send
+	chatExitTimeout       = 5   // timed out while the Mayor was still writing
+	chatExitInterrupted   = 130 // stopped by Ctrl-C or SIGTERM, as a shell reports SIGINT
+)
+
+var mayorChatCmd = &cobra.Command{
+	Use:   "chat [message]",
+	Short: "Send a message to the Mayor and print the response",
+	Long: `Send a message to the running Mayor session and print its response.
+
+The message is delivered via the same nudge path as 'gt nudge mayor'. The
+command then polls the Mayor's pane until the output stops changing, strips
+agent UI chrome (prompt box, status bar), and prints the response to stdout.
+Lines matching a regular expression in mayor.artifact_patterns in
+settings/config.json are stripped too, for agent CLIs other than Claude Code.
+
+If no message argument is given and stdin is not a terminal, the message is
+read from stdin. --file sends a file's contents instead, with no shell
+quoting to get right (--file - reads stdin, as when it is piped). The
+message comes from exactly one of these: an argument together with --file,
+or --file with a path while stdin is piped, is an error.
+
+If the Mayor is busy (no idle prompt visible), the command refuses to send so
+the message doesn't get interleaved with in-flight work. Use --wait-for-idle
+to wait (up to --timeout) for the Mayor to finish instead.
+
+With --batch, stdin (or the --file) is split into separate turns: one per line, or one per
+block if the input contains '---' separator lines. Empty segments are skipped.
+Each turn waits for its response before the next is sent, and responses are
+printed separated by --delimiter (JSON output is an array of results).
+A timed-out turn stops the batch; with --json the remaining turns still
+run, and the first timeout's exit code is returned after the array.
+
+With --stream, response lines are printed as they appear instead of all at
+once after the output stabilizes.
+
+With --append-context, a one-line summary of workspace state is put before
+the message, so the Mayor can answer without looking it up: issue counts
+summed over the town's and every rig's beads, and the polecats with live
+sessions. The summary is
+rendered from mayor.context_template in settings/config.json (a Go
+text/template over .Open, .Ready, .InProgress, .Blocked, .Polecats, and
+.Sessions) and is stripped from the printed response.
+
+With --unwrap, rows that fill the Mayor's pane width are joined with the row
+after them, so long sentences aren't split where tmux wrapped them. Streamed
+lines are printed as captured, before unwrapping.
+
+--poll-interval and --stable-for tune how often the pane is captured and how
+long it must stay unchanged before the response is considered complete. Use a
+shorter --stable-for for quick yes/no questions and a longer one for code
+generation that pauses between bursts. --stable-for must be less than
+--timeout.
+
+Polling starts every --poll-interval and, while the pane stays unchanged,
+doubles up to --max-poll-interval; new output resets it. A poll is always
+taken as soon as --stable-for has elapsed since the last change, so backoff
+never delays completion. Set --max-poll-interval equal to --poll-interval to
+poll at a fixed rate.
+
+Transient capture failures (e.g. the pane redrawing during a tmux server
+reload) are retried up to --capture-retries times with a short backoff before
+the command gives up.
+
+TUIs that debounce input occasionally swallow a message. If the pane hasn't
+changed --first-response-grace after the send, the Mayor is re-nudged, up to
+--renudges times (each restarting the grace period), before the wait goes
+on. --renudge-with picks how: "enter" (the default) presses Enter, which
+submits a message left in the input box and is harmless at an empty prompt;
+"message" sends the whole message again, which the Mayor sees twice if the
+first send did arrive. --timeout still counts from the first send. Set
+--first-response-grace to 0 to disable re-nudging.
+
+With --sentinel, the message is sent with an instruction asking the Mayor to
+finish its reply with a unique end marker (<<GT-END:id>>). The response is
+then sliced precisely between the echoed prompt and the marker, and the wait
+ends as soon as the marker appears. If the marker never shows up, the normal
+stabilization heuristic is used.
+
+The response is located with the most precise method that works, so
+agent UIs that strip or reformat the injected text still get an answer:
+  sentinel  between the echoed --sentinel instruction and its end marker
+  anchor    after the last line echoing the message
+  baseline  after the lines that were on screen before the send
+
+--debug-capture writes the raw final capture of each exchange to stderr,
+with how the response was located in it: the extraction method, the pre-send
+line count (before_len) and where it falls in the capture (baseline), the
+start and end rows of the response, and whether a --sentinel end marker was
+seen. Rows marked ">" are the ones the response was cleaned from. Give it a
+path (--debug-capture=FILE) to write there instead. It is printed even with
+--quiet; attach it to reports of wrong or missing responses.
+
+With --json, a single JSON object is written to stdout:
+  response    cleaned response text
+  elapsed_ms  time from send to return
+  session     tmux session that was messaged
+  truncated   true if the start of the response may have scrolled out of
+              the capture window
+  stabilized  true if output stabilized, false if the timeout was hit
+  responded   true if the pane changed at all after the message was sent
+  extraction  how the response was located: sentinel, anchor, or baseline
+  renudged    re-nudges sent before the Mayor reacted (omitted if none)
+  saved_as    message issue recorded by --save (omitted otherwise)
+
+A timeout is reported with its own exit code, depending on whether the Mayor
+reacted at all. No output means the message may never have arrived and is
+safe to resend; output that was still changing means the Mayor is working
+and resending would interrupt or duplicate that work:
+  4  no response: the pane never changed before --timeout
+  5  slow response: output was still changing when --timeout ran out
+
+Ctrl-C (or SIGTERM) while waiting stops polling at once instead of running
+out --timeout. Any partial response is written to stderr with a note (with
+--json, the partial result is still written to stdout), and the command
+exits 130. The Mayor may still be replying, so check its session before
+resending.
+
+With --save, each completed exchange is recorded in the town beads as a
+closed message issue holding the prompt, the response, the session, and
+when it happened, so past conversations can be searched later. The issue is
+closed so convoy feeding never delivers it back to the Mayor. --convoy adds
+the saved message to a convoy; it is reported on stderr and as "saved_as"
+with --json. Exchanges that time out are not saved.
+
+With --strict, the exit code also reflects whether the Mayor actually
+answered:
+  0  response received
+  1  command failed (Mayor not running, busy, tmux error)
+  2  response was empty after removing UI chrome
+  3  response reports an error (a line starting with "Error:" or "API Error")
+The response, if any, is still printed before a nonzero strict exit.
+
+Examples:
+  gt mayor chat "What's the status of the gastown rig?"
+  echo "Summarize open convoys" | gt mayor chat
+  gt mayor chat --file prompts/release-review.md
+  gt mayor chat --stream "Review the backlog"
+  gt mayor chat --stable-for 5s "Draft a migration plan"
+  gt mayor chat --batch < interview.txt
+  gt mayor chat --append-context "What should the next polecat pick up?"
+  gt mayor chat --save --convoy hq-cv-abc "Plan the next stage"
+  gt mayor chat --json "List parked rigs" | jq -r .response`,
+	Args: cobra.MaximumNArgs(1),
+	RunE: runMayorChat,
+}
+
+func init() {
+	mayorChatCmd.Flags().DurationVar(&mayorChatTimeout, "timeout", defaultChatTimeout, "Maximum time to wait for a response (mayor.chat_timeout overrides the default)")
+	mayorChatCmd.Flags().DurationVar(&mayorChatPollInterval, "poll-interval", defaultChatPollInterval, "Initial delay between captures of the Mayor's pane")
+	mayorChatCmd.Flags().DurationVar(&mayorChatMaxPoll, "max-poll-interval", defaultChatMaxPoll, "Longest delay between captures while the pane is quiet")
+	mayorChatCmd.Flags().DurationVar(&mayorChatStableFor, "stable-for", defaultChatStableFor, "How long output must stay unchanged to count as complete")
+	mayorChatCmd.Flags().BoolVarP(&mayorChatQuiet, "quiet", "q", false, "Suppress status messages on stderr")
+	mayorChatCmd.Flags().BoolVar(&mayorChatStream, "stream", false, "Print response lines as they appear")
+	mayorChatCmd.Flags().BoolVar(&mayorChatJSON, "json", false, "Output the response as a JSON object")
+	mayorChatCmd.Flags().IntVar(&mayorChatRetries, "capture-retries", defaultChatRetries, "Retries for a failed pane capture before giving up")
+	mayorChatCmd.Flags().DurationVar(&mayorChatGrace, "first-response-grace", defaultChatGrace, "Re-nudge the Mayor if its pane hasn't changed this long after the send (0 disables)")
+	mayorChatCmd.Flags().IntVar(&mayorChatRenudges, "renudges", defaultChatRenudges, "Most re-nudges to send while waiting for a first response")
+	mayorChatCmd.Flags().StringVar(&mayorChatRenudgeWith, "renudge-with", string(agentchat.RenudgeEnter), "How to re-nudge: enter or message")
+	mayorChatCmd.Flags().BoolVar(&mayorChatWaitIdle, "wait-for-idle", false, "Wait for the Mayor to become idle instead of refusing when busy")
+	mayorChatCmd.Flags().BoolVar(&mayorChatSentinel, "sentinel", false, "Ask the Mayor to end its reply with a unique marker for precise extraction")
+	mayorChatCmd.Flags().BoolVar(&mayorChatBatch, "batch", false, "Send each stdin (or --file) line or ---delimited block as a separate turn")
+	mayorChatCmd.Flags().BoolVar(&mayorChatStrict, "strict", false, "Exit nonzero when the response is empty or reports an error")
+	mayorChatCmd.Flags().BoolVar(&mayorChatUnwrap, "unwrap", false, "Re-join lines the pane wrapped at its width")
+	mayorChatCmd.Flags().StringVar(&mayorChatDelimiter, "delimiter", batchSeparator, "Separator printed between responses in --batch mode")
+	mayorChatCmd.Flags().BoolVar(&mayorChatContext, "append-context", false, "Put a summary of workspace state (issue counts, active polecats) before the message")
+	mayorChatCmd.Flags().BoolVar(&mayorChatSave, "save", false, "Record each completed exchange as a closed message issue in the town beads")
+	mayorChatCmd.Flags().StringVar(&mayorChatSaveConvoy, "convoy", "", "With --save, add the saved message to this convoy")
+	mayorChatCmd.Flags().StringVar(&mayorChatDebugCapture, "debug-capture", "", "Write the raw capture and extraction details to stderr, or to =FILE")
+	mayorChatCmd.Flags().Lookup("debug-capture").NoOptDefVal = "-"
+	mayorChatCmd.Flags().StringVar(&mayorChatFile, "file", "", "Send this file's contents as the message (- for stdin)")
+
+	mayorCmd.AddCommand(mayorChatCmd)
+}
+
+func runMayorChat(cmd *cobra.Command, args []string) error {
+	applyChatTimeoutSetting(cmd)
+	if mayorChatStream && mayorChatJSON {
+		return fmt.Errorf("--stream and --json cannot be used together")
+	}
+	if mayorChatBatch && len(args) > 0 {
+		return fmt.Errorf("--batch reads messages from stdin or --file; do not pass a message argument")
+	}
+	if mayorChatSaveConvoy != "" && !mayorChatSave {
+		return fmt.Errorf("--convoy requires --save")
+	}
+	opts := agentchat.Options{
+		Timeout:      mayorChatTimeout,
+		PollInterval: mayorChatPollInterval,
+		MaxPoll:      mayorChatMaxPoll,
+		StableFor:    mayorChatStableFor,
+		Retries:      mayorChatRetries,
+
+		FirstResponseGrace: mayorChatGrace,
+		Renudges:           mayorChatRenudges,
+		Renudge:            agentchat.RenudgeMode(mayorChatRenudgeWith),
+	}
+	if err := validateChatOptions(opts); err != nil {
+		return err
+	}
+	opts.Logger = gtlog.At(gtlog.Warn)
+	useTownArtifactPatterns()
+	message, err := readChatMessage(args, mayorChatFile)
+	if err != nil {
+		return err
+	}
+
+	mgr, err := readyMayorForChat(opts.Timeout)
+	if err != nil {
+		return err
+	}
+	opts.Nudge = mgr.NudgeOpts()
+
+	gtlog.Infof("Waiting for Mayor response...")
+
+	t := tmux.NewTmux()
+	if mayorChatContext {
+		townRoot, err := workspace.FindFromCwdOrError()
+		if err != nil {
+			return fmt.Errorf("not in a Gas Town workspace: %w", err)
+		}
+		if opts.Context, err = renderChatContext(chatContextTemplate(townRoot), gatherChatContext(townRoot, t)); err != nil {
+			return err
+		}
+	}
+	if err := setChatOutput(t, mgr.SessionName(), &opts); err != nil {
+		return err
+	}
+	closeDebug, err := openCaptureDebug(mayorChatDebugCapture, &opts)
+	if err != nil {
+		return err
+	}
+	defer closeDebug()
+	var recorder *chatRecorder
+	if mayorChatSave {
+		if recorder, err = openChatRecorder(mayorChatSaveConvoy); err != nil {
+			return err
+		}
+		defer recorder.close()
+	}
+	ctx, stop := chatSignalContext()
+	defer stop()
+	if mayorChatBatch {
+		return runMayorChatBatch(ctx, t, mgr.SessionName(), splitBatch(message), opts, recorder)
+	}
+	return chatWithSession(ctx, t, mgr.SessionName(), message, opts, recorder)
+}
+
+// chatSignalContext returns a context cancelled by Ctrl-C or SIGTERM, so a
+// chat stops polling immediately instead of running out --timeout. Install
+// it just before the send: until stop is called, those signals no longer
+// kill the process by default.
+func chatSignalContext() (context.Context, context.CancelFunc) {
+	return signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+}
+
+// setChatOutput applies --stream and --unwrap to opts for sessionName.
+func setChatOutput(t *tmux.Tmux, sessionName string, opts *agentchat.Options) error {
+	if mayorChatStream {
+		opts.OnLines = func(lines []string) {
+			for _, line := range lines {
+				fmt.Println(line)
+			}
+		}
+	}
+	if mayorChatUnwrap {
+		cols, _, err := t.PaneSize(sessionName)
+		if err != nil {
+			return fmt.Errorf("reading pane size: %w", err)
+		}
+		opts.WrapWidth = cols
+	}
+	return nil
+}
+
+// chatWithSession sends one message to an agent's tmux session and prints
+// the response per --sentinel and --json. A timeout, interrupt, or --strict
+// failure is returned as a SilentExitError carrying its exit code.
+func chatWithSession(ctx context.Context, t *tmux.Tmux, sessionName, message string, opts agentchat.Options, recorder *chatRecorder) error {
+	if mayorChatSentinel {
+		opts.Sentinel = agentchat.NewSentinel()
+	}
+	captured, err := agentchat.SendAndCaptureContext(ctx, t, sessionName, message, opts)
+	if err != nil && ctx.Err() != nil {
+		if mayorChatJSON && captured != nil {
+			enc := json.NewEncoder(os.Stdout)
+			enc.SetIndent("", "  ")
+			_ = enc.Encode(&chatResult{Result: captured})
+		}
+		return chatInterruptExit("", captured)
+	}
+	if captured == nil {
+		return err
+	}
+	result := &chatResult{Result: captured}
+	saveErr := saveChatExchange(recorder, message, result, err)
+
+	if mayorChatJSON {
+		enc := json.NewEncoder(os.Stdout)
+		enc.SetIndent("", "  ")
+		if err := enc.Encode(result); err != nil {
+			return err
+		}
+		if err != nil {
+			return chatTimeoutExit("", err)
+		}
+		if saveErr != nil {
+			return saveErr
+		}
+		return strictChatExit(result)
+	}
+
+	if err != nil {
+		return chatTimeoutExit("", err)
+	}
+	if !mayorChatStream {
+		fmt.Println(result.Response)
+	}
+	if saveErr != nil {
+		return saveErr
+	}
+	return strictChatExit(result)
+}
+
+// saveChatExchange records a completed exchange with recorder, if --save
+// gave one, setting result.SavedAs. Exchanges that timed out (chatErr set)
+// are not saved, since the response may be partial.
+func saveChatExchange(recorder *chatRecorder, message string, result *chatResult, chatErr error) error {
+	if recorder == nil || chatErr != nil {
+		return nil
+	}
+	id, err := recorder.save(message, result)
+	if err != nil {
+		return err
+	}
+	result.SavedAs = id
+	gtlog.Infof("Saved as %s", id)
+	return nil
+}
+
+// readyMayorForChat returns the Mayor's manager once its session is running
+// and idle. A busy Mayor is an error unless --wait-for-idle is set, in which
+// case it waits up to timeout.
+func readyMayorForChat(timeout time.Duration) (*mayor.Manager, error) {
+	if err := tmux.Available(); err != nil {
+		return nil, err
+	}
+	mgr, err := getMayorManager()
+	if err != nil {
+		return nil, err
+	}
+
+	running, err := mgr.IsRunning()
+	if err != nil {
+		return nil, fmt.Errorf("checking session: %w", err)
+	}
+	if !running {
+		return nil, fmt.Errorf("Mayor session is not running. Start with: gt mayor start")
+	}
+
+	if err := waitForChatIdle(tmux.NewTmux(), mgr.SessionName(), "Mayor", timeout); err != nil {
+		return nil, err
+	}
+	return mgr, nil
+}
+
+// waitForChatIdle returns once the agent in sessionName is at its idle
+// prompt. A busy agent (who names it in messages) is an error unless
+// --wait-for-idle is set, in which case it waits up to timeout.
+func waitForChatIdle(t *tmux.Tmux, sessionName, who string, timeout time.Duration) error {
+	if t.IsIdle(sessionName) {
+		return nil
+	}
+	if !mayorChatWaitIdle {
+		return fmt.Errorf("%s is busy. Retry later or use --wait-for-idle", who)
+	}
+	gtlog.Infof("%s is busy, waiting for idle prompt...", who)
+	if err := t.WaitForIdle(sessionName, timeout); err != nil {
+		return fmt.Errorf("waiting for %s to become idle: %w", who, err)
+	}
+	return nil
+}
+
+// applyChatTimeoutSetting makes the town's mayor.chat_timeout the --timeout
+// when the flag wasn't given.
+func applyChatTimeoutSetting(cmd *cobra.Command) {
+	if cmd.Flags().Changed("timeout") {
+		return
+	}
+	townRoot, err := workspace.FindFromCwd()
+	if err != nil || townRoot == "" {
+		return
+	}
+	if cfg, err := workspace.LoadConfig(townRoot); err == nil {
+		mayorChatTimeout = cfg.MayorChatTimeout(defaultChatTimeout)
+	}
+}
+
+// chatTimeoutExit explains a timeout from agentchat.SendAndCapture on
+// stderr, with whether a retry is safe, and returns a SilentExitError
+// carrying its exit code. Other errors are returned unchanged.
+func chatTimeoutExit(prefix string, err error) error {
+	var code int
+	var hint string
+	switch {
+	case errors.Is(err, agentchat.ErrNoResponse):
+		code, hint = chatExitNoResponse, "The pane never changed after the send, so the message may not have arrived. Retrying is safe."
+	case errors.Is(err, agentchat.ErrResponseTimeout):
+		code, hint = chatExitTimeout, "The agent was still writing when time ran out. Wait for it to finish before retrying, or raise --timeout."
+	default:
+		return err
+	}
+	fmt.Fprintf(os.Stderr, "Error: %s%v\n%s\n", prefix, err, style.RenderStderr(style.Dim, hint))
+	return NewSilentExit(code)
+}
+
+// chatInterruptExit reports a chat stopped by a signal on stderr: the
+// partial response, unless --stream already printed it or --json carries
+// it on stdout, then a note. Returns a SilentExitError carrying
+// chatExitInterrupted.
+func chatInterruptExit(prefix string, captured *agentchat.Result) error {
+	note := "Interrupted before a response arrived."
+	if captured != nil && captured.Responded {
+		note = fmt.Sprintf("Interrupted after %s; the response above is partial.", (time.Duration(captured.ElapsedMs) * time.Millisecond).Round(100*time.Millisecond))
+		if !mayorChatJSON && !mayorChatStream && captured.Response != "" {
+			fmt.Fprintln(os.Stderr, captured.Response)
+		}
+	}
+	fmt.Fprintf(os.Stderr, "%s%s\n%s\n", prefix, note, style.RenderStderr(style.Dim, "The agent may still be replying; check its session before resending."))
+	return NewSilentExit(chatExitInterrupted)
+}
+
+// strictChatExit returns a SilentExitError for failed responses when
+// --strict is set, and nil otherwise.
+func strictChatExit(result *chatResult) error {
+	if !mayorChatStrict || !result.Stabilized {
+		return nil
+	}
+	if code := classifyChatResponse(result.Response); code != 0 {
+		return NewSilentExit(code)
+	}
+	return nil
+}
+
+// classifyChatResponse returns the strict exit code for a cleaned response:
+// 0 for a normal answer, chatExitEmptyResponse if nothing remains, or
+// chatExitErrorResponse if any line reports an error.
+func classifyChatResponse(response string) int {
+	if strings.TrimSpace(response) == "" {
+		return chatExitEmptyResponse
+	}
+	for _, line := range strings.Split(response, "\n") {
+		trimmed := strings.TrimSpace(strings.TrimPrefix(strings.TrimSpace(line), "⏺"))
+		if strings.HasPrefix(trimmed, "Error:") || strings.HasPrefix(trimmed, "API Error") {
+			return chatExitErrorResponse
+		}
+	}
+	return 0
+}
+
+// runMayorChatBatch sends each segment as its own turn, carrying the pane
+// baseline forward so each extraction only sees output from its own turn.
+func runMayorChatBatch(ctx context.Context, t *tmux.Tmux, sessionName string, segments []string, opts agentchat.Options, recorder *chatRecorder) error {
+	if len(segments) == 0 {
+		return fmt.Errorf("no messages found on stdin")
+	}
+
+	var results []*chatResult
+	// With --json the remaining turns still run after a timeout; the first
+	// one sets the exit code once the results are written.
+	var timeoutErr error
+	var timeoutPrefix string
+	for i, segment := range segments {
+		if mayorChatSentinel {
+			opts.Sentinel = agentchat.NewSentinel()
+		}
+		if i > 0 && !mayorChatJSON {
+			fmt.Println(mayorChatDelimiter)
+		}
+
+		captured, err := agentchat.SendAndCaptureContext(ctx, t, sessionName, segment, opts)
+		if err != nil && ctx.Err() != nil {
+			if mayorChatJSON {
+				if captured != nil {
+					results = append(results, &chatResult{Result: captured})
+				}
+				enc := json.NewEncoder(os.Stdout)
+				enc.SetIndent("", "  ")
+				_ = enc.Encode(results)
+			}
+			return chatInterruptExit(fmt.Sprintf("turn %d: ", i+1), captured)
+		}
+		if captured == nil {
+			return fmt.Errorf("turn %d: %w", i+1, err)
+		}
+		result := &chatResult{Result: captured}
+		opts.Baseline = result.CapturedLines
+		if saveErr := saveChatExchange(recorder, segment, result, err); saveErr != nil {
+			return fmt.Errorf("turn %d: %w", i+1, saveErr)
+		}
+
+		if mayorChatJSON {
+			results = append(results, result)
+			if err != nil && timeoutErr == nil {
+				timeoutErr, timeoutPrefix = err, fmt.Sprintf("turn %d: ", i+1)
+			}
+			continue
+		}
+		if !mayorChatStream {
+			fmt.Println(result.Response)
+		}
+		if err != nil {
+			return chatTimeoutExit(fmt.Sprintf("turn %d: ", i+1), err)
+		}
+	}
+
+	if mayorChatJSON {
+		enc := json.NewEncoder(os.Stdout)
+		enc.SetIndent("", "  ")
+		if err := enc.Encode(results); err != nil {
+			return err
+		}
+		if timeoutErr != nil {
+			return chatTimeoutExit(timeoutPrefix, timeoutErr)
+		}
+	}
+	return nil
+}
+
+// batchSeparator separates multi-line blocks in --batch input, and is the
+// default delimiter between responses.
+const batchSeparator = "---"
+
+// splitBatch splits batch input into messages. If any line is the block
+// separator, input is split into blocks on it; otherwise each line is a
+// message. Blank segments are dropped.
+func splitBatch(input string) []string {
+	input = strings.ReplaceAll(input, "\r\n", "\n")
+	lines := strings.Split(input, "\n")
+
+	blockMode := false
+	for _, line := range lines {
+		if strings.TrimSpace(line) == batchSeparator {
+			blockMode = true
+			break
+		}
+	}
+
+	var segments []string
+	if !blockMode {
+		for _, line := range lines {
+			if line = strings.TrimSpace(line); line != "" {
+				segments = append(segments, line)
+			}
+		}
+		return segments
+	}
+
+	var block []string
+	flush := func() {
+		if text := strings.TrimSpace(strings.Join(block, "\n")); text != "" {
+			segments = append(segments, text)
+		}
+		block = block[:0]
+	}
+	for _, line := range lines {
+		if strings.TrimSpace(line) == batchSeparator {
+			flush()
+			continue
+		}
+		block = append(block, line)
+	}
+	flush()
+	return segments
+}
+
+// validateChatOptions checks that the flag durations are usable together.
+func validateChatOptions(o agentchat.Options) error {
+	if o.Timeout <= 0 {
+		return fmt.Errorf("--timeout must be positive")
+	}
+	if o.PollInterval <= 0 {
+		return fmt.Errorf("--poll-interval must be positive")
+	}
+	if o.MaxPoll != 0 && o.MaxPoll < o.PollInterval {
+		return fmt.Errorf("--max-poll-interval (%s) must not be less than --poll-interval (%s)", o.MaxPoll, o.PollInterval)
+	}
+	if o.StableFor <= 0 {
+		return fmt.Errorf("--stable-for must be positive")
+	}
+	if o.Retries < 0 {
+		return fmt.Errorf("--capture-retries must not be negative")
+	}
+	if o.StableFor >= o.Timeout {
+		return fmt.Errorf("--stable-for (%s) must be less than --timeout (%s)", o.StableFor, o.Timeout)
+	}
+	if o.FirstResponseGrace < 0 {
+		return fmt.Errorf("--first-response-grace must not be negative")
+	}
+	if o.Renudges < 0 {
+		return fmt.Errorf("--renudges must not be negative")
+	}
+	if _, err := agentchat.ParseRenudgeMode(string(o.Renudge)); err != nil {
+		return fmt.Errorf("--renudge-with: %w", err)
+	}
+	return nil
+}
+
+// chatResult is gt mayor chat's JSON output for one exchange.
+type chatResult struct {
+	*agentchat.Result
+	SavedAs string `json:"saved_as,omitempty"` // message issue written by --save
+}
+
+// readChatMessage returns the chat message from exactly one source: the
+// positional argument, the file named by --file ("-" for stdin), or stdin
+// when no argument is given and stdin is not a terminal.
+func readChatMessage(args []string, file string) (string, error) {
+	return readChatMessageFrom(args, file, os.Stdin)
+}
+
+func readChatMessageFrom(args []string, file string, stdin *os.File) (string, error) {
+	var data []byte
+	switch {
+	case file != "" && len(args) > 0:
+		return "", fmt.Errorf("--file and a message argument cannot be used together; give one")
+	case len(args) > 0:
+		data = []byte(args[0])
+	case file == "" || file == "-":
+		if term.IsTerminal(int(stdin.Fd())) {
+			if file == "-" {
+				return "", fmt.Errorf("--file - reads the message from stdin, but stdin is a terminal")
+			}
+			return "", fmt.Errorf("message required: provide as an argument, with --file, or pipe via stdin")
+		}
+		var err error
+		if data, err = io.ReadAll(stdin); err != nil {
+			return "", fmt.Errorf("reading stdin: %w", err)
+		}
+	default:
+		if stdinPiped(stdin) {
+			return "", fmt.Errorf("--file %s and piped stdin cannot be used together; give one", file)
+		}
+		var err error
+		if data, err = os.ReadFile(file); err != nil {
+			return "", fmt.Errorf("reading --file: %w", err)
+		}
+	}
+	message := strings.TrimSpace(string(data))
+	if message == "" {
+		return "", fmt.Errorf("message must not be empty")
+	}
+	return message, nil
+}
+
+// stdinPiped reports whether stdin carries input: a pipe, or a redirected
+// file that isn't empty. An empty or device stdin (such as /dev/null, as cron
+// and CI give) doesn't count.
+func stdinPiped(stdin *os.File) bool {
+	info, err := stdin.Stat()
+	if err != nil {
+		return false
+	}
+	mode := info.Mode()
+	return mode&os.ModeNamedPipe != 0 || (mode.IsRegular() && info.Size() > 0)
+}
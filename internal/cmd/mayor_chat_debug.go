@@ -0,0 +1,50 @@
+package cmd
+
+import (
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/steveyegge/gastown/internal/agentchat"
+)
+
+// openCaptureDebug points opts.OnCapture at the --debug-capture destination:
+// stderr for "-", otherwise a file at path, created or truncated. It does
+// nothing when path is empty. Call the returned func when the chat is done.
+func openCaptureDebug(path string, opts *agentchat.Options) (func(), error) {
+	if path == "" {
+		return func() {}, nil
+	}
+	var w io.Writer = os.Stderr
+	closeFn := func() {}
+	if path != "-" {
+		f, err := os.Create(path)
+		if err != nil {
+			return nil, fmt.Errorf("opening --debug-capture file: %w", err)
+		}
+		w, closeFn = f, func() { _ = f.Close() }
+	}
+	opts.OnCapture = func(d agentchat.CaptureDebug) { writeCaptureDebug(w, d) }
+	return closeFn, nil
+}
+
+// writeCaptureDebug prints a capture and where the response was found in
+// it. Rows marked ">" are the ones the response was cleaned from.
+func writeCaptureDebug(w io.Writer, d agentchat.CaptureDebug) {
+	fmt.Fprintf(w, "=== capture debug ===\n")
+	fmt.Fprintf(w, "method:     %s\n", d.Method)
+	fmt.Fprintf(w, "before_len: %d\n", d.BeforeLen)
+	fmt.Fprintf(w, "baseline:   %d\n", d.Baseline)
+	fmt.Fprintf(w, "start:      %d\n", d.Start)
+	fmt.Fprintf(w, "end:        %d\n", d.End)
+	fmt.Fprintf(w, "complete:   %t\n", d.Complete)
+	fmt.Fprintf(w, "lines:      %d\n", len(d.Lines))
+	for i, line := range d.Lines {
+		mark := " "
+		if i >= d.Start && i < d.End {
+			mark = ">"
+		}
+		fmt.Fprintf(w, "%4d %s| %s\n", i, mark, line)
+	}
+	fmt.Fprintf(w, "=== end capture debug ===\n")
+}
@@ -0,0 +1,60 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"time"
+
+	beadsdk "github.com/steveyegge/beads"
+	"github.com/steveyegge/gastown/internal/convoy"
+)
+
+// chatRecorder saves chat exchanges as message issues in the town store,
+// for gt mayor chat --save.
+type chatRecorder struct {
+	store    beadsdk.Storage
+	convoyID string
+	actor    string
+}
+
+// openChatRecorder opens the town store for --save and checks the convoy, if
+// given, so a bad --convoy fails before anything is sent.
+func openChatRecorder(convoyID string) (*chatRecorder, error) {
+	townRoot, err := getTownBeadsDir()
+	if err != nil {
+		return nil, err
+	}
+	ctx := context.Background()
+	store, err := beadsdk.Open(ctx, filepath.Join(townRoot, ".beads"))
+	if err != nil {
+		return nil, fmt.Errorf("opening town beads: %w", err)
+	}
+	if convoyID != "" {
+		if err := convoy.CheckConvoy(ctx, store, convoyID); err != nil {
+			_ = store.Close()
+			return nil, err
+		}
+	}
+	return &chatRecorder{store: store, convoyID: convoyID, actor: dependencyActor()}, nil
+}
+
+// save records one exchange and returns the message issue's ID.
+func (r *chatRecorder) save(prompt string, result *chatResult) (string, error) {
+	msg := convoy.Message{
+		Prompt:   prompt,
+		Response: result.Response,
+		Session:  result.Session,
+		Sender:   r.actor,
+		At:       time.Now(),
+	}
+	id, err := convoy.SaveMessage(context.Background(), r.store, msg, r.convoyID, r.actor)
+	if err != nil {
+		return id, fmt.Errorf("saving chat: %w", err)
+	}
+	return id, nil
+}
+
+func (r *chatRecorder) close() {
+	_ = r.store.Close()
+}
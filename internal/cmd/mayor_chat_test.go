@@ -0,0 +1,318 @@
+package cmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/steveyegge/gastown/internal/agentchat"
+	"github.com/steveyegge/gastown/internal/tmux"
+	"github.com/steveyegge/gastown/internal/workspace"
+)
+
+func TestChatOptionsValidate(t *testing.T) {
+	tests := []struct {
+		name    string
+		opts    agentchat.Options
+		wantErr bool
+	}{
+		{"defaults", agentchat.Options{Timeout: 2 * time.Minute, PollInterval: defaultChatPollInterval, StableFor: defaultChatStableFor}, false},
+		{"stable equals timeout", agentchat.Options{Timeout: 5 * time.Second, PollInterval: time.Second, StableFor: 5 * time.Second}, true},
+		{"stable exceeds timeout", agentchat.Options{Timeout: 5 * time.Second, PollInterval: time.Second, StableFor: 10 * time.Second}, true},
+		{"zero poll interval", agentchat.Options{Timeout: time.Minute, StableFor: time.Second}, true},
+		{"zero stable-for", agentchat.Options{Timeout: time.Minute, PollInterval: time.Second}, true},
+		{"negative retries", agentchat.Options{Timeout: time.Minute, PollInterval: time.Second, StableFor: time.Second, Retries: -1}, true},
+		{"max poll below poll interval", agentchat.Options{Timeout: time.Minute, PollInterval: time.Second, MaxPoll: 500 * time.Millisecond, StableFor: 2 * time.Second}, true},
+		{"fixed rate", agentchat.Options{Timeout: time.Minute, PollInterval: time.Second, MaxPoll: time.Second, StableFor: 2 * time.Second}, false},
+		{"renudge by message", agentchat.Options{Timeout: time.Minute, PollInterval: time.Second, StableFor: time.Second, FirstResponseGrace: defaultChatGrace, Renudges: 2, Renudge: agentchat.RenudgeMessage}, false},
+		{"negative grace", agentchat.Options{Timeout: time.Minute, PollInterval: time.Second, StableFor: time.Second, FirstResponseGrace: -time.Second}, true},
+		{"negative renudges", agentchat.Options{Timeout: time.Minute, PollInterval: time.Second, StableFor: time.Second, Renudges: -1}, true},
+		{"unknown renudge mode", agentchat.Options{Timeout: time.Minute, PollInterval: time.Second, StableFor: time.Second, Renudge: "twice"}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateChatOptions(tt.opts)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestSplitBatch(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		want  []string
+	}{
+		{"one per line", "first\n\nsecond\r\nthird\n", []string{"first", "second", "third"}},
+		{"blocks", "line a\nline b\n---\n\n---\nsecond block\n", []string{"line a\nline b", "second block"}},
+		{"empty", "\n  \n", nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := splitBatch(tt.input); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("splitBatch() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestClassifyChatResponse(t *testing.T) {
+	tests := []struct {
+		response string
+		want     int
+	}{
+		{"⏺ All rigs healthy.", 0},
+		{"", chatExitEmptyResponse},
+		{"  \n ", chatExitEmptyResponse},
+		{"⏺ Error: could not reach dolt", chatExitErrorResponse},
+		{"Looking...\n  API Error: 529 overloaded", chatExitErrorResponse},
+		{"The Error: prefix mid-line is fine", 0},
+	}
+	for _, tt := range tests {
+		if got := classifyChatResponse(tt.response); got != tt.want {
+			t.Errorf("classifyChatResponse(%q) = %d, want %d", tt.response, got, tt.want)
+		}
+	}
+}
+
+func TestCleanANSILines(t *testing.T) {
+	lines := []string{
+		"",
+		"\x1b[1m⏺\x1b[0m Rigs are \x1b[32mhealthy\x1b[0m",
+		"\x1b[2m────────────────\x1b[0m",
+		"\x1b[1m❯\x1b[0m ",
+		"\x1b[0m",
+	}
+	got := cleanANSILines(lines)
+	want := []string{"\x1b[1m⏺\x1b[0m Rigs are \x1b[32mhealthy\x1b[0m"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("cleanANSILines() = %q, want %q", got, want)
+	}
+}
+
+func TestWaitForChatIdle(t *testing.T) {
+	if _, err := exec.LookPath("tmux"); err != nil {
+		t.Skip("tmux not installed")
+	}
+	socket := fmt.Sprintf("gt-test-chat-idle-%d", os.Getpid())
+	tm := tmux.NewTmuxWithSocket(socket)
+	t.Cleanup(func() { _ = tm.KillServer() })
+
+	// Each pane shows one line and stays put; idle detection reads the
+	// pane's last rows, so print it at the bottom.
+	tests := []struct {
+		name     string
+		command  string
+		wantBusy bool
+	}{
+		{"idle", "printf '❯ '; sleep 30", false},
+		{"busy", "printf '✻ Working… (esc to interrupt)'; sleep 30", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			session := "gt-test-idle-" + tt.name
+			if err := tm.NewSessionWithCommand(session, t.TempDir(), "clear; tput cup $(($(tput lines)-1)) 0; "+tt.command); err != nil {
+				t.Fatalf("NewSessionWithCommand: %v", err)
+			}
+			t.Cleanup(func() { _ = tm.KillSession(session) })
+			for deadline := time.Now().Add(3 * time.Second); time.Now().Before(deadline); time.Sleep(50 * time.Millisecond) {
+				if lines, _ := tm.CapturePaneLines(session, 5); strings.TrimSpace(strings.Join(lines, "")) != "" {
+					break
+				}
+			}
+
+			err := waitForChatIdle(tm, session, "Polecat Toast", time.Second)
+			if (err != nil) != tt.wantBusy {
+				t.Fatalf("waitForChatIdle() error = %v, wantBusy %v", err, tt.wantBusy)
+			}
+			if tt.wantBusy && !strings.Contains(err.Error(), "Polecat Toast is busy") {
+				t.Errorf("error = %q, want it to name the busy agent", err)
+			}
+		})
+	}
+}
+
+func TestChatTimeoutExit(t *testing.T) {
+	for err, want := range map[error]int{
+		fmt.Errorf("%w after 2s", agentchat.ErrNoResponse):      chatExitNoResponse,
+		fmt.Errorf("%w after 2s", agentchat.ErrResponseTimeout): chatExitTimeout,
+	} {
+		if code, ok := IsSilentExit(chatTimeoutExit("", err)); !ok || code != want {
+			t.Errorf("chatTimeoutExit(%v) code = %d, want %d", err, code, want)
+		}
+	}
+	other := errors.New("capturing output: boom")
+	if got := chatTimeoutExit("", other); got != other {
+		t.Errorf("chatTimeoutExit(other) = %v, want it unchanged", got)
+	}
+}
+
+func TestChatInterruptExit(t *testing.T) {
+	oldJSON, oldStream := mayorChatJSON, mayorChatStream
+	t.Cleanup(func() { mayorChatJSON, mayorChatStream = oldJSON, oldStream })
+	mayorChatJSON, mayorChatStream = false, false
+
+	partial := &agentchat.Result{Response: "half an answer", Responded: true, ElapsedMs: 1500}
+	var err error
+	stderr := captureStderr(t, func() { err = chatInterruptExit("turn 2: ", partial) })
+	if code, ok := IsSilentExit(err); !ok || code != chatExitInterrupted {
+		t.Errorf("chatInterruptExit() = %v, want exit %d", err, chatExitInterrupted)
+	}
+	if !strings.Contains(stderr, "half an answer") || !strings.Contains(stderr, "turn 2: Interrupted after 1.5s") {
+		t.Errorf("stderr = %q, want the partial response and an interrupt note", stderr)
+	}
+
+	mayorChatStream = true // already printed as it streamed
+	stderr = captureStderr(t, func() { _ = chatInterruptExit("", partial) })
+	if strings.Contains(stderr, "half an answer") {
+		t.Errorf("stderr = %q, want no repeat of a streamed response", stderr)
+	}
+
+	stderr = captureStderr(t, func() { _ = chatInterruptExit("", nil) })
+	if !strings.Contains(stderr, "Interrupted before a response arrived") {
+		t.Errorf("stderr = %q, want the before-response note", stderr)
+	}
+}
+
+func TestChatWithSession_CancelledExitsInterrupted(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	var err error
+	captureStderr(t, func() {
+		err = chatWithSession(ctx, tmux.NewTmuxWithSocket("gt-test-chat-cancel"), "gt-test-none", "hello", agentchat.Options{Timeout: time.Minute}, nil)
+	})
+	if code, ok := IsSilentExit(err); !ok || code != chatExitInterrupted {
+		t.Errorf("chatWithSession() on a cancelled context = %v, want exit %d", err, chatExitInterrupted)
+	}
+}
+
+func TestWriteCaptureDebug(t *testing.T) {
+	var buf strings.Builder
+	writeCaptureDebug(&buf, agentchat.CaptureDebug{
+		Lines:     []string{"old turn", "❯ hello", "⏺ hi there", "❯"},
+		BeforeLen: 5,
+		Baseline:  1,
+		Location:  agentchat.Location{Start: 2, End: 4, Method: agentchat.MethodAnchor},
+	})
+	out := buf.String()
+	for _, want := range []string{
+		"method:     anchor\n",
+		"before_len: 5\n",
+		"baseline:   1\n",
+		"start:      2\n",
+		"lines:      4\n",
+		"   1  | ❯ hello\n",
+		"   2 >| ⏺ hi there\n",
+		"   3 >| ❯\n",
+	} {
+		if !strings.Contains(out, want) {
+			t.Errorf("output missing %q:\n%s", want, out)
+		}
+	}
+}
+
+func TestReadChatMessageFrom(t *testing.T) {
+	dir := t.TempDir()
+	prompt := filepath.Join(dir, "prompt.md")
+	if err := os.WriteFile(prompt, []byte("Review \"this\" $PLAN\n  with quotes\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	blank := filepath.Join(dir, "blank.md")
+	if err := os.WriteFile(blank, []byte("\n  \n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	stdinWith := func(content string) *os.File {
+		path := filepath.Join(t.TempDir(), "stdin")
+		if err := os.WriteFile(path, []byte(content), 0o644); err != nil {
+			t.Fatal(err)
+		}
+		f, err := os.Open(path)
+		if err != nil {
+			t.Fatal(err)
+		}
+		t.Cleanup(func() { f.Close() })
+		return f
+	}
+
+	tests := []struct {
+		name    string
+		args    []string
+		file    string
+		stdin   string
+		want    string
+		wantErr string
+	}{
+		{name: "argument", args: []string{" hello "}, want: "hello"},
+		{name: "stdin", stdin: "from stdin\n", want: "from stdin"},
+		{name: "file", file: prompt, want: "Review \"this\" $PLAN\n  with quotes"},
+		{name: "file dash reads stdin", file: "-", stdin: "piped", want: "piped"},
+		{name: "file with empty stdin", file: prompt, want: "Review \"this\" $PLAN\n  with quotes"},
+		{name: "file and argument", args: []string{"hi"}, file: prompt, wantErr: "--file and a message argument"},
+		{name: "file and piped stdin", file: prompt, stdin: "piped", wantErr: "piped stdin"},
+		{name: "missing file", file: filepath.Join(dir, "nope.md"), wantErr: "reading --file"},
+		{name: "blank file", file: blank, wantErr: "must not be empty"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := readChatMessageFrom(tt.args, tt.file, stdinWith(tt.stdin))
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("readChatMessageFrom() error = %v, want it to mention %q", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil || got != tt.want {
+				t.Errorf("readChatMessageFrom() = %q, %v; want %q", got, err, tt.want)
+			}
+		})
+	}
+}
+
+func TestApplyChatTimeoutSetting(t *testing.T) {
+	townRoot := t.TempDir()
+	for path, data := range map[string]string{
+		"mayor/town.json":      `{"type":"town","version":2,"name":"test"}`,
+		"settings/config.json": `{"type":"town-settings","version":1,"mayor":{"chat_timeout":"5m"}}`,
+	} {
+		full := filepath.Join(townRoot, path)
+		if err := os.MkdirAll(filepath.Dir(full), 0755); err != nil {
+			t.Fatalf("mkdir: %v", err)
+		}
+		if err := os.WriteFile(full, []byte(data), 0644); err != nil {
+			t.Fatalf("write %s: %v", path, err)
+		}
+	}
+	t.Setenv(workspace.EnvWorkspace, townRoot)
+	prev := mayorChatTimeout
+	t.Cleanup(func() { mayorChatTimeout = prev })
+
+	newCmd := func() *cobra.Command {
+		cmd := &cobra.Command{}
+		cmd.Flags().DurationVar(&mayorChatTimeout, "timeout", defaultChatTimeout, "")
+		return cmd
+	}
+
+	applyChatTimeoutSetting(newCmd())
+	if mayorChatTimeout != 5*time.Minute {
+		t.Errorf("timeout = %v, want mayor.chat_timeout's 5m", mayorChatTimeout)
+	}
+
+	cmd := newCmd()
+	if err := cmd.Flags().Set("timeout", "30s"); err != nil {
+		t.Fatalf("set --timeout: %v", err)
+	}
+	applyChatTimeoutSetting(cmd)
+	if mayorChatTimeout != 30*time.Second {
+		t.Errorf("timeout = %v, want the explicit --timeout 30s", mayorChatTimeout)
+	}
+}
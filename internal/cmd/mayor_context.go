@@ -0,0 +1,117 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"sort"
+	"strings"
+	"text/template"
+
+	beadsdk "github.com/steveyegge/beads"
+	"github.com/steveyegge/gastown/internal/config"
+	"github.com/steveyegge/gastown/internal/constants"
+	"github.com/steveyegge/gastown/internal/doltserver"
+	"github.com/steveyegge/gastown/internal/git"
+	"github.com/steveyegge/gastown/internal/rig"
+	"github.com/steveyegge/gastown/internal/session"
+	"github.com/steveyegge/gastown/internal/tmux"
+)
+
+// defaultChatContextTemplate renders the summary when mayor.context_template
+// is not set in the town settings.
+const defaultChatContextTemplate = `Workspace: {{.Ready}} ready, {{.InProgress}} in progress, {{.Blocked}} blocked.
+Active polecats ({{len .Polecats}}): {{if .Polecats}}{{join .Polecats ", "}}{{else}}none{{end}}.`
+
+// chatContext is the data a context template renders.
+type chatContext struct {
+	Open       int      // open issues across the town's and rigs' beads
+	Ready      int      // open issues with no open blockers
+	InProgress int      // issues being worked
+	Blocked    int      // issues waiting on open blockers
+	Polecats   []string // polecats with a live session, as rig/name
+	Sessions   []string // every gt agent session on the town's tmux server
+}
+
+// gatherChatContext collects issue counts from the town's and every rig's
+// beads, since rig work lives in the rig stores, and live agents from tmux.
+// A source that can't be read is left out of the counts.
+func gatherChatContext(townRoot string, t *tmux.Tmux) chatContext {
+	var data chatContext
+
+	ctx := context.Background()
+	for _, dir := range chatContextBeadsDirs(townRoot) {
+		store, err := beadsdk.Open(ctx, dir)
+		if err != nil {
+			continue
+		}
+		if stats, err := store.GetStatistics(ctx); err == nil {
+			data.Open += stats.OpenIssues
+			data.Ready += stats.ReadyIssues
+			data.InProgress += stats.InProgressIssues
+			data.Blocked += stats.BlockedIssues
+		}
+		_ = store.Close()
+	}
+
+	sessions, _ := t.ListSessions()
+	for _, name := range sessions {
+		id, err := session.ParseSessionName(name)
+		if err != nil {
+			continue
+		}
+		data.Sessions = append(data.Sessions, name)
+		if id.Role == session.RolePolecat {
+			data.Polecats = append(data.Polecats, id.Rig+"/"+id.Name)
+		}
+	}
+	sort.Strings(data.Sessions)
+	sort.Strings(data.Polecats)
+	return data
+}
+
+// chatContextBeadsDirs returns the town's beads directory followed by each
+// discovered rig's in name order, without duplicates.
+func chatContextBeadsDirs(townRoot string) []string {
+	dirs := []string{filepath.Join(townRoot, ".beads")}
+	rigsConfig, err := config.LoadRigsConfig(constants.MayorRigsPath(townRoot))
+	if err != nil {
+		return dirs
+	}
+	rigs, err := rig.NewManager(townRoot, rigsConfig, git.NewGit(townRoot)).DiscoverRigs()
+	if err != nil {
+		return dirs
+	}
+	seen := map[string]bool{dirs[0]: true}
+	for _, r := range rigs {
+		if dir := doltserver.FindRigBeadsDir(townRoot, r.Name); dir != "" && !seen[dir] {
+			seen[dir] = true
+			dirs = append(dirs, dir)
+		}
+	}
+	return dirs
+}
+
+// chatContextTemplate returns the town's mayor.context_template, or the
+// default when it is unset.
+func chatContextTemplate(townRoot string) string {
+	settings, err := config.LoadOrCreateTownSettings(config.TownSettingsPath(townRoot))
+	if err == nil && settings.Mayor != nil && strings.TrimSpace(settings.Mayor.ContextTemplate) != "" {
+		return settings.Mayor.ContextTemplate
+	}
+	return defaultChatContextTemplate
+}
+
+// renderChatContext executes a context template and collapses the result to
+// one line, since a newline in send-keys would submit the prompt early.
+func renderChatContext(text string, data chatContext) (string, error) {
+	tmpl, err := template.New("context").Funcs(template.FuncMap{"join": strings.Join}).Parse(text)
+	if err != nil {
+		return "", fmt.Errorf("parsing mayor.context_template: %w", err)
+	}
+	var b strings.Builder
+	if err := tmpl.Execute(&b, data); err != nil {
+		return "", fmt.Errorf("rendering mayor.context_template: %w", err)
+	}
+	return strings.Join(strings.Fields(b.String()), " "), nil
+}
@@ -0,0 +1,58 @@
+package cmd
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/steveyegge/gastown/internal/config"
+	"github.com/steveyegge/gastown/internal/constants"
+)
+
+func TestRenderChatContext(t *testing.T) {
+	data := chatContext{Ready: 3, InProgress: 2, Blocked: 1, Polecats: []string{"gastown/alpha", "gastown/bravo"}}
+
+	got, err := renderChatContext(defaultChatContextTemplate, data)
+	if err != nil {
+		t.Fatalf("renderChatContext(default) = %v", err)
+	}
+	want := "Workspace: 3 ready, 2 in progress, 1 blocked. Active polecats (2): gastown/alpha, gastown/bravo."
+	if got != want {
+		t.Errorf("default = %q, want %q", got, want)
+	}
+
+	got, err = renderChatContext("ready={{.Ready}}\n  polecats={{len .Polecats}}", data)
+	if err != nil || got != "ready=3 polecats=2" {
+		t.Errorf("custom = %q, %v; want one line", got, err)
+	}
+
+	if _, err := renderChatContext("{{.Ready", data); err == nil {
+		t.Error("invalid template should fail to parse")
+	}
+	if _, err := renderChatContext("{{.NoSuchField}}", data); err == nil {
+		t.Error("unknown field should fail to render")
+	}
+}
+
+func TestChatContextBeadsDirs(t *testing.T) {
+	townRoot := t.TempDir()
+	for _, dir := range []string{"gastown/mayor/rig/.beads", "beads/.beads"} {
+		if err := os.MkdirAll(filepath.Join(townRoot, dir), 0755); err != nil {
+			t.Fatalf("mkdir %s: %v", dir, err)
+		}
+	}
+	rigs := &config.RigsConfig{Version: 1, Rigs: map[string]config.RigEntry{"gastown": {}, "beads": {}}}
+	if err := config.SaveRigsConfig(constants.MayorRigsPath(townRoot), rigs); err != nil {
+		t.Fatalf("SaveRigsConfig: %v", err)
+	}
+
+	want := []string{
+		filepath.Join(townRoot, ".beads"),
+		filepath.Join(townRoot, "beads", ".beads"),
+		filepath.Join(townRoot, "gastown", "mayor", "rig", ".beads"),
+	}
+	if got := chatContextBeadsDirs(townRoot); !reflect.DeepEqual(got, want) {
+		t.Errorf("chatContextBeadsDirs() = %v, want %v", got, want)
+	}
+}
@@ -0,0 +1,86 @@
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/steveyegge/gastown/internal/style"
+	"github.com/steveyegge/gastown/internal/tmux"
+)
+
+var (
+	mayorInterruptEscape  bool
+	mayorInterruptTimeout time.Duration
+)
+
+var mayorInterruptCmd = &cobra.Command{
+	Use:   "interrupt",
+	Short: "Interrupt the Mayor's current task",
+	Long: `Abort a runaway Mayor task without killing the session.
+
+Sends Ctrl-C to the Mayor pane, then waits up to --timeout for the agent to
+return to its input prompt. The conversation and context are kept, unlike
+'gt mayor stop'. With --escape, Escape is sent first, for agents that use it
+to cancel the current turn.
+
+If the Mayor is already idle at its prompt, nothing is sent: a Ctrl-C at an
+idle prompt can make some agents exit.
+
+Examples:
+  gt mayor interrupt
+  gt mayor interrupt --escape
+  gt mayor interrupt --timeout 30s`,
+	Args: cobra.NoArgs,
+	RunE: runMayorInterrupt,
+}
+
+func init() {
+	mayorInterruptCmd.Flags().BoolVar(&mayorInterruptEscape, "escape", false, "Send Escape before Ctrl-C")
+	mayorInterruptCmd.Flags().DurationVar(&mayorInterruptTimeout, "timeout", 10*time.Second, "How long to wait for the prompt to return (0 = don't wait)")
+
+	mayorCmd.AddCommand(mayorInterruptCmd)
+}
+
+func runMayorInterrupt(cmd *cobra.Command, args []string) error {
+	mgr, err := getMayorManager()
+	if err != nil {
+		return err
+	}
+	running, err := mgr.IsRunning()
+	if err != nil {
+		return fmt.Errorf("checking session: %w", err)
+	}
+	if !running {
+		return fmt.Errorf("Mayor session is not running. Start with: gt mayor start")
+	}
+
+	t := tmux.NewTmux()
+	sessionName := mgr.SessionName()
+	if t.IsIdle(sessionName) {
+		fmt.Printf("%s Mayor is idle; nothing to interrupt.\n", style.Dim.Render("○"))
+		return nil
+	}
+
+	keys := []string{"C-c"}
+	if mayorInterruptEscape {
+		keys = []string{"Escape", "C-c"}
+	}
+	if err := t.SendKeySequence(sessionName, keys...); err != nil {
+		return fmt.Errorf("interrupting Mayor: %w", err)
+	}
+
+	if mayorInterruptTimeout == 0 {
+		fmt.Printf("%s Sent interrupt to the Mayor.\n", style.Bold.Render("✓"))
+		return nil
+	}
+	if err := mgr.WaitForIdle(mayorInterruptTimeout); err != nil {
+		if errors.Is(err, tmux.ErrIdleTimeout) {
+			return fmt.Errorf("Mayor did not return to its prompt within %s. Inspect with: gt mayor attach", mayorInterruptTimeout)
+		}
+		return fmt.Errorf("waiting for Mayor: %w", err)
+	}
+	fmt.Printf("%s Mayor interrupted and back at its prompt.\n", style.Bold.Render("✓"))
+	return nil
+}
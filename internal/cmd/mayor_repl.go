@@ -0,0 +1,151 @@
+package cmd
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/steveyegge/gastown/internal/agentchat"
+	"github.com/steveyegge/gastown/internal/gtlog"
+	"github.com/steveyegge/gastown/internal/style"
+	"github.com/steveyegge/gastown/internal/tmux"
+	"golang.org/x/term"
+)
+
+var mayorReplCmd = &cobra.Command{
+	Use:   "repl",
+	Short: "Hold a multi-turn conversation with the Mayor",
+	Long: `Start an interactive conversation with the running Mayor session.
+
+Each line read from stdin is sent as one turn, using the same delivery and
+response capture as 'gt mayor chat'. The response is printed before the next
+line is read. Only output produced after the previous turn is considered, so
+earlier answers are not repeated.
+
+Commands:
+  /reset   Re-snapshot the pane so anything currently visible is ignored
+  /quit    Exit (EOF also exits)`,
+	Args: cobra.NoArgs,
+	RunE: runMayorRepl,
+}
+
+func init() {
+	mayorReplCmd.Flags().DurationVar(&mayorChatTimeout, "timeout", defaultChatTimeout, "Maximum time to wait for each response (mayor.chat_timeout overrides the default)")
+	mayorReplCmd.Flags().DurationVar(&mayorChatPollInterval, "poll-interval", defaultChatPollInterval, "Initial delay between captures of the Mayor's pane")
+	mayorReplCmd.Flags().DurationVar(&mayorChatMaxPoll, "max-poll-interval", defaultChatMaxPoll, "Longest delay between captures while the pane is quiet")
+	mayorReplCmd.Flags().DurationVar(&mayorChatStableFor, "stable-for", defaultChatStableFor, "How long output must stay unchanged to count as complete")
+	mayorReplCmd.Flags().IntVar(&mayorChatRetries, "capture-retries", defaultChatRetries, "Retries for a failed pane capture before giving up")
+	mayorReplCmd.Flags().DurationVar(&mayorChatGrace, "first-response-grace", defaultChatGrace, "Re-nudge the Mayor if its pane hasn't changed this long after the send (0 disables)")
+	mayorReplCmd.Flags().IntVar(&mayorChatRenudges, "renudges", defaultChatRenudges, "Most re-nudges to send while waiting for a first response")
+	mayorReplCmd.Flags().StringVar(&mayorChatRenudgeWith, "renudge-with", string(agentchat.RenudgeEnter), "How to re-nudge: enter or message")
+	mayorReplCmd.Flags().BoolVarP(&mayorChatQuiet, "quiet", "q", false, "Suppress the prompt and status messages on stderr")
+
+	mayorCmd.AddCommand(mayorReplCmd)
+}
+
+func runMayorRepl(cmd *cobra.Command, args []string) error {
+	applyChatTimeoutSetting(cmd)
+	opts := agentchat.Options{
+		Timeout:      mayorChatTimeout,
+		PollInterval: mayorChatPollInterval,
+		MaxPoll:      mayorChatMaxPoll,
+		StableFor:    mayorChatStableFor,
+		Retries:      mayorChatRetries,
+
+		FirstResponseGrace: mayorChatGrace,
+		Renudges:           mayorChatRenudges,
+		Renudge:            agentchat.RenudgeMode(mayorChatRenudgeWith),
+	}
+	if err := validateChatOptions(opts); err != nil {
+		return err
+	}
+	opts.Logger = gtlog.At(gtlog.Warn)
+	useTownArtifactPatterns()
+
+	if err := tmux.Available(); err != nil {
+		return err
+	}
+	mgr, err := getMayorManager()
+	if err != nil {
+		return err
+	}
+	running, err := mgr.IsRunning()
+	if err != nil {
+		return fmt.Errorf("checking session: %w", err)
+	}
+	if !running {
+		return fmt.Errorf("Mayor session is not running. Start with: gt mayor start")
+	}
+
+	t := tmux.NewTmux()
+	sessionName := mgr.SessionName()
+	opts.Nudge = mgr.NudgeOpts()
+
+	lastSeen, err := paneLineCount(t, sessionName, opts)
+	if err != nil {
+		return err
+	}
+
+	interactive := !mayorChatQuiet && term.IsTerminal(int(os.Stdin.Fd()))
+	prompt := func() {
+		if interactive {
+			fmt.Fprint(os.Stderr, style.RenderStderr(style.Bold, "mayor> "))
+		}
+	}
+
+	scanner := bufio.NewScanner(os.Stdin)
+	scanner.Buffer(make([]byte, 64*1024), 1024*1024)
+	prompt()
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		switch line {
+		case "":
+			prompt()
+			continue
+		case "/quit":
+			return nil
+		case "/reset":
+			if lastSeen, err = paneLineCount(t, sessionName, opts); err != nil {
+				return err
+			}
+			if !mayorChatQuiet {
+				fmt.Fprintln(os.Stderr, style.RenderStderr(style.Dim, "Baseline reset."))
+			}
+			prompt()
+			continue
+		}
+
+		opts.Baseline = lastSeen
+		result, err := agentchat.SendAndCapture(t, sessionName, line, opts)
+		if result == nil {
+			return err
+		}
+		lastSeen = result.CapturedLines
+
+		fmt.Println(result.Response)
+		if err != nil && !mayorChatQuiet {
+			if errors.Is(err, agentchat.ErrNoResponse) {
+				style.PrintWarning("%v; the message may not have arrived", err)
+			} else {
+				style.PrintWarning("%v; output may be incomplete", err)
+			}
+		}
+		fmt.Println()
+		prompt()
+	}
+	return scanner.Err()
+}
+
+// paneLineCount returns how many lines the chat capture window currently
+// holds for a session, used as the baseline for the next turn.
+func paneLineCount(t *tmux.Tmux, sessionName string, opts agentchat.Options) (int, error) {
+	lines, err := agentchat.CaptureWithRetry(context.Background(), t, sessionName, agentchat.CaptureLines, opts)
+	if err != nil {
+		return 0, fmt.Errorf("capturing output: %w", err)
+	}
+	return len(lines), nil
+}
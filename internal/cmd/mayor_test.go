@@ -0,0 +1,39 @@
+package cmd
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestSessionUptime(t *testing.T) {
+	now := time.Date(2026, 3, 1, 12, 0, 0, 0, time.Local)
+	if d, ok := sessionUptime("2026-03-01 09:30:00", now); !ok || d != 150*time.Minute {
+		t.Errorf("sessionUptime = %s, %v; want 2h30m, true", d, ok)
+	}
+	for _, created := range []string{"", "1700000000", "2026-03-01 12:00:01"} {
+		if _, ok := sessionUptime(created, now); ok {
+			t.Errorf("sessionUptime(%q) ok, want no uptime", created)
+		}
+	}
+}
+
+func TestLastOutputLines(t *testing.T) {
+	pane := []string{
+		"⏺ Checked the gastown rig.",
+		"  Two polecats are idle.   ",
+		"",
+		"⏺ Slung gt-abc to Toast.",
+		"────────────────",
+		"❯ ",
+		"────────────────",
+	}
+	got := lastOutputLines(pane, 2)
+	want := []string{"  Two polecats are idle.", "⏺ Slung gt-abc to Toast."}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("lastOutputLines = %q, want %q", got, want)
+	}
+	if got := lastOutputLines(pane, 10); len(got) != 3 {
+		t.Errorf("lastOutputLines(10) = %q, want all 3 output lines", got)
+	}
+}
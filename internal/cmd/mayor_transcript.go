@@ -0,0 +1,123 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/steveyegge/gastown/internal/agentchat"
+	"github.com/steveyegge/gastown/internal/tmux"
+)
+
+var (
+	mayorTranscriptOut   string
+	mayorTranscriptSince int
+	mayorTranscriptColor bool
+)
+
+// ansiEscapeRe matches CSI escape sequences kept by capture-pane -e.
+var ansiEscapeRe = regexp.MustCompile(`\x1b\[[0-9;]*[a-zA-Z]`)
+
+var mayorTranscriptCmd = &cobra.Command{
+	Use:   "transcript",
+	Short: "Dump the Mayor's conversation log",
+	Long: `Print the Mayor session's full tmux scrollback with agent UI chrome removed.
+
+Unlike 'gt mayor chat', which only looks at the most recent output, this
+captures the entire pane history. Useful for reviewing what was said to the
+Mayor and debugging why it went off the rails.
+
+With --color, ANSI escape sequences are kept so the transcript looks like
+the live pane when printed to a terminal (or viewed with 'less -R').
+
+Examples:
+  gt mayor transcript
+  gt mayor transcript --color | less -R
+  gt mayor transcript --since 200
+  gt mayor transcript --out mayor.log`,
+	Args: cobra.NoArgs,
+	RunE: runMayorTranscript,
+}
+
+func init() {
+	mayorTranscriptCmd.Flags().StringVarP(&mayorTranscriptOut, "out", "o", "", "Write the transcript to a file instead of stdout")
+	mayorTranscriptCmd.Flags().IntVar(&mayorTranscriptSince, "since", 0, "Only include the last N lines (0 = all)")
+	mayorTranscriptCmd.Flags().BoolVar(&mayorTranscriptColor, "color", false, "Keep ANSI color codes from the pane")
+
+	mayorCmd.AddCommand(mayorTranscriptCmd)
+}
+
+func runMayorTranscript(cmd *cobra.Command, args []string) error {
+	if mayorTranscriptSince < 0 {
+		return fmt.Errorf("--since must not be negative")
+	}
+
+	mgr, err := getMayorManager()
+	if err != nil {
+		return err
+	}
+	running, err := mgr.IsRunning()
+	if err != nil {
+		return fmt.Errorf("checking session: %w", err)
+	}
+	if !running {
+		return fmt.Errorf("Mayor session is not running. Start with: gt mayor start")
+	}
+
+	t := tmux.NewTmux()
+	capture := t.CapturePaneAll
+	if mayorTranscriptColor {
+		capture = t.CapturePaneAllANSI
+	}
+	history, err := capture(mgr.SessionName())
+	if err != nil {
+		return fmt.Errorf("capturing history: %w", err)
+	}
+
+	useTownArtifactPatterns()
+	var lines []string
+	if mayorTranscriptColor {
+		lines = cleanANSILines(strings.Split(history, "\n"))
+	} else {
+		lines = agentchat.CleanLines(strings.Split(history, "\n"))
+	}
+	if mayorTranscriptSince > 0 && len(lines) > mayorTranscriptSince {
+		lines = lines[len(lines)-mayorTranscriptSince:]
+	}
+	transcript := strings.Join(lines, "\n") + "\n"
+
+	if mayorTranscriptOut == "" {
+		fmt.Print(transcript)
+		return nil
+	}
+	if err := os.WriteFile(mayorTranscriptOut, []byte(transcript), 0644); err != nil {
+		return fmt.Errorf("writing transcript: %w", err)
+	}
+	fmt.Fprintf(os.Stderr, "Wrote %d lines to %s\n", len(lines), mayorTranscriptOut)
+	return nil
+}
+
+// cleanANSILines applies the same filtering as agentchat.CleanLines to lines
+// that still carry escape sequences. Decisions are made on the stripped text,
+// but the original colored lines are kept.
+func cleanANSILines(lines []string) []string {
+	var kept, plain []string
+	for _, line := range lines {
+		p := strings.TrimRight(ansiEscapeRe.ReplaceAllString(line, ""), " \t")
+		if agentchat.IsUIArtifact(p) {
+			continue
+		}
+		kept = append(kept, line)
+		plain = append(plain, p)
+	}
+	start, end := 0, len(plain)
+	for start < end && plain[start] == "" {
+		start++
+	}
+	for end > start && plain[end-1] == "" {
+		end--
+	}
+	return kept[start:end]
+}
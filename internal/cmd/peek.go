@@ -64,8 +64,8 @@ func runPeek(cmd *cobra.Command, args []string) error {
 	// Handle town-level agents: mayor, deacon, boot
 	// These use session names like "hq-mayor", "hq-deacon" but have no rig.
 	townAgentSessions := map[string]string{
-		"mayor":     "hq-mayor",
-		"hq/mayor":  "hq-mayor",
+		"mayor":     session.MayorSessionName(),
+		"hq/mayor":  session.MayorSessionName(),
 		"deacon":    "hq-deacon",
 		"hq/deacon": "hq-deacon",
 		"boot":      "hq-boot",
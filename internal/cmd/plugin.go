@@ -567,7 +567,7 @@ func runPluginSync(cmd *cobra.Command, args []string) error {
 			style.Dim.Render("·"), len(result.Skipped))
 	}
 	for _, e := range result.Errors {
-		fmt.Fprintf(os.Stderr, "  %s %s\n", style.Error.Render("!"), e)
+		fmt.Fprintf(os.Stderr, "  %s %s\n", style.RenderStderr(style.Error, "!"), e)
 	}
 
 	return nil
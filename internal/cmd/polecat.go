@@ -24,6 +24,7 @@ import (
 var (
 	polecatListJSON  bool
 	polecatListAll   bool
+	polecatListFmt   string
 	polecatForce     bool
 	polecatRemoveAll bool
 )
@@ -70,10 +71,18 @@ all polecats with their states:
   - done: Completed work, waiting for cleanup
   - stuck: Needs assistance
 
+For polecats with a running session, the list also shows whether the agent
+is sitting at its input prompt or busy with a turn ("agent_idle" in --json).
+
+--format=table prints one row per polecat (rig, name, state, session,
+agent, issue); csv prints the same columns for spreadsheets, and json is
+the same as --json.
+
 Examples:
   gt polecat list greenplace
   gt polecat list --all
-  gt polecat list greenplace --json`,
+  gt polecat list greenplace --json
+  gt polecat list --all --format=csv`,
 	RunE: runPolecatList,
 }
 
@@ -326,6 +335,7 @@ func init() {
 	// List flags
 	polecatListCmd.Flags().BoolVar(&polecatListJSON, "json", false, "Output as JSON")
 	polecatListCmd.Flags().BoolVar(&polecatListAll, "all", false, "List polecats in all rigs")
+	addFormatFlag(polecatListCmd, &polecatListFmt)
 
 	// Remove flags
 	polecatRemoveCmd.Flags().BoolVarP(&polecatForce, "force", "f", false, "Force removal, bypassing checks")
@@ -387,6 +397,35 @@ type PolecatListItem struct {
 	SessionRunning bool          `json:"session_running"`
 	Zombie         bool          `json:"zombie,omitempty"`
 	SessionName    string        `json:"session_name,omitempty"`
+	AgentIdle      bool          `json:"agent_idle"` // agent is at its input prompt (running sessions only)
+}
+
+// polecatListTable lays out gt polecat list's rows for --format=table and csv.
+// SESSION is the zombie's session name, or running/stopped; AGENT is only
+// set for running sessions.
+func polecatListTable(items []PolecatListItem) *style.Table {
+	tbl := style.NewTable(
+		style.Column{Name: "RIG"},
+		style.Column{Name: "NAME"},
+		style.Column{Name: "STATE"},
+		style.Column{Name: "SESSION"},
+		style.Column{Name: "AGENT"},
+		style.Column{Name: "ISSUE"},
+	)
+	for _, p := range items {
+		session, agent := "stopped", ""
+		if p.SessionRunning {
+			session, agent = "running", "busy"
+			if p.AgentIdle {
+				agent = "at prompt"
+			}
+		}
+		if p.Zombie && p.SessionName != "" {
+			session = p.SessionName
+		}
+		tbl.AddRow(p.Rig, p.Name, string(p.State), session, agent, p.Issue)
+	}
+	return tbl
 }
 
 // effectivePolecatState returns the observable state used by polecat list output.
@@ -424,6 +463,10 @@ func getPolecatManager(rigName string) (*polecat.Manager, *rig.Rig, error) {
 }
 
 func runPolecatList(cmd *cobra.Command, args []string) error {
+	format, err := resolveListFormat(polecatListFmt, polecatListJSON)
+	if err != nil {
+		return err
+	}
 	var rigs []*rig.Rig
 
 	if polecatListAll {
@@ -470,6 +513,7 @@ func runPolecatList(cmd *cobra.Command, args []string) error {
 				State:          p.State,
 				Issue:          p.Issue,
 				SessionRunning: running,
+				AgentIdle:      running && t.IsIdle(polecatMgr.SessionName(p.Name)),
 			})
 			knownNames[p.Name] = true
 		}
@@ -491,6 +535,7 @@ func runPolecatList(cmd *cobra.Command, args []string) error {
 					SessionRunning: true,
 					Zombie:         true,
 					SessionName:    sessionName,
+					AgentIdle:      t.IsIdle(sessionName),
 				})
 			}
 		}
@@ -501,16 +546,17 @@ func runPolecatList(cmd *cobra.Command, args []string) error {
 		allPolecats[i].State = effectivePolecatState(allPolecats[i])
 	}
 
-	if polecatListJSON {
-		enc := json.NewEncoder(os.Stdout)
-		enc.SetIndent("", "  ")
-		return enc.Encode(allPolecats)
+	if format == formatJSON || format == formatCSV {
+		return writeListing(os.Stdout, format, polecatListTable(allPolecats), allPolecats)
 	}
 
 	if len(allPolecats) == 0 {
 		fmt.Println("No polecats found.")
 		return nil
 	}
+	if format == formatTable {
+		return writeListing(os.Stdout, format, polecatListTable(allPolecats), allPolecats)
+	}
 
 	fmt.Printf("%s\n\n", style.Bold.Render("Polecats"))
 	for _, p := range allPolecats {
@@ -537,7 +583,16 @@ func runPolecatList(cmd *cobra.Command, args []string) error {
 			stateStr = style.Dim.Render(stateStr)
 		}
 
-		fmt.Printf("  %s %s/%s  %s\n", sessionStatus, p.Rig, p.Name, stateStr)
+		// Agent activity for live sessions
+		activity := ""
+		if p.SessionRunning {
+			activity = "  " + style.Dim.Render("busy")
+			if p.AgentIdle {
+				activity = "  " + style.Dim.Render("at prompt")
+			}
+		}
+
+		fmt.Printf("  %s %s/%s  %s%s\n", sessionStatus, p.Rig, p.Name, stateStr, activity)
 		if p.Issue != "" {
 			fmt.Printf("    %s\n", style.Dim.Render(p.Issue))
 		}
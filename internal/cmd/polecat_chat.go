@@ -0,0 +1,131 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"github.com/steveyegge/gastown/internal/agentchat"
+	"github.com/steveyegge/gastown/internal/gtlog"
+	"github.com/steveyegge/gastown/internal/polecat"
+	"github.com/steveyegge/gastown/internal/tmux"
+)
+
+func init() {
+	polecatChatCmd.Flags().DurationVar(&mayorChatTimeout, "timeout", defaultChatTimeout, "Maximum time to wait for a response (mayor.chat_timeout overrides the default)")
+	polecatChatCmd.Flags().DurationVar(&mayorChatPollInterval, "poll-interval", defaultChatPollInterval, "Initial delay between captures of the polecat's pane")
+	polecatChatCmd.Flags().DurationVar(&mayorChatMaxPoll, "max-poll-interval", defaultChatMaxPoll, "Longest delay between captures while the pane is quiet")
+	polecatChatCmd.Flags().DurationVar(&mayorChatStableFor, "stable-for", defaultChatStableFor, "How long output must stay unchanged to count as complete")
+	polecatChatCmd.Flags().IntVar(&mayorChatRetries, "capture-retries", defaultChatRetries, "Retries for a failed pane capture before giving up")
+	polecatChatCmd.Flags().DurationVar(&mayorChatGrace, "first-response-grace", defaultChatGrace, "Re-nudge the polecat if its pane hasn't changed this long after the send (0 disables)")
+	polecatChatCmd.Flags().IntVar(&mayorChatRenudges, "renudges", defaultChatRenudges, "Most re-nudges to send while waiting for a first response")
+	polecatChatCmd.Flags().StringVar(&mayorChatRenudgeWith, "renudge-with", string(agentchat.RenudgeEnter), "How to re-nudge: enter or message")
+	polecatChatCmd.Flags().BoolVarP(&mayorChatQuiet, "quiet", "q", false, "Suppress status messages on stderr")
+	polecatChatCmd.Flags().BoolVar(&mayorChatStream, "stream", false, "Print response lines as they appear")
+	polecatChatCmd.Flags().BoolVar(&mayorChatJSON, "json", false, "Output the response as a JSON object")
+	polecatChatCmd.Flags().BoolVar(&mayorChatWaitIdle, "wait-for-idle", false, "Wait for the polecat to become idle instead of refusing when busy")
+	polecatChatCmd.Flags().BoolVar(&mayorChatSentinel, "sentinel", false, "Ask the polecat to end its reply with a unique marker for precise extraction")
+	polecatChatCmd.Flags().BoolVar(&mayorChatStrict, "strict", false, "Exit nonzero when the response is empty or reports an error")
+	polecatChatCmd.Flags().BoolVar(&mayorChatUnwrap, "unwrap", false, "Re-join lines the pane wrapped at its width")
+	polecatChatCmd.Flags().StringVar(&mayorChatDebugCapture, "debug-capture", "", "Write the raw capture and extraction details to stderr, or to =FILE")
+	polecatChatCmd.Flags().Lookup("debug-capture").NoOptDefVal = "-"
+	polecatChatCmd.Flags().StringVar(&mayorChatFile, "file", "", "Send this file's contents as the message (- for stdin)")
+
+	polecatCmd.AddCommand(polecatChatCmd)
+}
+
+var polecatChatCmd = &cobra.Command{
+	Use:   "chat <rig>/<polecat> [message]",
+	Short: "Send a message to a polecat and print the response",
+	Long: `Send a message to a polecat's session and print its response, without
+attaching to tmux.
+
+This works like 'gt mayor chat': the message is nudged into the polecat's
+session, the pane is polled until the output stops changing, and the
+response is printed with agent UI chrome removed. The polling, re-nudge,
+--sentinel, --stream, --json, --strict, --debug-capture, and --file options
+and the exit codes are the same; see 'gt mayor chat --help'.
+
+If no message argument is given and stdin is not a terminal, the message is
+read from stdin; --file reads it from a file instead. If the rig is
+omitted, it is inferred from the current directory.
+
+A polecat that is in the middle of its work is busy; the command refuses to
+interrupt it unless --wait-for-idle is set, in which case it waits up to
+--timeout for the polecat to finish.
+
+Examples:
+  gt polecat chat gastown/Toast "What's your status?"
+  gt polecat chat Toast --wait-for-idle "Which files have you changed?"
+  gt polecat chat gastown/Toast --json "Are you blocked?" | jq -r .response`,
+	Args:         cobra.RangeArgs(1, 2),
+	SilenceUsage: true,
+	RunE:         runPolecatChat,
+}
+
+func runPolecatChat(cmd *cobra.Command, args []string) error {
+	applyChatTimeoutSetting(cmd)
+	if mayorChatStream && mayorChatJSON {
+		return fmt.Errorf("--stream and --json cannot be used together")
+	}
+	opts := agentchat.Options{
+		Timeout:      mayorChatTimeout,
+		PollInterval: mayorChatPollInterval,
+		MaxPoll:      mayorChatMaxPoll,
+		StableFor:    mayorChatStableFor,
+		Retries:      mayorChatRetries,
+
+		FirstResponseGrace: mayorChatGrace,
+		Renudges:           mayorChatRenudges,
+		Renudge:            agentchat.RenudgeMode(mayorChatRenudgeWith),
+	}
+	if err := validateChatOptions(opts); err != nil {
+		return err
+	}
+	opts.Logger = gtlog.At(gtlog.Warn)
+	useTownArtifactPatterns()
+
+	rigName, polecatName, err := parseAddress(args[0])
+	if err != nil {
+		return err
+	}
+	message, err := readChatMessage(args[1:], mayorChatFile)
+	if err != nil {
+		return err
+	}
+	if err := tmux.Available(); err != nil {
+		return err
+	}
+
+	townRoot, r, err := getRig(rigName)
+	if err != nil {
+		return err
+	}
+	t := tmux.NewTmux()
+	sessionName := polecat.NewSessionManager(t, r).SessionName(polecatName)
+	running, err := t.HasSession(sessionName)
+	if err != nil {
+		return fmt.Errorf("checking session: %w", err)
+	}
+	if !running {
+		return fmt.Errorf("polecat %s/%s has no running session (%s)", rigName, polecatName, sessionName)
+	}
+
+	who := "Polecat " + polecatName
+	if err := waitForChatIdle(t, sessionName, who, opts.Timeout); err != nil {
+		return err
+	}
+	opts.Nudge = tmux.NudgeOpts{TownRoot: townRoot}
+	if err := setChatOutput(t, sessionName, &opts); err != nil {
+		return err
+	}
+	closeDebug, err := openCaptureDebug(mayorChatDebugCapture, &opts)
+	if err != nil {
+		return err
+	}
+	defer closeDebug()
+
+	gtlog.Infof("Waiting for %s response...", polecatName)
+	ctx, stop := chatSignalContext()
+	defer stop()
+	return chatWithSession(ctx, t, sessionName, message, opts, nil)
+}
@@ -0,0 +1,117 @@
+package cmd
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"github.com/steveyegge/gastown/internal/beads"
+	"github.com/steveyegge/gastown/internal/constants"
+	"github.com/steveyegge/gastown/internal/polecat"
+	"github.com/steveyegge/gastown/internal/session"
+	"github.com/steveyegge/gastown/internal/style"
+	"github.com/steveyegge/gastown/internal/tmux"
+	"github.com/steveyegge/gastown/internal/workspace"
+)
+
+// polecat kill flags
+var (
+	polecatKillRig      string
+	polecatKillForce    bool
+	polecatKillReassign bool
+)
+
+func init() {
+	polecatKillCmd.Flags().StringVar(&polecatKillRig, "rig", "", "Rig the polecat belongs to (default: inferred from the current directory)")
+	polecatKillCmd.Flags().BoolVarP(&polecatKillForce, "force", "f", false, "Kill even if the polecat has an active issue (the issue stays assigned)")
+	polecatKillCmd.Flags().BoolVar(&polecatKillReassign, "reassign", false, "Reopen and unassign the polecat's issue first so a convoy re-slings it")
+
+	polecatCmd.AddCommand(polecatKillCmd)
+}
+
+var polecatKillCmd = &cobra.Command{
+	Use:   "kill <name>",
+	Short: "Stop a polecat's session, keeping its sandbox",
+	Long: `Stop a polecat's tmux session and mark it idle.
+
+Refuses if the polecat has an active (open, in_progress, or hooked) issue.
+Re-sling the issue elsewhere first, or:
+  --reassign   reopen and unassign the issue so the next convoy feed
+               dispatches it to another worker
+  --force      kill anyway; the issue stays assigned to this polecat
+
+The session is interrupted and given a few seconds to exit before it and
+its processes are killed. The worktree and identity are kept, so the polecat
+can be reused; use 'gt polecat nuke' to remove it entirely.
+
+Examples:
+  gt polecat kill Toast
+  gt polecat kill Toast --rig=gastown --reassign`,
+	Args:         cobra.ExactArgs(1),
+	SilenceUsage: true,
+	RunE:         runPolecatKill,
+}
+
+func runPolecatKill(cmd *cobra.Command, args []string) error {
+	name := args[0]
+
+	rigName := polecatKillRig
+	if rigName == "" {
+		townRoot, err := workspace.FindFromCwdOrError()
+		if err != nil {
+			return fmt.Errorf("not in a Gas Town workspace: %w", err)
+		}
+		if rigName, err = inferRigFromCwd(townRoot); err != nil {
+			return fmt.Errorf("%w; pass --rig", err)
+		}
+	}
+
+	mgr, r, err := getPolecatManager(rigName)
+	if err != nil {
+		return err
+	}
+	p, err := mgr.Get(name)
+	if err != nil {
+		return fmt.Errorf("polecat %s/%s: %w", rigName, name, err)
+	}
+	if err := checkPolecatKillable(p, polecatKillForce, polecatKillReassign); err != nil {
+		return err
+	}
+
+	if p.Issue != "" && polecatKillReassign {
+		if err := BdCmd("update", p.Issue, "--status=open", "--unassign").
+			Dir(resolveBeadDir(p.Issue)).
+			WithAutoCommit().
+			Run(); err != nil {
+			return fmt.Errorf("reopening %s: %w", p.Issue, err)
+		}
+		fmt.Printf("%s Reopened %s for re-slinging\n", style.Success.Render("↺"), p.Issue)
+	}
+
+	t := tmux.NewTmux()
+	sessionName := polecat.NewSessionManager(t, r).SessionName(name)
+	err = t.KillSessionGraceful(sessionName, constants.GracefulShutdownTimeout)
+	if errors.Is(err, tmux.ErrSessionNotFound) {
+		fmt.Printf("%s\n", style.Dim.Render("No running session "+sessionName))
+	} else if err != nil {
+		return fmt.Errorf("killing session %s: %w", sessionName, err)
+	}
+
+	if err := mgr.SetAgentStateWithRetry(name, string(beads.AgentStateIdle)); err != nil {
+		style.PrintWarning("could not mark %s idle: %v", name, err)
+	}
+
+	fmt.Printf("%s Killed %s\n", style.SuccessPrefix, session.FormatAssignee(rigName, name))
+	return nil
+}
+
+// checkPolecatKillable refuses to kill a polecat that still holds an active
+// issue unless the caller forces it or reassigns the issue first.
+func checkPolecatKillable(p *polecat.Polecat, force, reassign bool) error {
+	if p.Issue == "" || force || reassign {
+		return nil
+	}
+	return fmt.Errorf("polecat %s/%s is working on %s\n"+
+		"Re-sling it first, or pass --reassign to reopen it or --force to kill anyway",
+		p.Rig, p.Name, p.Issue)
+}
@@ -0,0 +1,37 @@
+package cmd
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/steveyegge/gastown/internal/polecat"
+)
+
+func TestCheckPolecatKillable(t *testing.T) {
+	working := &polecat.Polecat{Name: "Toast", Rig: "gastown", Issue: "gt-abc"}
+	idle := &polecat.Polecat{Name: "Toast", Rig: "gastown"}
+
+	tests := []struct {
+		name     string
+		p        *polecat.Polecat
+		force    bool
+		reassign bool
+		wantErr  bool
+	}{
+		{"active issue refuses", working, false, false, true},
+		{"active issue with force", working, true, false, false},
+		{"active issue with reassign", working, false, true, false},
+		{"no issue", idle, false, false, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := checkPolecatKillable(tt.p, tt.force, tt.reassign)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("checkPolecatKillable() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err != nil && !strings.Contains(err.Error(), "gt-abc") {
+				t.Errorf("error %q should name the active issue", err)
+			}
+		})
+	}
+}
@@ -16,6 +16,7 @@ import (
 	"github.com/steveyegge/gastown/internal/git"
 	"github.com/steveyegge/gastown/internal/polecat"
 	"github.com/steveyegge/gastown/internal/rig"
+	"github.com/steveyegge/gastown/internal/session"
 	"github.com/steveyegge/gastown/internal/style"
 	"github.com/steveyegge/gastown/internal/tmux"
 	"github.com/steveyegge/gastown/internal/witness"
@@ -39,7 +40,7 @@ type SpawnedPolecatInfo struct {
 
 // AgentID returns the agent identifier (e.g., "gastown/polecats/Toast")
 func (s *SpawnedPolecatInfo) AgentID() string {
-	return fmt.Sprintf("%s/polecats/%s", s.RigName, s.PolecatName)
+	return session.FormatAssignee(s.RigName, s.PolecatName)
 }
 
 // SessionStarted returns true if the tmux session has been started.
@@ -0,0 +1,126 @@
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/steveyegge/gastown/internal/beads"
+	"github.com/steveyegge/gastown/internal/polecat"
+	"github.com/steveyegge/gastown/internal/style"
+	"github.com/steveyegge/gastown/internal/tmux"
+	"github.com/steveyegge/gastown/internal/workspace"
+)
+
+// polecat spawn flags
+var (
+	polecatSpawnRig   string
+	polecatSpawnAgent string
+)
+
+func init() {
+	polecatSpawnCmd.Flags().StringVar(&polecatSpawnRig, "rig", "", "Rig to spawn in (default: inferred from the current directory)")
+	polecatSpawnCmd.Flags().StringVar(&polecatSpawnAgent, "agent", "", "Agent override for the session (e.g. codex, claude-haiku)")
+
+	polecatCmd.AddCommand(polecatSpawnCmd)
+}
+
+var polecatSpawnCmd = &cobra.Command{
+	Use:   "spawn <name>",
+	Short: "Create a named polecat and start its session",
+	Long: `Create a polecat with the given name and start its agent session.
+
+The polecat's worktree and identity bead are created (an existing idle
+polecat of that name is reused), the tmux session is started with the rig's
+polecat agent, and the command waits for the agent to be ready. The polecat
+is left idle with no hooked work.
+
+Fails if the polecat already has a running session.
+
+On success the polecat's address is printed, for use as a sling or
+reassign target:
+  gt sling gt-abc gastown/polecats/Toast
+  gt convoy reassign gt-abc gastown/polecats/Toast
+
+Examples:
+  gt polecat spawn Toast
+  gt polecat spawn Toast --rig=gastown
+  gt polecat spawn Toast --rig=gastown --agent=codex`,
+	Args:         cobra.ExactArgs(1),
+	SilenceUsage: true,
+	RunE:         runPolecatSpawn,
+}
+
+func runPolecatSpawn(cmd *cobra.Command, args []string) error {
+	name := args[0]
+	if name == "" || strings.ContainsAny(name, "/ \t") {
+		return fmt.Errorf("invalid polecat name %q", name)
+	}
+
+	rigName := polecatSpawnRig
+	if rigName == "" {
+		townRoot, err := workspace.FindFromCwdOrError()
+		if err != nil {
+			return fmt.Errorf("not in a Gas Town workspace: %w", err)
+		}
+		if rigName, err = inferRigFromCwd(townRoot); err != nil {
+			return fmt.Errorf("%w; pass --rig", err)
+		}
+	}
+
+	mgr, r, err := getPolecatManager(rigName)
+	if err != nil {
+		return err
+	}
+	t := tmux.NewTmux()
+	sessionName := polecat.NewSessionManager(t, r).SessionName(name)
+	if running, err := t.HasSession(sessionName); err != nil {
+		return fmt.Errorf("checking session %s: %w", sessionName, err)
+	} else if running {
+		return fmt.Errorf("polecat %s/%s is already running (session %s)", rigName, name, sessionName)
+	}
+
+	if err := mgr.CheckDoltHealth(); err != nil {
+		return fmt.Errorf("pre-spawn health check failed: %w", err)
+	}
+
+	p, err := mgr.AddWithOptions(name, polecat.AddOptions{})
+	if errors.Is(err, polecat.ErrPolecatExists) {
+		if p, err = mgr.Get(name); err == nil {
+			fmt.Printf("Reusing existing polecat: %s\n", name)
+		}
+	} else if err == nil {
+		fmt.Printf("Created polecat: %s\n", name)
+	}
+	if err != nil {
+		return fmt.Errorf("creating polecat %s: %w", name, err)
+	}
+	if err := verifyWorktreeExists(p.ClonePath); err != nil {
+		return fmt.Errorf("worktree verification failed for %s: %w\nHint: try 'gt polecat nuke %s/%s --force' to clean up",
+			name, err, rigName, name)
+	}
+
+	info := &SpawnedPolecatInfo{
+		RigName:     rigName,
+		PolecatName: name,
+		ClonePath:   p.ClonePath,
+		SessionName: sessionName,
+		BaseBranch:  r.DefaultBranch(),
+		Branch:      p.Branch,
+		agent:       polecatSpawnAgent,
+	}
+	if _, err := info.StartSession(); err != nil {
+		return err
+	}
+
+	// StartSession marks the agent working; with nothing hooked it is idle
+	// and available for sling or reassign.
+	if err := mgr.SetAgentStateWithRetry(name, string(beads.AgentStateIdle)); err != nil {
+		style.PrintWarning("could not mark %s idle: %v", name, err)
+	}
+
+	fmt.Printf("%s Polecat %s is ready\n", style.SuccessPrefix, style.Bold.Render(info.AgentID()))
+	fmt.Printf("  Session: %s\n", style.Dim.Render(sessionName))
+	return nil
+}
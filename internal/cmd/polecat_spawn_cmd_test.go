@@ -0,0 +1,15 @@
+package cmd
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestRunPolecatSpawn_RejectsInvalidName(t *testing.T) {
+	for _, name := range []string{"", "gastown/Toast", "two words"} {
+		err := runPolecatSpawn(polecatSpawnCmd, []string{name})
+		if err == nil || !strings.Contains(err.Error(), "invalid polecat name") {
+			t.Errorf("runPolecatSpawn(%q) error = %v, want invalid polecat name", name, err)
+		}
+	}
+}
@@ -0,0 +1,187 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/steveyegge/gastown/internal/git"
+	"github.com/steveyegge/gastown/internal/polecat"
+	"github.com/steveyegge/gastown/internal/rig"
+	"github.com/steveyegge/gastown/internal/session"
+	"github.com/steveyegge/gastown/internal/style"
+	"github.com/steveyegge/gastown/internal/tmux"
+)
+
+// polecat watch flags
+var (
+	polecatWatchRig      string
+	polecatWatchInterval time.Duration
+	polecatWatchOnce     bool
+	polecatWatchDryRun   bool
+)
+
+func init() {
+	polecatWatchCmd.Flags().StringVar(&polecatWatchRig, "rig", "", "Only watch polecats in this rig (default: all rigs)")
+	polecatWatchCmd.Flags().DurationVar(&polecatWatchInterval, "interval", time.Minute, "Time between session checks")
+	polecatWatchCmd.Flags().BoolVar(&polecatWatchOnce, "once", false, "Check once and exit instead of looping")
+	polecatWatchCmd.Flags().BoolVar(&polecatWatchDryRun, "dry-run", false, "Report dead polecats without reopening their issues")
+
+	polecatCmd.AddCommand(polecatWatchCmd)
+}
+
+var polecatWatchCmd = &cobra.Command{
+	Use:   "watch",
+	Short: "Reopen work held by polecats whose session died",
+	Long: `Watch for polecats whose tmux session is gone while they still hold an
+active issue, and put that issue back up for dispatch.
+
+Every --interval, the watch lists tmux sessions and compares them against
+polecats with an assigned issue. A polecat missing from two checks in a row
+is treated as dead (one miss can be a session that is still starting): its
+issue is unassigned and set back to open, so the next convoy feed re-slings
+it. Each recovery is logged. With --once, a single check recovers
+immediately.
+
+Examples:
+  gt polecat watch
+  gt polecat watch --rig=gastown --interval=30s
+  gt polecat watch --once --dry-run`,
+	Args:         cobra.NoArgs,
+	SilenceUsage: true,
+	RunE:         runPolecatWatch,
+}
+
+// watchedPolecat is a polecat holding an issue, with the session it should have.
+type watchedPolecat struct {
+	Rig     string
+	Name    string
+	Session string
+	Issue   string
+}
+
+func runPolecatWatch(cmd *cobra.Command, args []string) error {
+	if polecatWatchInterval <= 0 {
+		return fmt.Errorf("--interval must be positive")
+	}
+
+	var rigs []*rig.Rig
+	if polecatWatchRig != "" {
+		_, r, err := getRig(polecatWatchRig)
+		if err != nil {
+			return err
+		}
+		rigs = []*rig.Rig{r}
+	} else {
+		allRigs, err := getAllRigs()
+		if err != nil {
+			return err
+		}
+		rigs = allRigs
+	}
+
+	t := tmux.NewTmux()
+	misses := make(map[string]int)
+	if polecatWatchOnce {
+		return polecatWatchPass(t, rigs, misses, 1)
+	}
+
+	sigChan := make(chan os.Signal, 1)
+	signal.Notify(sigChan, os.Interrupt, syscall.SIGTERM)
+	defer signal.Stop(sigChan)
+
+	ticker := time.NewTicker(polecatWatchInterval)
+	defer ticker.Stop()
+
+	fmt.Printf("Watching polecat sessions every %s (Ctrl+C to stop)\n", polecatWatchInterval)
+	for {
+		if err := polecatWatchPass(t, rigs, misses, 2); err != nil {
+			style.PrintWarning("%v", err)
+		}
+		select {
+		case <-sigChan:
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+// polecatWatchPass runs one check and recovers polecats that have been
+// missing for threshold consecutive passes.
+func polecatWatchPass(t *tmux.Tmux, rigs []*rig.Rig, misses map[string]int, threshold int) error {
+	sessions, err := t.ListSessions()
+	if err != nil {
+		return fmt.Errorf("listing tmux sessions: %w", err)
+	}
+	live := make(map[string]bool, len(sessions))
+	for _, s := range sessions {
+		live[s] = true
+	}
+
+	var expected []watchedPolecat
+	for _, r := range rigs {
+		mgr := polecat.NewManager(r, git.NewGit(r.Path), t)
+		sessMgr := polecat.NewSessionManager(t, r)
+		polecats, err := mgr.List()
+		if err != nil {
+			style.PrintWarning("listing polecats in %s: %v", r.Name, err)
+			continue
+		}
+		for _, p := range polecats {
+			if p.Issue != "" {
+				expected = append(expected, watchedPolecat{Rig: r.Name, Name: p.Name, Session: sessMgr.SessionName(p.Name), Issue: p.Issue})
+			}
+		}
+	}
+
+	for _, p := range findDeadPolecats(expected, live, misses, threshold) {
+		recoverDeadPolecat(p)
+	}
+	return nil
+}
+
+// findDeadPolecats updates the per-session miss counts and returns polecats
+// whose session has been absent for threshold consecutive checks. Polecats
+// that are live again, or no longer hold an issue, are forgotten.
+func findDeadPolecats(expected []watchedPolecat, live map[string]bool, misses map[string]int, threshold int) []watchedPolecat {
+	var dead []watchedPolecat
+	seen := make(map[string]bool, len(expected))
+	for _, p := range expected {
+		seen[p.Session] = true
+		if live[p.Session] {
+			delete(misses, p.Session)
+			continue
+		}
+		misses[p.Session]++
+		if misses[p.Session] >= threshold {
+			dead = append(dead, p)
+			delete(misses, p.Session)
+		}
+	}
+	for s := range misses {
+		if !seen[s] {
+			delete(misses, s)
+		}
+	}
+	return dead
+}
+
+// recoverDeadPolecat reopens a dead polecat's issue so a convoy re-slings it.
+func recoverDeadPolecat(p watchedPolecat) {
+	ts := style.Dim.Render(time.Now().Format("15:04:05"))
+	if polecatWatchDryRun {
+		fmt.Printf("%s %s %s has no session; would reopen %s\n", ts, style.Warning.Render("⚠"), session.FormatAssignee(p.Rig, p.Name), p.Issue)
+		return
+	}
+	if err := BdCmd("update", p.Issue, "--status=open", "--unassign").
+		Dir(resolveBeadDir(p.Issue)).
+		WithAutoCommit().
+		Run(); err != nil {
+		style.PrintWarning("could not reopen %s from dead polecat %s/%s: %v", p.Issue, p.Rig, p.Name, err)
+		return
+	}
+	fmt.Printf("%s %s %s has no session; reopened %s for re-slinging\n", ts, style.Success.Render("↺"), session.FormatAssignee(p.Rig, p.Name), p.Issue)
+}
@@ -0,0 +1,33 @@
+package cmd
+
+import "testing"
+
+func TestFindDeadPolecats_RequiresConsecutiveMisses(t *testing.T) {
+	toast := watchedPolecat{Rig: "gastown", Name: "Toast", Session: "gt-p-Toast", Issue: "gt-abc"}
+	nux := watchedPolecat{Rig: "gastown", Name: "Nux", Session: "gt-p-Nux", Issue: "gt-def"}
+	expected := []watchedPolecat{toast, nux}
+	misses := make(map[string]int)
+
+	// First miss: Toast may still be starting.
+	if dead := findDeadPolecats(expected, map[string]bool{"gt-p-Nux": true}, misses, 2); len(dead) != 0 {
+		t.Fatalf("first pass dead = %v, want none", dead)
+	}
+	// Second consecutive miss: Toast is dead, Nux is fine.
+	dead := findDeadPolecats(expected, map[string]bool{"gt-p-Nux": true}, misses, 2)
+	if len(dead) != 1 || dead[0] != toast {
+		t.Fatalf("second pass dead = %v, want [%v]", dead, toast)
+	}
+
+	// A session that comes back resets its count.
+	findDeadPolecats(expected, map[string]bool{"gt-p-Toast": true}, misses, 2)
+	findDeadPolecats(expected, map[string]bool{"gt-p-Toast": true, "gt-p-Nux": true}, misses, 2)
+	if dead := findDeadPolecats(expected, map[string]bool{"gt-p-Nux": true}, misses, 2); len(dead) != 0 {
+		t.Errorf("dead after recovery = %v, want none", dead)
+	}
+
+	// Polecats that no longer hold an issue are forgotten.
+	findDeadPolecats(nil, nil, misses, 2)
+	if len(misses) != 0 {
+		t.Errorf("misses = %v, want empty", misses)
+	}
+}
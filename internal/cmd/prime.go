@@ -182,7 +182,7 @@ func runPrime(cmd *cobra.Command, args []string) (retErr error) {
 		// Database error during hook query — NOT the same as "no work assigned".
 		// Emit a loud warning so the agent does NOT run gt done / close the bead.
 		// This prevents the destructive cycle: DB error → "no work" → gt done → bead lost. (GH#2638)
-		fmt.Fprintf(os.Stderr, "\n%s\n", style.Bold.Render("## ⚠️  DATABASE ERROR — DO NOT RUN gt done ⚠️"))
+		fmt.Fprintf(os.Stderr, "\n%s\n", style.RenderStderr(style.Bold, "## ⚠️  DATABASE ERROR — DO NOT RUN gt done ⚠️"))
 		fmt.Fprintf(os.Stderr, "Hook query failed: %v\n", hookErr)
 		fmt.Fprintf(os.Stderr, "This is a database connectivity error, NOT an empty hook.\n")
 		fmt.Fprintf(os.Stderr, "Your work may still be assigned. Do NOT close any beads.\n")
@@ -1189,7 +1189,7 @@ func setTmuxWorkContext(workRig, workBead, workMol string) {
 	if os.Getenv("TMUX") == "" {
 		return
 	}
-	out, err := exec.Command("tmux", "display-message", "-p", "#{session_name}").Output()
+	out, err := exec.Command(tmux.Binary(), "display-message", "-p", "#{session_name}").Output()
 	if err != nil {
 		return
 	}
@@ -1199,9 +1199,9 @@ func setTmuxWorkContext(workRig, workBead, workMol string) {
 	}
 	setOrUnset := func(key, value string) {
 		if value != "" {
-			_ = exec.Command("tmux", "set-environment", "-t", session, key, value).Run()
+			_ = exec.Command(tmux.Binary(), "set-environment", "-t", session, key, value).Run()
 		} else {
-			_ = exec.Command("tmux", "set-environment", "-u", "-t", session, key).Run()
+			_ = exec.Command(tmux.Binary(), "set-environment", "-u", "-t", session, key).Run()
 		}
 	}
 	setOrUnset("GT_WORK_RIG", workRig)
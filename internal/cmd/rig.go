@@ -963,7 +963,7 @@ func runRigMenu(cmd *cobra.Command, args []string) error {
 		menuArgs = append(menuArgs, "")
 	}
 
-	tmuxPath, err := exec.LookPath("tmux")
+	tmuxPath, err := exec.LookPath(tmux.Binary())
 	if err != nil {
 		return fmt.Errorf("tmux not found: %w", err)
 	}
@@ -13,10 +13,13 @@ import (
 	"github.com/spf13/cobra"
 	"github.com/steveyegge/gastown/internal/cli"
 	"github.com/steveyegge/gastown/internal/config"
+	"github.com/steveyegge/gastown/internal/convoy"
+	"github.com/steveyegge/gastown/internal/gtlog"
 	"github.com/steveyegge/gastown/internal/polecat"
 	"github.com/steveyegge/gastown/internal/session"
 	"github.com/steveyegge/gastown/internal/style"
 	"github.com/steveyegge/gastown/internal/telemetry"
+	"github.com/steveyegge/gastown/internal/tmux"
 	"github.com/steveyegge/gastown/internal/ui"
 	"github.com/steveyegge/gastown/internal/version"
 	"github.com/steveyegge/gastown/internal/workspace"
@@ -91,8 +94,68 @@ var branchCheckExemptCommands = map[string]bool{
 	"upgrade":    true, // Post-install migration
 }
 
+// workspaceFlag is the global --workspace flag.
+var workspaceFlag string
+
+// logFileFlag is the global --log-file flag; GT_LOG is its env fallback.
+var logFileFlag string
+
+// logLevelFlag is the global --log-level flag; GT_LOG_LEVEL is its env fallback.
+var logLevelFlag string
+
+// setLogLevel applies --log-level (or GT_LOG_LEVEL), then lets a command's
+// own --quiet flag raise the level to warn.
+func setLogLevel(cmd *cobra.Command) error {
+	name, source := logLevelFlag, "--log-level"
+	if name == "" {
+		name, source = os.Getenv(gtlog.EnvLevel), gtlog.EnvLevel
+	}
+	if name != "" {
+		level, err := gtlog.ParseLevel(name)
+		if err != nil {
+			return fmt.Errorf("%s: %w", source, err)
+		}
+		gtlog.SetLevel(level)
+	}
+	if f := cmd.Flags().Lookup("quiet"); f != nil && f.Changed && f.Value.String() == "true" {
+		gtlog.Quiet()
+	}
+	return nil
+}
+
+// openTmuxTrace points the tmux package's command trace at --log-file (or
+// GT_LOG), appending so successive commands share one log.
+func openTmuxTrace() error {
+	path := logFileFlag
+	if path == "" {
+		path = os.Getenv("GT_LOG")
+	}
+	if path == "" {
+		return nil
+	}
+	f, err := os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_APPEND, 0644)
+	if err != nil {
+		return fmt.Errorf("opening log file: %w", err)
+	}
+	tmux.SetTraceWriter(f)
+	return nil
+}
+
 // persistentPreRun runs before every command.
 func persistentPreRun(cmd *cobra.Command, args []string) error {
+	// Pin the workspace before anything tries to discover it, and fail fast
+	// on a bad --workspace or GT_WORKSPACE rather than silently using cwd.
+	workspace.SetOverride(workspaceFlag)
+	if _, _, err := workspace.Override(); err != nil {
+		return err
+	}
+	if err := openTmuxTrace(); err != nil {
+		return err
+	}
+	if err := setLogLevel(cmd); err != nil {
+		return err
+	}
+
 	// Check if binary was built properly (via make build, not raw go build).
 	// Raw go build produces unsigned binaries that macOS may kill.
 	// Warning only - doesn't block execution.
@@ -122,6 +185,17 @@ func persistentPreRun(cmd *cobra.Command, args []string) error {
 		if err := session.InitRegistry(townRoot); err != nil {
 			fmt.Fprintf(os.Stderr, "WARNING: failed to initialize town registry: %v\n", err)
 		}
+		if cfg, err := workspace.LoadConfig(townRoot); err != nil {
+			convoy.SetSlingableTypes(nil)
+		} else {
+			convoy.SetSlingableTypes(cfg.ConvoySettings().SlingableTypes)
+			convoy.SetEventSink(convoy.EventSinkFor(townRoot, cfg.ConvoySettings(), func(format string, args ...interface{}) {
+				fmt.Fprintf(os.Stderr, "WARNING: "+format+"\n", args...)
+			}))
+			for _, key := range cfg.UnknownKeys {
+				fmt.Fprintf(os.Stderr, "WARNING: unknown key %q in %s (ignored)\n", key, cfg.Path)
+			}
+		}
 	}
 
 	// Get the root command name being run
@@ -150,9 +224,9 @@ func persistentPreRun(cmd *cobra.Command, args []string) error {
 
 	// Check beads version (non-blocking - warn only)
 	if err := CheckBeadsVersion(); err != nil {
-		fmt.Fprintf(os.Stderr, "\n%s beads (bd) version issue:\n", style.Bold.Render("⚠️  WARNING:"))
+		fmt.Fprintf(os.Stderr, "\n%s beads (bd) version issue:\n", style.RenderStderr(style.Bold, "⚠️  WARNING:"))
 		fmt.Fprintf(os.Stderr, "   %v\n", err)
-		fmt.Fprintf(os.Stderr, "   Run %s for details.\n\n", style.Dim.Render("gt doctor"))
+		fmt.Fprintf(os.Stderr, "   Run %s for details.\n\n", style.RenderStderr(style.Dim, "gt doctor"))
 	}
 	return nil
 }
@@ -306,6 +380,9 @@ func Execute() int {
 		telemetry.SetProcessOTELAttrs()
 	}
 
+	// Deliver convoy events still queued for a webhook before exiting.
+	defer convoy.CloseEventSink(2 * time.Second)
+
 	if err := rootCmd.Execute(); err != nil {
 		// Check for silent exit (scripting commands that signal status via exit code)
 		if code, ok := IsSilentExit(err); ok {
@@ -347,8 +424,13 @@ func init() {
 	rootCmd.SetHelpCommandGroupID(GroupDiag)
 	rootCmd.SetCompletionCommandGroupID(GroupConfig)
 
-	// Global flags can be added here
-	// rootCmd.PersistentFlags().StringVar(&cfgFile, "config", "", "config file")
+	// Global flags
+	rootCmd.PersistentFlags().StringVar(&workspaceFlag, "workspace", "",
+		"Town root to use instead of searching up from the current directory (overrides "+workspace.EnvWorkspace+")")
+	rootCmd.PersistentFlags().StringVar(&logFileFlag, "log-file", "",
+		"Append a timestamped trace of every tmux command to this file (overrides GT_LOG)")
+	rootCmd.PersistentFlags().StringVar(&logLevelFlag, "log-level", "",
+		"Minimum level of status messages on stderr: debug, info, warn, or error (overrides "+gtlog.EnvLevel+")")
 }
 
 // buildCommandPath walks the command hierarchy to build the full command path.
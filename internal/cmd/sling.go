@@ -12,10 +12,12 @@ import (
 
 	"github.com/spf13/cobra"
 	"github.com/steveyegge/gastown/internal/beads"
+	"github.com/steveyegge/gastown/internal/convoy"
 	"github.com/steveyegge/gastown/internal/events"
 	"github.com/steveyegge/gastown/internal/lock"
 	"github.com/steveyegge/gastown/internal/mail"
 	"github.com/steveyegge/gastown/internal/nudge"
+	"github.com/steveyegge/gastown/internal/session"
 	"github.com/steveyegge/gastown/internal/style"
 	"github.com/steveyegge/gastown/internal/telemetry"
 	"github.com/steveyegge/gastown/internal/witness"
@@ -135,6 +137,7 @@ var (
 	slingFormula       string // --formula: override formula for dispatch (default: mol-polecat-work)
 	slingCrew          string // --crew: target a crew member in the specified rig
 	slingReviewOnly    bool   // --review-only: mark work as review-only (no merge/commit/push)
+	slingAllowCrossRig bool   // --allow-cross-rig: skip only the cross-rig guard (convoy feed routing)
 )
 
 func init() {
@@ -163,6 +166,11 @@ func init() {
 	slingCmd.Flags().StringVar(&slingFormula, "formula", "", "Formula to apply (default: mol-polecat-work for polecat targets)")
 	slingCmd.Flags().StringVar(&slingCrew, "crew", "", "Target a crew member in the specified rig (e.g., --crew mel with target gastown → gastown/crew/mel)")
 	slingCmd.Flags().BoolVar(&slingReviewOnly, "review-only", false, "Mark work as review-only: assignee evaluates and reports back, must NOT merge/commit/push")
+	// Internal: convoy feeds pass this for type routes and forced assignees,
+	// which deliberately send a bead outside its prefix's rig. Unlike
+	// --force it keeps every other guard and leaves the feed's claim alone.
+	slingCmd.Flags().BoolVar(&slingAllowCrossRig, "allow-cross-rig", false, "Skip the cross-rig guard only")
+	_ = slingCmd.Flags().MarkHidden("allow-cross-rig")
 
 	slingCmd.AddCommand(slingRespawnResetCmd)
 	rootCmd.AddCommand(slingCmd)
@@ -595,7 +603,9 @@ func runSling(cmd *cobra.Command, args []string) (retErr error) {
 	originalStatus := info.Status
 	originalAssignee := info.Assignee
 	force := slingForce // local copy to avoid mutating package-level flag
-	if (info.Status == "pinned" || info.Status == "hooked" || info.Status == "in_progress") && !force {
+	// A convoy feed claims the bead just before slinging it; that claim is
+	// this dispatch's placeholder, not a competing assignment.
+	if (info.Status == "pinned" || info.Status == "hooked" || info.Status == "in_progress") && !force && !convoy.IsDispatchClaim(info.Assignee) {
 		// Auto-force when hooked/in_progress agent's session is confirmed dead (gt-pqf9x, GH#1380).
 		// This eliminates the #1 friction in convoy feeding: stale hooks from
 		// dead polecats blocking re-sling without --force.
@@ -664,16 +674,17 @@ func runSling(cmd *cobra.Command, args []string) (retErr error) {
 		target = args[1]
 	}
 	resolved, err := resolveTarget(target, ResolveTargetOptions{
-		DryRun:     slingDryRun,
-		Force:      force,
-		Create:     slingCreate,
-		Account:    slingAccount,
-		Agent:      slingAgent,
-		NoBoot:     slingNoBoot,
-		HookBead:   beadID,
-		BeadID:     beadID,
-		TownRoot:   townRoot,
-		BaseBranch: slingBaseBranch,
+		DryRun:        slingDryRun,
+		Force:         force,
+		AllowCrossRig: slingAllowCrossRig,
+		Create:        slingCreate,
+		Account:       slingAccount,
+		Agent:         slingAgent,
+		NoBoot:        slingNoBoot,
+		HookBead:      beadID,
+		BeadID:        beadID,
+		TownRoot:      townRoot,
+		BaseBranch:    slingBaseBranch,
 	})
 	if err != nil {
 		return err
@@ -693,8 +704,9 @@ func runSling(cmd *cobra.Command, args []string) (retErr error) {
 
 	// Cross-rig guard: prevent slinging beads to polecats in the wrong rig (gt-myecw).
 	// Polecats work in their rig's worktree and cannot fix code owned by another rig.
-	// Skip for self-sling (user knows what they're doing) and --force overrides.
-	if strings.Contains(targetAgent, "/polecats/") && !force && !isSelfSling {
+	// Skip for self-sling (user knows what they're doing); --force and
+	// --allow-cross-rig override.
+	if strings.Contains(targetAgent, "/polecats/") && !force && !slingAllowCrossRig && !isSelfSling {
 		if err := checkCrossRigGuard(beadID, targetAgent, townRoot); err != nil {
 			return err
 		}
@@ -923,9 +935,9 @@ func runSling(cmd *cobra.Command, args []string) (retErr error) {
 	// This allows the ACP propeller to react to hook changes event-driven.
 	if targetAgent == "mayor/" {
 		if townRoot, err := workspace.FindFromCwd(); err == nil && townRoot != "" {
-			session := "hq-mayor"
+			mayorSession := session.MayorSessionName()
 			message := fmt.Sprintf("Hook updated: attached bead %s", beadID)
-			_ = nudge.Enqueue(townRoot, session, nudge.QueuedNudge{
+			_ = nudge.Enqueue(townRoot, mayorSession, nudge.QueuedNudge{
 				Sender:   "sling",
 				Message:  message,
 				Priority: nudge.PriorityNormal,
@@ -952,6 +964,7 @@ func runSling(cmd *cobra.Command, args []string) (retErr error) {
 	// (dispatcher, args, no_merge, attached_molecule) could overwrite each other.
 	fieldUpdates := beadFieldUpdates{
 		Dispatcher:       actor,
+		AssignedAt:       time.Now().UTC().Format(time.RFC3339),
 		Args:             slingArgs,
 		Vars:             append([]string(nil), slingVars...),
 		AttachedMolecule: attachedMoleculeID,
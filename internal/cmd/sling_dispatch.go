@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"path/filepath"
 	"strings"
+	"time"
 
 	"github.com/steveyegge/gastown/internal/beads"
 	"github.com/steveyegge/gastown/internal/events"
@@ -347,6 +348,7 @@ func executeSling(params SlingParams) (*SlingResult, error) {
 	// 10. Store fields in bead (dispatcher, args, attached_molecule, no_merge, mode)
 	fieldUpdates := beadFieldUpdates{
 		Dispatcher:       actor,
+		AssignedAt:       time.Now().UTC().Format(time.RFC3339),
 		Args:             params.Args,
 		Vars:             append([]string(nil), params.Vars...),
 		AttachedMolecule: attachedMoleculeID,
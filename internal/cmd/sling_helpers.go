@@ -263,6 +263,7 @@ func getBeadInfo(beadID string) (*beadInfo, error) {
 // eliminating the race condition where concurrent writers could overwrite each other's fields.
 type beadFieldUpdates struct {
 	Dispatcher       string // Agent that dispatched the work
+	AssignedAt       string // ISO 8601 time of this sling (resets convoy staleness)
 	Args             string // Natural language instructions
 	Vars             []string // Formula variables (key=value pairs)
 	AttachedMolecule string // Wisp root ID
@@ -318,6 +319,9 @@ func storeFieldsInBead(beadID string, updates beadFieldUpdates) error {
 	if updates.Dispatcher != "" {
 		fields.DispatchedBy = updates.Dispatcher
 	}
+	if updates.AssignedAt != "" {
+		fields.AssignedAt = updates.AssignedAt
+	}
 	if updates.Args != "" {
 		fields.AttachedArgs = updates.Args
 	}
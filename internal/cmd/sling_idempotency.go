@@ -1,6 +1,10 @@
 package cmd
 
-import "strings"
+import (
+	"strings"
+
+	"github.com/steveyegge/gastown/internal/session"
+)
 
 // normalizeAgentID trims surrounding whitespace and trailing slash for comparison.
 func normalizeAgentID(v string) string {
@@ -35,9 +39,10 @@ func matchesSlingTarget(target, assignee, selfAgent string) bool {
 	// Intentionally excludes crew/witness/refinery: rig-name targets resolve
 	// exclusively to polecats via IsRigName, so "gastown" + "gastown/crew/alex"
 	// is NOT a match (different dispatch path).
-	parts := strings.Split(targetNorm, "/")
-	if len(parts) == 1 && strings.HasPrefix(assigneeNorm, targetNorm+"/polecats/") {
-		return true
+	if !strings.Contains(targetNorm, "/") {
+		if rig, _, ok := session.ParseAssignee(assigneeNorm); ok && rig == targetNorm {
+			return true
+		}
 	}
 
 	// NOTE: Two-segment shorthand targets (e.g., "gastown/alex") and pool
@@ -75,7 +75,7 @@ func resolveSelfTarget() (agentID string, pane string, hookRoot string, err erro
 	case RoleRefinery:
 		agentID = fmt.Sprintf("%s/refinery", roleInfo.Rig)
 	case RolePolecat:
-		agentID = fmt.Sprintf("%s/polecats/%s", roleInfo.Rig, roleInfo.Polecat)
+		agentID = session.FormatAssignee(roleInfo.Rig, roleInfo.Polecat)
 	case RoleCrew:
 		agentID = fmt.Sprintf("%s/crew/%s", roleInfo.Rig, roleInfo.Polecat)
 	case RoleDog:
@@ -99,17 +99,18 @@ func resolveSelfTarget() (agentID string, pane string, hookRoot string, err erro
 
 // ResolveTargetOptions controls target resolution behavior.
 type ResolveTargetOptions struct {
-	DryRun   bool
-	Force    bool
-	Create   bool
-	Account  string
-	Agent    string
-	NoBoot   bool
-	HookBead   string // Bead ID to set atomically during polecat spawn (empty = skip)
-	BeadID     string // For cross-rig guard checks (empty = skip guard)
-	TownRoot   string
-	WorkDesc   string // Description for dog dispatch (defaults to HookBead if empty)
-	BaseBranch string // Override base branch for polecat worktree
+	DryRun        bool
+	Force         bool
+	AllowCrossRig bool // skip only the cross-rig guard (--allow-cross-rig)
+	Create        bool
+	Account       string
+	Agent         string
+	NoBoot        bool
+	HookBead      string // Bead ID to set atomically during polecat spawn (empty = skip)
+	BeadID        string // For cross-rig guard checks (empty = skip guard)
+	TownRoot      string
+	WorkDesc      string // Description for dog dispatch (defaults to HookBead if empty)
+	BaseBranch    string // Override base branch for polecat worktree
 }
 
 // ResolvedTarget holds the results of target resolution.
@@ -195,7 +196,7 @@ func resolveTarget(target string, opts ResolveTargetOptions) (*ResolvedTarget, e
 			}
 		}
 
-		if opts.BeadID != "" && !opts.Force {
+		if opts.BeadID != "" && !opts.Force && !opts.AllowCrossRig {
 			if err := checkCrossRigGuard(opts.BeadID, rigName+"/polecats/_", opts.TownRoot); err != nil {
 				return nil, err
 			}
@@ -238,7 +239,7 @@ func resolveTarget(target string, opts ResolveTargetOptions) (*ResolvedTarget, e
 			parts := strings.Split(target, "/")
 			if len(parts) >= 3 && parts[1] == "polecats" {
 				rigName := parts[0]
-				if opts.BeadID != "" && !opts.Force {
+				if opts.BeadID != "" && !opts.Force && !opts.AllowCrossRig {
 					if err := checkCrossRigGuard(opts.BeadID, rigName+"/polecats/_", opts.TownRoot); err != nil {
 						return nil, err
 					}
@@ -2584,3 +2584,13 @@ func TestSlingRejectsDeferredBead(t *testing.T) {
 		})
 	}
 }
+
+func TestSlingAllowCrossRigFlagIsHidden(t *testing.T) {
+	f := slingCmd.Flags().Lookup("allow-cross-rig")
+	if f == nil {
+		t.Fatal("gt sling has no --allow-cross-rig flag")
+	}
+	if !f.Hidden {
+		t.Error("--allow-cross-rig is an internal flag for convoy feeds and should be hidden")
+	}
+}
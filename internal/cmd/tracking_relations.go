@@ -3,7 +3,6 @@ package cmd
 import (
 	"context"
 	"fmt"
-	"os"
 	"strings"
 	"time"
 
@@ -47,10 +46,7 @@ func mutateTrackingRelationViaStore(townRoot, trackerID, issueID string, add boo
 	defer cleanup()
 
 	targetID := trackingDependsOnID(townRoot, issueID)
-	actor := os.Getenv("BD_ACTOR")
-	if actor == "" {
-		actor = detectSender()
-	}
+	actor := dependencyActor()
 
 	if add {
 		dep := &beadsdk.Dependency{
@@ -10,6 +10,7 @@ import (
 	"github.com/steveyegge/gastown/internal/constants"
 	"github.com/steveyegge/gastown/internal/events"
 	"github.com/steveyegge/gastown/internal/nudge"
+	"github.com/steveyegge/gastown/internal/session"
 	"github.com/steveyegge/gastown/internal/style"
 	"github.com/steveyegge/gastown/internal/workspace"
 )
@@ -242,9 +243,9 @@ func runUnslingWith(cmd *cobra.Command, args []string, dryRun, force bool) error
 	// This allows the ACP propeller to react to hook changes event-driven.
 	if agentID == "mayor/" {
 		if townRoot, err := workspace.FindFromCwd(); err == nil && townRoot != "" {
-			session := "hq-mayor"
+			mayorSession := session.MayorSessionName()
 			message := fmt.Sprintf("Hook updated: cleared bead %s", hookedBeadID)
-			_ = nudge.Enqueue(townRoot, session, nudge.QueuedNudge{
+			_ = nudge.Enqueue(townRoot, mayorSession, nudge.QueuedNudge{
 				Sender:   "unsling",
 				Message:  message,
 				Priority: nudge.PriorityNormal,
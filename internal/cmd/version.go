@@ -1,7 +1,9 @@
 package cmd
 
 import (
+	"encoding/json"
 	"fmt"
+	"os"
 	"os/exec"
 	"runtime"
 	"runtime/debug"
@@ -20,6 +22,8 @@ var (
 	// Commit and Branch - the git revision the binary was built from (optional ldflag)
 	Commit = ""
 	Branch = ""
+	// BuildTime is the UTC build timestamp in RFC 3339 form (optional ldflag)
+	BuildTime = ""
 	// BuiltProperly is set to "1" by `make build`. If empty, the binary was built
 	// with raw `go build` and is likely unsigned (will be killed on macOS).
 	BuiltProperly = ""
@@ -27,6 +31,18 @@ var (
 
 var versionVerbose bool
 var versionShort bool
+var versionJSON bool
+
+// versionInfo is the machine-readable form of gt version --json.
+type versionInfo struct {
+	Version   string `json:"version"`
+	Build     string `json:"build"`
+	Commit    string `json:"commit,omitempty"`
+	Branch    string `json:"branch,omitempty"`
+	BuildTime string `json:"build_time,omitempty"`
+	GoVersion string `json:"go_version"`
+	Platform  string `json:"platform"`
+}
 
 var versionCmd = &cobra.Command{
 	Use:         "version",
@@ -36,16 +52,33 @@ var versionCmd = &cobra.Command{
 	Long: `Print the gt version, build type, git branch, and commit hash.
 
 Output includes the semantic version, whether this is a dev or release build,
-and the git revision the binary was built from (if available).`,
-	Run: func(cmd *cobra.Command, args []string) {
+and the git revision the binary was built from (if available).
+
+Use --json for machine consumption (e.g. when filing an issue), which adds
+the build time, Go version, and platform.`,
+	RunE: func(cmd *cobra.Command, args []string) error {
 		if versionShort {
 			fmt.Printf("%s-%s\n", Version, Build)
-			return
+			return nil
 		}
 
 		commit := resolveCommitHash()
 		branch := resolveBranch()
 
+		if versionJSON {
+			enc := json.NewEncoder(os.Stdout)
+			enc.SetIndent("", "  ")
+			return enc.Encode(versionInfo{
+				Version:   Version,
+				Build:     Build,
+				Commit:    commit,
+				Branch:    branch,
+				BuildTime: resolveBuildTime(),
+				GoVersion: runtime.Version(),
+				Platform:  runtime.GOOS + "/" + runtime.GOARCH,
+			})
+		}
+
 		if commit != "" && branch != "" {
 			fmt.Printf("gt version %s (%s: %s@%s)\n", Version, Build, branch, version.ShortCommit(commit))
 		} else if commit != "" {
@@ -56,8 +89,12 @@ and the git revision the binary was built from (if available).`,
 
 		if versionVerbose {
 			fmt.Printf("Timestamp: %s\n", time.Now().Format(time.RFC3339))
+			if built := resolveBuildTime(); built != "" {
+				fmt.Printf("Built: %s\n", built)
+			}
 			fmt.Printf("Go version: %s\n", runtime.Version())
 		}
+		return nil
 	},
 }
 
@@ -65,6 +102,7 @@ func init() {
 	rootCmd.AddCommand(versionCmd)
 	versionCmd.Flags().BoolVarP(&versionVerbose, "verbose", "v", false, "Show extended version info including timestamp")
 	versionCmd.Flags().BoolVar(&versionShort, "short", false, "Output only the version number (e.g., 0.5.0-362)")
+	versionCmd.Flags().BoolVar(&versionJSON, "json", false, "Output version and build metadata as JSON")
 
 	// Pass the build-time commit to the version package for stale binary checks
 	if Commit != "" {
@@ -88,6 +126,24 @@ func resolveCommitHash() string {
 	return ""
 }
 
+// resolveBuildTime returns the build timestamp from ldflags, falling back to
+// the VCS commit time recorded by go build.
+func resolveBuildTime() string {
+	if BuildTime != "" {
+		return BuildTime
+	}
+
+	if info, ok := debug.ReadBuildInfo(); ok {
+		for _, setting := range info.Settings {
+			if setting.Key == "vcs.time" && setting.Value != "" {
+				return setting.Value
+			}
+		}
+	}
+
+	return ""
+}
+
 func resolveBranch() string {
 	if Branch != "" {
 		return Branch
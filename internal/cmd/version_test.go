@@ -0,0 +1,13 @@
+package cmd
+
+import "testing"
+
+func TestResolveBuildTime_PrefersLdflag(t *testing.T) {
+	orig := BuildTime
+	t.Cleanup(func() { BuildTime = orig })
+
+	BuildTime = "2026-02-18T12:00:00Z"
+	if got := resolveBuildTime(); got != BuildTime {
+		t.Errorf("resolveBuildTime() = %q, want %q", got, BuildTime)
+	}
+}
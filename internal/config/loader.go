@@ -7,6 +7,7 @@ import (
 	"os"
 	"os/exec"
 	"path/filepath"
+	"reflect"
 	"runtime"
 	"sort"
 	"strings"
@@ -2911,3 +2912,71 @@ func (c *EscalationConfig) GetMaxReescalations() int {
 	}
 	return *c.MaxReescalations
 }
+
+// ConvoySettings returns the town's convoy configuration, or the zero value
+// (built-in defaults) when none is set.
+func (s *TownSettings) ConvoySettings() ConvoyConfig {
+	if s == nil || s.Convoy == nil {
+		return ConvoyConfig{}
+	}
+	return *s.Convoy
+}
+
+// MayorChatTimeout returns mayor.chat_timeout, or fallback when it is unset,
+// unparseable, or not positive.
+func (s *TownSettings) MayorChatTimeout(fallback time.Duration) time.Duration {
+	if s == nil || s.Mayor == nil {
+		return fallback
+	}
+	if d := ParseDurationOrDefault(s.Mayor.ChatTimeout, fallback); d > 0 {
+		return d
+	}
+	return fallback
+}
+
+// UnknownTownSettingsKeys returns the dotted paths of keys in a settings file
+// that TownSettings does not recognize, e.g. "convoy.rig_stratgy". Free-form
+// map fields such as agents are not inspected. Unknown keys are ignored when
+// loading, so this exists to surface typos rather than to reject the file.
+func UnknownTownSettingsKeys(data []byte) []string {
+	var raw map[string]json.RawMessage
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return nil
+	}
+	var unknown []string
+	collectUnknownKeys(raw, reflect.TypeOf(TownSettings{}), "", &unknown)
+	sort.Strings(unknown)
+	return unknown
+}
+
+func collectUnknownKeys(raw map[string]json.RawMessage, t reflect.Type, prefix string, unknown *[]string) {
+	fields := make(map[string]reflect.Type)
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		name, _, _ := strings.Cut(f.Tag.Get("json"), ",")
+		if name == "-" || !f.IsExported() {
+			continue
+		}
+		if name == "" {
+			name = f.Name
+		}
+		fields[name] = f.Type
+	}
+	for key, value := range raw {
+		ft, ok := fields[key]
+		if !ok {
+			*unknown = append(*unknown, prefix+key)
+			continue
+		}
+		if ft.Kind() == reflect.Pointer {
+			ft = ft.Elem()
+		}
+		if ft.Kind() != reflect.Struct {
+			continue
+		}
+		var nested map[string]json.RawMessage
+		if json.Unmarshal(value, &nested) == nil {
+			collectUnknownKeys(nested, ft, prefix+key+".", unknown)
+		}
+	}
+}
@@ -91,6 +91,9 @@ type TownSettings struct {
 	// Convoy configures convoy behavior settings.
 	Convoy *ConvoyConfig `json:"convoy,omitempty"`
 
+	// Mayor configures the Mayor's tmux session.
+	Mayor *MayorSessionConfig `json:"mayor,omitempty"`
+
 	// RoleEffort maps role names to effort levels for per-role effort configuration.
 	// Keys are role names: "mayor", "deacon", "witness", "refinery", "polecat", "crew", "boot", "dog".
 	// Values are effort levels: "low", "medium", "high", "max".
@@ -492,11 +495,116 @@ func DefaultOperationalConfig() *OperationalConfig {
 	return &OperationalConfig{}
 }
 
+// MayorSessionConfig configures the Mayor's tmux session.
+type MayorSessionConfig struct {
+	// SessionName names the Mayor's tmux session. Empty means "hq-mayor".
+	// "auto" derives a name from the town root ("hq-mayor-<basename>-<hash6>"),
+	// so towns sharing a tmux socket each get their own Mayor. Any other value
+	// is used as given, with an "hq-" prefix added if missing.
+	//
+	// Deriving is opt-in: each town already runs on its own tmux socket
+	// unless GT_TMUX_SOCKET points several at one, and existing hooks and
+	// scripts address the Mayor as hq-mayor. Set "auto" when towns share a
+	// socket.
+	SessionName string `json:"session_name,omitempty"`
+
+	// Command, if set, is the startup command for the Mayor's session in
+	// place of the one built from its agent config. gt mayor start --agent
+	// still takes precedence.
+	Command string `json:"command,omitempty"`
+
+	// ContextTemplate is a Go text/template for the workspace summary that
+	// gt mayor chat --append-context puts before a message. It can use
+	// .Open, .Ready, .InProgress, .Blocked (issue counts), .Polecats (live
+	// polecats as rig/name), .Sessions (live agent sessions), and join.
+	// The result is collapsed onto one line. Empty uses a built-in summary.
+	ContextTemplate string `json:"context_template,omitempty"`
+
+	// ChatTimeout is the default --timeout for gt mayor chat, ask, and repl
+	// and gt polecat chat, as a Go duration (e.g. "5m"). Empty or invalid
+	// keeps the built-in 2m.
+	ChatTimeout string `json:"chat_timeout,omitempty"`
+
+	// ArtifactPatterns are regular expressions for agent UI lines that
+	// gt mayor chat strips from captured responses, in addition to the
+	// built-in Claude Code patterns. Each is matched against a line with
+	// surrounding whitespace trimmed. Use them for other agent CLIs'
+	// prompts and status bars.
+	ArtifactPatterns []string `json:"artifact_patterns,omitempty"`
+}
+
 // ConvoyConfig configures convoy behavior settings.
 type ConvoyConfig struct {
 	// NotifyOnComplete controls whether convoy completion pushes a notification
 	// into the active Mayor session (in addition to mail). Opt-in; default false.
 	NotifyOnComplete bool `json:"notify_on_complete,omitempty"`
+
+	// SlingableTypes lists the bead types convoys may dispatch via gt sling.
+	// Empty means the built-in set (task, bug, feature, chore).
+	// Example: ["task", "bug", "spike", "research"]
+	SlingableTypes []string `json:"slingable_types,omitempty"`
+
+	// RigStrategy picks which rig receives the next convoy dispatch when
+	// ready issues route to several rigs: "order" (default), "least-loaded",
+	// or "round-robin".
+	RigStrategy string `json:"rig_strategy,omitempty"`
+
+	// MaxPerRig caps in-flight issues per rig from a single convoy.
+	// 0 means no limit.
+	MaxPerRig int `json:"max_per_rig,omitempty"`
+
+	// EventLog, if set, appends a JSON line per convoy transition (issue
+	// dispatched or completed, convoy advanced or completed, capacity
+	// throttled) to this file. Relative paths are under the town root.
+	EventLog string `json:"event_log,omitempty"`
+
+	// Webhook, if its URL is set, POSTs each convoy event as JSON.
+	Webhook *ConvoyWebhookConfig `json:"webhook,omitempty"`
+
+	// TypeRoutes sends issues of a type to a fixed rig, overriding the rig
+	// their ID prefix maps to. Types without a rule route by prefix.
+	// Type-routed dispatches run gt sling --allow-cross-rig, which passes its
+	// cross-rig guard and keeps every other check.
+	// Example: {"bug": "firefighter", "feature": "build"}
+	TypeRoutes map[string]string `json:"type_routes,omitempty"`
+
+	// Timeouts, if set, lets gt convoy feed --watch act on issues that stay
+	// in progress longer than a per-type limit.
+	Timeouts *ConvoyTimeoutConfig `json:"timeouts,omitempty"`
+}
+
+// ConvoyTimeoutConfig configures the in-progress timeout watchdog. Limits
+// are Go durations measured from the assigned_at time gt sling records.
+type ConvoyTimeoutConfig struct {
+	// Default applies to types without a ByType entry. Empty means those
+	// types never time out.
+	Default string `json:"default,omitempty"`
+
+	// ByType sets the limit per issue type.
+	// Example: {"bug": "2h", "feature": "8h"}
+	ByType map[string]string `json:"by_type,omitempty"`
+
+	// Action on timeout: "warn" (default) logs and emits an event,
+	// "reassign" also reopens the issue for re-slinging, and "kill" also
+	// interrupts the polecat first.
+	Action string `json:"action,omitempty"`
+}
+
+// ConvoyWebhookConfig configures HTTP delivery of convoy events.
+type ConvoyWebhookConfig struct {
+	// URL receives a POST per event.
+	URL string `json:"url,omitempty"`
+
+	// Secret, if set, signs each body with HMAC-SHA256 in the
+	// X-Gastown-Signature header ("sha256=<hex>") so receivers can
+	// verify it came from this town.
+	Secret string `json:"secret,omitempty"`
+
+	// Timeout bounds each delivery attempt (Go duration, default "5s").
+	Timeout string `json:"timeout,omitempty"`
+
+	// Retries is how many times a failed delivery is retried (default 2).
+	Retries *int `json:"retries,omitempty"`
 }
 
 // ParseDurationOrDefault parses a Go duration string, returning fallback on error or empty input.
@@ -622,4 +622,49 @@ func TestParseDurationOrDefault_AllWebTimeoutDefaults(t *testing.T) {
 	}
 }
 
+func TestUnknownTownSettingsKeys(t *testing.T) {
+	t.Parallel()
+	data := []byte(`{
+		"type": "town-settings",
+		"default_agnet": "claude",
+		"agents": {"custom": {"command": "x", "made_up": 1}},
+		"convoy": {"rig_strategy": "round-robin", "rig_stratgy": "x"},
+		"web_timeouts": {"cmd_timeout": "10s", "bogus": true}
+	}`)
+	got := UnknownTownSettingsKeys(data)
+	want := []string{"convoy.rig_stratgy", "default_agnet", "web_timeouts.bogus"}
+	if strings.Join(got, ",") != strings.Join(want, ",") {
+		t.Errorf("UnknownTownSettingsKeys() = %v, want %v", got, want)
+	}
+}
+
+func TestTownSettings_ConvoySettingsDefaults(t *testing.T) {
+	t.Parallel()
+	if got := NewTownSettings().ConvoySettings(); got.RigStrategy != "" || got.MaxPerRig != 0 || got.SlingableTypes != nil {
+		t.Errorf("ConvoySettings() with no convoy section = %+v, want zero value", got)
+	}
+	s := &TownSettings{Convoy: &ConvoyConfig{MaxPerRig: 2}}
+	if got := s.ConvoySettings().MaxPerRig; got != 2 {
+		t.Errorf("ConvoySettings().MaxPerRig = %d, want 2", got)
+	}
+}
 
+func TestTownSettings_MayorChatTimeout(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name     string
+		settings *TownSettings
+		want     time.Duration
+	}{
+		{"no mayor section", NewTownSettings(), 2 * time.Minute},
+		{"unset", &TownSettings{Mayor: &MayorSessionConfig{}}, 2 * time.Minute},
+		{"set", &TownSettings{Mayor: &MayorSessionConfig{ChatTimeout: "5m"}}, 5 * time.Minute},
+		{"invalid", &TownSettings{Mayor: &MayorSessionConfig{ChatTimeout: "soon"}}, 2 * time.Minute},
+		{"negative", &TownSettings{Mayor: &MayorSessionConfig{ChatTimeout: "-1m"}}, 2 * time.Minute},
+	}
+	for _, tt := range tests {
+		if got := tt.settings.MayorChatTimeout(2 * time.Minute); got != tt.want {
+			t.Errorf("%s: MayorChatTimeout() = %v, want %v", tt.name, got, tt.want)
+		}
+	}
+}
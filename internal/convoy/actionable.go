@@ -0,0 +1,111 @@
+package convoy
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"os/exec"
+	"strings"
+
+	"github.com/steveyegge/gastown/internal/beads"
+	"github.com/steveyegge/gastown/internal/util"
+)
+
+// actionableTypes are bead types no polecat works on but the feed still acts
+// on: a message is delivered to the Mayor, and a decision or event is
+// recorded. Either way the issue is closed so it doesn't hold the convoy open.
+var actionableTypes = map[string]bool{
+	"message":  true,
+	"decision": true,
+	"event":    true,
+}
+
+// IsActionableType reports whether the feed handles a bead type itself
+// instead of slinging it. A type the town has made slingable is slung.
+func IsActionableType(issueType string) bool {
+	return actionableTypes[issueType] && !IsSlingableType(issueType)
+}
+
+// IssueCloser is implemented by stores that can close an issue directly.
+// The beads store does; without it handleActionable falls back to bd close.
+type IssueCloser interface {
+	CloseIssue(ctx context.Context, id, reason, actor, session string) error
+}
+
+// handleActionable carries out an actionable issue and closes it. The issue
+// is claimed first so two feeders don't deliver a message twice; a failed
+// delivery releases the claim and leaves the issue for the next feed.
+// Returns the action taken ("delivered" or "recorded").
+func handleActionable(ctx context.Context, store IssueStore, resolver *StoreResolver, townRoot, convoyID, gtPath string, issue trackedIssue) (string, error) {
+	release, err := claimForDispatch(ctx, store, resolver, issue.ID, convoyID)
+	if err != nil {
+		return "", err
+	}
+
+	action, reason := "recorded", "Recorded by convoy "+convoyID
+	if issue.IssueType == "message" {
+		if err := deliverToMayor(ctx, store, resolver, townRoot, gtPath, issue.ID); err != nil {
+			release()
+			return "", fmt.Errorf("delivering to mayor: %w", err)
+		}
+		action, reason = "delivered", "Delivered to mayor by convoy "+convoyID
+	}
+
+	if err := closeActionable(ctx, store, resolver, townRoot, issue.ID, reason); err != nil {
+		return action, fmt.Errorf("closing: %w", err)
+	}
+	return action, nil
+}
+
+// deliverToMayor sends a message issue's title and description to the Mayor
+// with gt mayor chat.
+func deliverToMayor(ctx context.Context, store IssueStore, resolver *StoreResolver, townRoot, gtPath, issueID string) error {
+	text := issueID
+	if s := resolver.storeFor(issueID); s != nil {
+		store = s
+	}
+	if iss, err := store.GetIssue(ctx, issueID); err == nil && iss != nil {
+		text = fmt.Sprintf("Message %s: %s", issueID, iss.Title)
+		if desc := strings.TrimSpace(iss.Description); desc != "" {
+			text += "\n\n" + desc
+		}
+	}
+
+	cmd := exec.CommandContext(ctx, gtPath, "mayor", "chat", "--quiet", text)
+	cmd.Dir = townRoot
+	util.SetProcessGroup(cmd)
+	var stderr bytes.Buffer
+	cmd.Stderr = &stderr
+	if err := cmd.Run(); err != nil {
+		return fmt.Errorf("%v: %s", err, strings.TrimSpace(stderr.String()))
+	}
+	return nil
+}
+
+// closeActionable closes a handled issue through the store that holds it,
+// or bd close from its rig when that store can't close issues.
+func closeActionable(ctx context.Context, store IssueStore, resolver *StoreResolver, townRoot, issueID, reason string) error {
+	var closer IssueCloser
+	if rs := resolver.storeFor(issueID); rs != nil {
+		closer, _ = rs.(IssueCloser)
+	} else {
+		closer, _ = store.(IssueCloser)
+	}
+	if closer != nil {
+		return closer.CloseIssue(ctx, issueID, reason, "convoy", "")
+	}
+
+	dir := beads.GetRigPathForPrefix(townRoot, beads.ExtractPrefix(issueID))
+	if dir == "" {
+		dir = townRoot
+	}
+	cmd := exec.CommandContext(ctx, "bd", "close", issueID, "--reason="+reason)
+	cmd.Dir = dir
+	util.SetProcessGroup(cmd)
+	var stderr bytes.Buffer
+	cmd.Stderr = &stderr
+	if err := cmd.Run(); err != nil {
+		return fmt.Errorf("%v: %s", err, strings.TrimSpace(stderr.String()))
+	}
+	return nil
+}
@@ -0,0 +1,357 @@
+package convoy
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+	"time"
+
+	beadsdk "github.com/steveyegge/beads"
+)
+
+// ArchiveVersion is the format version written by ExportArchive. Import
+// rejects archives from a newer version.
+const ArchiveVersion = 1
+
+// Archive is a JSON snapshot of a store's issues for backup and migration
+// (gt convoy export / import). Blockers and convoy memberships are kept as
+// each issue's dependencies: "blocks" (and the other blocking types) and
+// "tracks" from a convoy.
+type Archive struct {
+	Version    int            `json:"version"`
+	ExportedAt time.Time      `json:"exported_at"`
+	Issues     []ArchiveIssue `json:"issues"`
+}
+
+// ArchiveIssue is one issue in an Archive.
+type ArchiveIssue struct {
+	ID           string              `json:"id"`
+	Title        string              `json:"title"`
+	Description  string              `json:"description,omitempty"`
+	Type         string              `json:"issue_type"`
+	Status       string              `json:"status"`
+	Priority     int                 `json:"priority"`
+	Assignee     string              `json:"assignee,omitempty"`
+	Labels       []string            `json:"labels,omitempty"`
+	CreatedAt    time.Time           `json:"created_at"`
+	ClosedAt     *time.Time          `json:"closed_at,omitempty"`
+	Dependencies []ArchiveDependency `json:"dependencies,omitempty"`
+}
+
+// ArchiveDependency is an issue's dependency on another. DependsOn is kept
+// as the store records it, so external: references survive a round trip.
+type ArchiveDependency struct {
+	DependsOn string `json:"depends_on"`
+	Type      string `json:"type"`
+}
+
+// ArchiveStore is a store that can be exported and imported. The beads
+// store is one.
+type ArchiveStore interface {
+	DependencyStore
+	SearchIssues(ctx context.Context, query string, filter beadsdk.IssueFilter) ([]*beadsdk.Issue, error)
+	CreateIssue(ctx context.Context, issue *beadsdk.Issue, actor string) error
+	UpdateIssue(ctx context.Context, id string, updates map[string]interface{}, actor string) error
+	DeleteIssue(ctx context.Context, id string) error
+	AddLabel(ctx context.Context, issueID, label, actor string) error
+	RemoveLabel(ctx context.Context, issueID, label, actor string) error
+}
+
+// ExportArchive snapshots every issue in store, including closed ones, with
+// its dependencies. Ephemeral issues (wisps) are left out. Issues are
+// sorted by ID so exports of the same store diff cleanly.
+func ExportArchive(ctx context.Context, store ArchiveStore) (*Archive, error) {
+	issues, err := allIssues(ctx, store)
+	if err != nil {
+		return nil, err
+	}
+	archive := &Archive{Version: ArchiveVersion, ExportedAt: time.Now().UTC(), Issues: make([]ArchiveIssue, 0, len(issues))}
+	for _, iss := range issues {
+		deps, err := store.GetDependenciesWithMetadata(ctx, iss.ID)
+		if err != nil {
+			return nil, fmt.Errorf("dependencies of %s: %w", iss.ID, err)
+		}
+		entry := ArchiveIssue{
+			ID:          iss.ID,
+			Title:       iss.Title,
+			Description: iss.Description,
+			Type:        string(iss.IssueType),
+			Status:      string(iss.Status),
+			Priority:    iss.Priority,
+			Assignee:    iss.Assignee,
+			Labels:      iss.Labels,
+			CreatedAt:   iss.CreatedAt,
+			ClosedAt:    iss.ClosedAt,
+		}
+		for _, d := range deps {
+			entry.Dependencies = append(entry.Dependencies, ArchiveDependency{DependsOn: d.ID, Type: string(d.DependencyType)})
+		}
+		sort.Slice(entry.Dependencies, func(i, j int) bool { return entry.Dependencies[i].DependsOn < entry.Dependencies[j].DependsOn })
+		archive.Issues = append(archive.Issues, entry)
+	}
+	sort.Slice(archive.Issues, func(i, j int) bool { return archive.Issues[i].ID < archive.Issues[j].ID })
+	return archive, nil
+}
+
+// allIssues returns every non-ephemeral issue in store. An empty status
+// filter would return only open and in-progress issues, so each status is
+// asked for explicitly.
+func allIssues(ctx context.Context, store IssueSearcher) ([]*beadsdk.Issue, error) {
+	ephemeral := false
+	filter := beadsdk.IssueFilter{
+		Statuses:  append(slices.Clone(ActiveStatuses), beadsdk.StatusClosed),
+		Ephemeral: &ephemeral,
+	}
+	issues, err := store.SearchIssues(ctx, "", filter)
+	if err != nil {
+		return nil, fmt.Errorf("listing issues: %w", err)
+	}
+	return issues, nil
+}
+
+// ImportOptions controls ImportArchive.
+type ImportOptions struct {
+	// Replace makes the store hold exactly the archive: issues it names are
+	// created or updated, dependencies it doesn't list are removed from
+	// them, and every other issue is deleted last. Otherwise the archive is
+	// merged in and the rest of the store is left alone.
+	Replace bool
+	// DryRun validates the archive and counts the changes without making
+	// them.
+	DryRun bool
+	Actor  string
+}
+
+// ImportResult counts what ImportArchive changed (or, with DryRun, would).
+type ImportResult struct {
+	Created      int  `json:"created"`
+	Updated      int  `json:"updated"`
+	Deleted      int  `json:"deleted"`
+	Dependencies int  `json:"dependencies"` // dependencies added
+	DryRun       bool `json:"dry_run,omitempty"`
+}
+
+// ImportArchive loads an archive into store. The archive is validated
+// before anything is written: every issue needs a unique ID, and every
+// dependency must point at an issue in the archive, at an issue already in
+// the store (when merging), or at another store through an external:
+// reference. Dependencies are added once all issues exist. Merging adds
+// missing dependencies but never removes any.
+//
+// The writes are not atomic. A failure partway leaves the issues written so
+// far; with Replace, nothing is deleted until everything else is written, so
+// a failed replace keeps the store's old issues and can be rerun.
+func ImportArchive(ctx context.Context, store ArchiveStore, archive *Archive, opts ImportOptions) (*ImportResult, error) {
+	if archive.Version > ArchiveVersion {
+		return nil, fmt.Errorf("archive version %d is newer than this gt supports (%d)", archive.Version, ArchiveVersion)
+	}
+	existing, err := allIssues(ctx, store)
+	if err != nil {
+		return nil, err
+	}
+	current := make(map[string]*beadsdk.Issue, len(existing))
+	exists := make(map[string]bool, len(existing))
+	for _, iss := range existing {
+		current[iss.ID] = iss
+		exists[iss.ID] = true
+	}
+	// A replace deletes the issues outside the archive, so only a merge can
+	// lean on them to satisfy a dependency.
+	resolvable := exists
+	if opts.Replace {
+		resolvable = nil
+	}
+	if err := validateArchive(archive, resolvable); err != nil {
+		return nil, err
+	}
+
+	inArchive := make(map[string]bool, len(archive.Issues))
+	result := &ImportResult{DryRun: opts.DryRun}
+	for _, entry := range archive.Issues {
+		inArchive[entry.ID] = true
+		if exists[entry.ID] {
+			result.Updated++
+		} else {
+			result.Created++
+		}
+	}
+	var leftovers []string
+	if opts.Replace {
+		for _, iss := range existing {
+			if !inArchive[iss.ID] {
+				leftovers = append(leftovers, iss.ID)
+			}
+		}
+		result.Deleted = len(leftovers)
+	}
+	if opts.DryRun {
+		result.Dependencies = countNewDependencies(ctx, store, archive, exists)
+		return result, nil
+	}
+
+	for _, entry := range archive.Issues {
+		if iss := current[entry.ID]; iss != nil {
+			if err := store.UpdateIssue(ctx, entry.ID, archiveUpdates(entry), opts.Actor); err != nil {
+				return result, fmt.Errorf("updating %s: %w", entry.ID, err)
+			}
+			if err := syncLabels(ctx, store, iss, entry, opts.Actor); err != nil {
+				return result, err
+			}
+			continue
+		}
+		if err := store.CreateIssue(ctx, archiveIssue(entry), opts.Actor); err != nil {
+			return result, fmt.Errorf("creating %s: %w", entry.ID, err)
+		}
+	}
+	for _, entry := range archive.Issues {
+		have := existingDependencies(ctx, store, entry.ID, exists)
+		want := make(map[string]bool, len(entry.Dependencies))
+		for _, d := range entry.Dependencies {
+			want[d.DependsOn] = true
+			if have[d.DependsOn] {
+				continue
+			}
+			dep := &beadsdk.Dependency{IssueID: entry.ID, DependsOnID: d.DependsOn, Type: beadsdk.DependencyType(d.Type)}
+			if err := store.AddDependency(ctx, dep, opts.Actor); err != nil {
+				return result, fmt.Errorf("adding dependency of %s on %s: %w", entry.ID, d.DependsOn, err)
+			}
+			result.Dependencies++
+		}
+		if !opts.Replace {
+			continue
+		}
+		for id := range have {
+			if want[id] {
+				continue
+			}
+			if err := store.RemoveDependency(ctx, entry.ID, id, opts.Actor); err != nil {
+				return result, fmt.Errorf("removing dependency of %s on %s: %w", entry.ID, id, err)
+			}
+		}
+	}
+	for _, id := range leftovers {
+		if err := store.DeleteIssue(ctx, id); err != nil {
+			return result, fmt.Errorf("deleting %s: %w", id, err)
+		}
+	}
+	return result, nil
+}
+
+// validateArchive checks an archive's IDs and dependencies against itself
+// and the issues already in the store, reporting every problem at once.
+func validateArchive(archive *Archive, exists map[string]bool) error {
+	inArchive := make(map[string]bool, len(archive.Issues))
+	var problems []string
+	for _, entry := range archive.Issues {
+		switch {
+		case entry.ID == "":
+			problems = append(problems, fmt.Sprintf("issue %q has no ID", entry.Title))
+		case inArchive[entry.ID]:
+			problems = append(problems, fmt.Sprintf("%s appears more than once", entry.ID))
+		}
+		inArchive[entry.ID] = true
+	}
+	for _, entry := range archive.Issues {
+		for _, d := range entry.Dependencies {
+			if inArchive[d.DependsOn] || exists[d.DependsOn] || ParseIssueRef(d.DependsOn).Provider != "" {
+				continue
+			}
+			problems = append(problems, fmt.Sprintf("%s has a %q dependency on %s, which is not in the archive or the store", entry.ID, d.Type, d.DependsOn))
+		}
+	}
+	if len(problems) > 0 {
+		return fmt.Errorf("invalid archive:\n  %s", strings.Join(problems, "\n  "))
+	}
+	return nil
+}
+
+// existingDependencies returns what issueID already depends on, when it
+// was in the store before the import.
+func existingDependencies(ctx context.Context, store ArchiveStore, issueID string, exists map[string]bool) map[string]bool {
+	have := make(map[string]bool)
+	if !exists[issueID] {
+		return have
+	}
+	deps, err := store.GetDependenciesWithMetadata(ctx, issueID)
+	if err != nil {
+		return have
+	}
+	for _, d := range deps {
+		have[d.ID] = true
+	}
+	return have
+}
+
+// countNewDependencies counts the dependencies an import would add.
+func countNewDependencies(ctx context.Context, store ArchiveStore, archive *Archive, exists map[string]bool) int {
+	n := 0
+	for _, entry := range archive.Issues {
+		have := existingDependencies(ctx, store, entry.ID, exists)
+		for _, d := range entry.Dependencies {
+			if !have[d.DependsOn] {
+				n++
+			}
+		}
+	}
+	return n
+}
+
+// archiveIssue builds the issue to create for an archive entry. A closed
+// issue without a recorded close time is stamped now, as the store requires
+// one.
+func archiveIssue(entry ArchiveIssue) *beadsdk.Issue {
+	iss := &beadsdk.Issue{
+		ID:          entry.ID,
+		Title:       entry.Title,
+		Description: entry.Description,
+		IssueType:   beadsdk.IssueType(entry.Type),
+		Status:      beadsdk.Status(entry.Status),
+		Priority:    entry.Priority,
+		Assignee:    entry.Assignee,
+		Labels:      entry.Labels,
+		CreatedAt:   entry.CreatedAt,
+		ClosedAt:    entry.ClosedAt,
+	}
+	if iss.Status == beadsdk.StatusClosed && iss.ClosedAt == nil {
+		now := time.Now().UTC()
+		iss.ClosedAt = &now
+	}
+	return iss
+}
+
+// archiveUpdates is the update that brings an existing issue's fields in
+// line with its archive entry. Labels are set apart, by syncLabels.
+func archiveUpdates(entry ArchiveIssue) map[string]interface{} {
+	return map[string]interface{}{
+		"title":       entry.Title,
+		"description": entry.Description,
+		"issue_type":  entry.Type,
+		"status":      entry.Status,
+		"priority":    entry.Priority,
+		"assignee":    entry.Assignee,
+	}
+}
+
+// syncLabels adds and removes labels so that iss carries exactly its
+// archive entry's.
+func syncLabels(ctx context.Context, store ArchiveStore, iss *beadsdk.Issue, entry ArchiveIssue, actor string) error {
+	for _, label := range entry.Labels {
+		if slices.Contains(iss.Labels, label) {
+			continue
+		}
+		if err := store.AddLabel(ctx, iss.ID, label, actor); err != nil {
+			return fmt.Errorf("labelling %s %q: %w", iss.ID, label, err)
+		}
+	}
+	for _, label := range iss.Labels {
+		if slices.Contains(entry.Labels, label) {
+			continue
+		}
+		if err := store.RemoveLabel(ctx, iss.ID, label, actor); err != nil {
+			return fmt.Errorf("removing label %q from %s: %w", label, iss.ID, err)
+		}
+	}
+	return nil
+}
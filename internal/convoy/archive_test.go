@@ -0,0 +1,183 @@
+package convoy
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	beadsdk "github.com/steveyegge/beads"
+)
+
+// archiveFixture is a store with a convoy tracking two issues, one blocking
+// the other, and a closed, labelled issue.
+func archiveFixture() *memStore {
+	convoy := memIssue("test-convoy", beadsdk.StatusOpen, "")
+	convoy.IssueType = "convoy"
+	done := memIssue("test-done", beadsdk.StatusClosed, "")
+	done.Labels = []string{"backend"}
+	closedAt := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+	done.ClosedAt = &closedAt
+	store := newMemStore(
+		convoy,
+		memIssue("test-a", beadsdk.StatusInProgress, "testrig/polecats/alpha"),
+		memIssue("test-b", beadsdk.StatusOpen, ""),
+		done,
+	)
+	store.addDep("test-convoy", "test-a", "tracks")
+	store.addDep("test-convoy", "test-b", "tracks")
+	store.addDep("test-b", "test-a", "blocks")
+	return store
+}
+
+func TestArchive_RoundTrip(t *testing.T) {
+	ctx := context.Background()
+	archive, err := ExportArchive(ctx, archiveFixture())
+	if err != nil {
+		t.Fatalf("ExportArchive: %v", err)
+	}
+	if len(archive.Issues) != 4 {
+		t.Fatalf("exported %d issues, want 4", len(archive.Issues))
+	}
+
+	target := newMemStore()
+	result, err := ImportArchive(ctx, target, archive, ImportOptions{})
+	if err != nil {
+		t.Fatalf("ImportArchive: %v", err)
+	}
+	if result.Created != 4 || result.Dependencies != 3 {
+		t.Errorf("result = %+v, want 4 created and 3 dependencies", result)
+	}
+
+	again, err := ExportArchive(ctx, target)
+	if err != nil {
+		t.Fatalf("ExportArchive after import: %v", err)
+	}
+	if !reflect.DeepEqual(again.Issues, archive.Issues) {
+		t.Errorf("re-exported issues differ:\n got %+v\nwant %+v", again.Issues, archive.Issues)
+	}
+	if tracked := getConvoyTrackedIssues(ctx, target, "test-convoy", "", nil); len(tracked) != 2 {
+		t.Errorf("imported convoy tracks %d issues, want 2", len(tracked))
+	}
+}
+
+func TestImportArchive_MergeAndReplace(t *testing.T) {
+	ctx := context.Background()
+	archive, err := ExportArchive(ctx, archiveFixture())
+	if err != nil {
+		t.Fatalf("ExportArchive: %v", err)
+	}
+	renamed := func() *beadsdk.Issue {
+		iss := memIssue("test-a", beadsdk.StatusOpen, "")
+		iss.Title, iss.IssueType, iss.Labels = "stale title", "bug", []string{"stale"}
+		return iss
+	}
+
+	t.Run("merge", func(t *testing.T) {
+		target := newMemStore(renamed(), memIssue("test-other", beadsdk.StatusOpen, ""))
+		result, err := ImportArchive(ctx, target, archive, ImportOptions{})
+		if err != nil {
+			t.Fatalf("ImportArchive: %v", err)
+		}
+		if result.Created != 3 || result.Updated != 1 || result.Deleted != 0 {
+			t.Errorf("result = %+v, want 3 created, 1 updated", result)
+		}
+		if got, _ := target.GetIssue(ctx, "test-a"); got.Title != "test-a" || got.Assignee != "testrig/polecats/alpha" || got.IssueType != "task" || len(got.Labels) != 0 {
+			t.Errorf("test-a = %+v, want the archived title, assignee, type, and labels", got)
+		}
+		if got, _ := target.GetIssue(ctx, "test-other"); got == nil {
+			t.Error("merge deleted test-other, want it kept")
+		}
+	})
+	t.Run("replace", func(t *testing.T) {
+		target := newMemStore(renamed(), memIssue("test-other", beadsdk.StatusOpen, ""))
+		target.addDep("test-a", "test-other", "blocks")
+		result, err := ImportArchive(ctx, target, archive, ImportOptions{Replace: true})
+		if err != nil {
+			t.Fatalf("ImportArchive: %v", err)
+		}
+		if result.Created != 3 || result.Updated != 1 || result.Deleted != 1 {
+			t.Errorf("result = %+v, want 3 created, 1 updated, 1 deleted", result)
+		}
+		if got, _ := target.GetIssue(ctx, "test-other"); got != nil {
+			t.Error("replace kept test-other, want it deleted")
+		}
+		again, err := ExportArchive(ctx, target)
+		if err != nil {
+			t.Fatalf("ExportArchive after replace: %v", err)
+		}
+		if !reflect.DeepEqual(again.Issues, archive.Issues) {
+			t.Errorf("replaced store differs from the archive:\n got %+v\nwant %+v", again.Issues, archive.Issues)
+		}
+	})
+	t.Run("failed replace deletes nothing", func(t *testing.T) {
+		target := newMemStore(memIssue("test-other", beadsdk.StatusOpen, ""))
+		store := &failingCreateStore{memStore: target, failID: "test-b"}
+		if _, err := ImportArchive(ctx, store, archive, ImportOptions{Replace: true}); err == nil {
+			t.Fatal("ImportArchive succeeded, want the create failure")
+		}
+		if got, _ := target.GetIssue(ctx, "test-other"); got == nil {
+			t.Error("failed replace deleted test-other, want deletes left until everything else is written")
+		}
+	})
+	t.Run("dry run", func(t *testing.T) {
+		target := newMemStore()
+		result, err := ImportArchive(ctx, target, archive, ImportOptions{DryRun: true})
+		if err != nil {
+			t.Fatalf("ImportArchive: %v", err)
+		}
+		if result.Created != 4 || result.Dependencies != 3 || len(target.issues) != 0 {
+			t.Errorf("result = %+v with %d issues written, want 4 created, 3 dependencies, nothing written", result, len(target.issues))
+		}
+	})
+}
+
+// failingCreateStore is a memStore whose CreateIssue fails for one ID.
+type failingCreateStore struct {
+	*memStore
+	failID string
+}
+
+func (s *failingCreateStore) CreateIssue(ctx context.Context, issue *beadsdk.Issue, actor string) error {
+	if issue.ID == s.failID {
+		return fmt.Errorf("store unavailable")
+	}
+	return s.memStore.CreateIssue(ctx, issue, actor)
+}
+
+func TestImportArchive_RejectsDanglingDependencies(t *testing.T) {
+	archive := &Archive{Version: ArchiveVersion, Issues: []ArchiveIssue{
+		{ID: "test-a", Status: "open", Dependencies: []ArchiveDependency{
+			{DependsOn: "test-missing", Type: "blocks"},
+			{DependsOn: "external:gh:gh-42", Type: "blocks"}, // another store's issue
+			{DependsOn: "test-existing", Type: "blocks"},
+		}},
+		{ID: "test-a", Status: "open"},
+	}}
+	target := newMemStore(memIssue("test-existing", beadsdk.StatusOpen, ""))
+
+	_, err := ImportArchive(context.Background(), target, archive, ImportOptions{})
+	if err == nil {
+		t.Fatal("ImportArchive succeeded, want an integrity error")
+	}
+	for _, want := range []string{"test-missing", "test-a appears more than once"} {
+		if !strings.Contains(err.Error(), want) {
+			t.Errorf("error %q does not mention %q", err, want)
+		}
+	}
+	for _, unwanted := range []string{"gh-42", "test-existing"} {
+		if strings.Contains(err.Error(), unwanted) {
+			t.Errorf("error %q flags %s, which is resolvable", err, unwanted)
+		}
+	}
+	if len(target.issues) != 1 {
+		t.Errorf("store has %d issues after a rejected import, want it untouched", len(target.issues))
+	}
+
+	// Replacing drops the store's issues, so they can't satisfy a dependency.
+	if _, err := ImportArchive(context.Background(), target, &Archive{Issues: archive.Issues[:1]}, ImportOptions{Replace: true}); err == nil || !strings.Contains(err.Error(), "test-existing") {
+		t.Errorf("replace import error = %v, want test-existing reported as dangling", err)
+	}
+}
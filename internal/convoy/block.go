@@ -0,0 +1,89 @@
+package convoy
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	beadsdk "github.com/steveyegge/beads"
+)
+
+// ErrDependencyCycle is returned by BlockIssue when the new dependency would
+// make issues block each other.
+var ErrDependencyCycle = errors.New("dependency cycle")
+
+// DependencyStore is an IssueStore that can add and remove dependencies.
+// The beads store is one.
+type DependencyStore interface {
+	IssueStore
+	AddDependency(ctx context.Context, dep *beadsdk.Dependency, actor string) error
+	RemoveDependency(ctx context.Context, issueID, dependsOnID, actor string) error
+}
+
+// BlockIssue records that issueID is blocked by blockerID with a "blocks"
+// dependency. Wrapped IDs are accepted; dependsOnID is what the dependency
+// stores for the blocker (an external: reference for a blocker in another
+// rig), or blockerID when empty. A dependency that would close a cycle is
+// rejected with ErrDependencyCycle, naming the issues in it.
+func BlockIssue(ctx context.Context, store DependencyStore, issueID, blockerID, dependsOnID, actor string, resolver *StoreResolver) error {
+	issueID, blockerID = extractIssueID(issueID), extractIssueID(blockerID)
+	if cycle := cycleThrough(ctx, store, issueID, blockerID, resolver); cycle != nil {
+		return fmt.Errorf("%w: blocking %s on %s would create %s", ErrDependencyCycle, issueID, blockerID, FormatCycle(cycle))
+	}
+	if dependsOnID == "" {
+		dependsOnID = blockerID
+	}
+	dep := &beadsdk.Dependency{
+		IssueID:     issueID,
+		DependsOnID: dependsOnID,
+		Type:        beadsdk.DependencyType("blocks"),
+	}
+	if err := store.AddDependency(ctx, dep, actor); err != nil {
+		return fmt.Errorf("blocking %s on %s: %w", issueID, blockerID, err)
+	}
+	return nil
+}
+
+// UnblockIssue removes the dependency of issueID on dependsOnID (see
+// BlockIssue).
+func UnblockIssue(ctx context.Context, store DependencyStore, issueID, dependsOnID, actor string) error {
+	issueID = extractIssueID(issueID)
+	if err := store.RemoveDependency(ctx, issueID, dependsOnID, actor); err != nil {
+		return fmt.Errorf("unblocking %s from %s: %w", issueID, extractIssueID(dependsOnID), err)
+	}
+	return nil
+}
+
+// FeedConvoysForIssue feeds each open, launched convoy tracking issueID, for
+// when the issue's blockers change. Unlike CheckConvoysForIssue it runs no
+// completion check: the issue hasn't closed, but it may have just become
+// ready.
+func FeedConvoysForIssue(ctx context.Context, store IssueStore, townRoot, issueID, caller string, logger func(format string, args ...interface{}), gtPath string, isRigParked func(string) bool, res *StoreResolver) CheckResult {
+	var result CheckResult
+	if logger == nil {
+		logger = func(format string, args ...interface{}) {}
+	}
+	if isRigParked == nil {
+		isRigParked = func(string) bool { return false }
+	}
+	if store == nil {
+		return result
+	}
+
+	result.ConvoyIDs = getTrackingConvoys(ctx, store, extractIssueID(issueID), logger)
+	feedOpts := townFeedOptions(townRoot)
+	for _, convoyID := range result.ConvoyIDs {
+		if isConvoyClosed(ctx, store, convoyID) || isConvoyStaged(ctx, store, convoyID) {
+			result.Skipped = append(result.Skipped, convoyID)
+			continue
+		}
+		fed := FeedConvoy(ctx, store, townRoot, convoyID, caller, logger, gtPath, isRigParked, feedOpts, res)
+		if fed.IssueID != "" {
+			result.Fed = append(result.Fed, ConvoyFeed{ConvoyID: convoyID, FeedResult: *fed})
+			if !fed.DryRun {
+				emit(Event{Type: EventConvoyAdvanced, ConvoyID: convoyID, IssueID: fed.IssueID, Rig: fed.Rig, Caller: caller})
+			}
+		}
+	}
+	return result
+}
@@ -0,0 +1,74 @@
+package convoy
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	beadsdk "github.com/steveyegge/beads"
+)
+
+func TestBlockIssue_RejectsCycle(t *testing.T) {
+	store := newMemStore(
+		memIssue("test-a", beadsdk.StatusOpen, ""),
+		memIssue("test-b", beadsdk.StatusOpen, ""),
+		memIssue("test-c", beadsdk.StatusOpen, ""),
+	)
+	ctx := context.Background()
+	if err := BlockIssue(ctx, store, "test-b", "test-a", "", "test", nil); err != nil {
+		t.Fatalf("BlockIssue(b on a) = %v", err)
+	}
+	if err := BlockIssue(ctx, store, "external:test:test-c", "test-b", "", "test", nil); err != nil {
+		t.Fatalf("BlockIssue(c on b) = %v", err)
+	}
+
+	err := BlockIssue(ctx, store, "test-a", "test-c", "", "test", nil)
+	if !errors.Is(err, ErrDependencyCycle) {
+		t.Fatalf("BlockIssue(a on c) = %v, want ErrDependencyCycle", err)
+	}
+	if !strings.Contains(err.Error(), "test-a → test-c → test-b → test-a") {
+		t.Errorf("error = %q, want the cycle named", err)
+	}
+	if len(store.deps) != 2 {
+		t.Errorf("deps = %d, want the rejected dependency not recorded", len(store.deps))
+	}
+
+	if err := BlockIssue(ctx, store, "test-a", "test-a", "", "test", nil); !errors.Is(err, ErrDependencyCycle) {
+		t.Errorf("BlockIssue(a on a) = %v, want ErrDependencyCycle", err)
+	}
+
+	// Once the blocker is closed the chain no longer holds anything back.
+	store.setStatus("test-b", beadsdk.StatusClosed)
+	if err := BlockIssue(ctx, store, "test-a", "test-c", "", "test", nil); err != nil {
+		t.Errorf("BlockIssue(a on c) after closing b = %v", err)
+	}
+}
+
+func TestMemStore_UnblockFeedsConvoy(t *testing.T) {
+	store := newMemStore(
+		memIssue("test-convoy", beadsdk.StatusOpen, ""),
+		memIssue("test-blocker", beadsdk.StatusOpen, "testrig/polecats/alpha"),
+		memIssue("test-waiting", beadsdk.StatusOpen, ""),
+	)
+	store.addDep("test-convoy", "test-waiting", "tracks")
+	store.addDep("test-waiting", "test-blocker", "blocks")
+
+	townRoot := setupTownRoot(t)
+	gtPath, logPath := makeGTStub(t, 0)
+	ctx := context.Background()
+
+	if err := UnblockIssue(ctx, store, "test-waiting", "test-blocker", "test"); err != nil {
+		t.Fatalf("UnblockIssue() = %v", err)
+	}
+	result := FeedConvoysForIssue(ctx, store, townRoot, "test-waiting", "Unblock", nil, gtPath, nil, nil)
+	if len(result.Fed) != 1 || result.Fed[0].IssueID != "test-waiting" {
+		t.Fatalf("Fed = %+v, want test-waiting dispatched", result.Fed)
+	}
+	if log := readGTLog(t, logPath); !strings.Contains(log, "sling test-waiting testrig") {
+		t.Errorf("gt stub log = %q, want sling of test-waiting", log)
+	}
+	if strings.Contains(readGTLog(t, logPath), "convoy check") {
+		t.Error("unblocking should not run a completion check")
+	}
+}
@@ -0,0 +1,87 @@
+package convoy
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+	beadsdk "github.com/steveyegge/beads"
+)
+
+// ErrAlreadyClaimed is returned when a dispatch claim loses to another
+// worker that assigned the issue first.
+var ErrAlreadyClaimed = errors.New("issue already claimed")
+
+// IssueClaimer is implemented by stores that can atomically assign an open,
+// unassigned issue. The Dolt-backed beads store does (its ClaimIssue is a
+// conditional UPDATE on an empty assignee), although ClaimIssue is not part
+// of beadsdk.Storage, so callers type-assert for it.
+type IssueClaimer interface {
+	GetIssue(ctx context.Context, id string) (*beadsdk.Issue, error)
+	ClaimIssue(ctx context.Context, id, actor string) error
+	UpdateIssue(ctx context.Context, id string, updates map[string]interface{}, actor string) error
+}
+
+// dispatchClaimPrefix marks the placeholder assignee a convoy feed writes
+// while gt sling picks the real polecat.
+const dispatchClaimPrefix = "convoy-dispatch:"
+
+// newDispatchClaim returns a claim actor unique to one dispatch attempt, so
+// two feeders of the same convoy never mistake each other's claim for their
+// own (ClaimIssue succeeds idempotently for the same actor).
+func newDispatchClaim(convoyID string) string {
+	return dispatchClaimPrefix + convoyID + ":" + strings.ReplaceAll(uuid.NewString(), "-", "")[:8]
+}
+
+// IsDispatchClaim reports whether assignee is a convoy feed's in-flight
+// dispatch claim rather than a real agent. gt sling treats such issues as
+// unassigned so the dispatch that made the claim can complete.
+func IsDispatchClaim(assignee string) bool {
+	return strings.HasPrefix(assignee, dispatchClaimPrefix)
+}
+
+// claimForDispatch claims issueID in the store that holds it before gt sling
+// runs, so concurrent or restarted feeders can't sling it twice. It returns
+// ErrAlreadyClaimed (wrapped, naming the holder) if another worker got there
+// first. Claiming is best-effort otherwise: if the store can't claim, or
+// doesn't hold the issue, dispatch proceeds unclaimed and gt sling's own
+// per-bead lock remains the guard.
+//
+// release undoes the claim after a failed dispatch, even once ctx is
+// cancelled; it is never nil.
+func claimForDispatch(ctx context.Context, store IssueStore, resolver *StoreResolver, issueID, convoyID string) (release func(), err error) {
+	noop := func() {}
+
+	claimer, ok := store.(IssueClaimer)
+	if rs := resolver.storeFor(issueID); rs != nil {
+		claimer, ok = rs.(IssueClaimer)
+	}
+	if !ok {
+		return noop, nil
+	}
+
+	actor := newDispatchClaim(convoyID)
+	if err := claimer.ClaimIssue(ctx, issueID, actor); err != nil {
+		if errors.Is(err, ErrAlreadyClaimed) {
+			return noop, err
+		}
+		// The beads store's sentinel lives in an internal package; match
+		// its text ("issue already claimed by <holder>") instead.
+		if _, holder, found := strings.Cut(err.Error(), ErrAlreadyClaimed.Error()); found {
+			return noop, fmt.Errorf("%w%s", ErrAlreadyClaimed, holder)
+		}
+		return noop, nil // can't claim here; fall back to gt sling's lock
+	}
+
+	return func() {
+		// A dispatch that failed because ctx was cancelled must still
+		// release, or shutdown would leave the issue half-claimed.
+		ctx := context.WithoutCancel(ctx)
+		// Only reopen if gt sling didn't get as far as assigning a polecat.
+		if iss, err := claimer.GetIssue(ctx, issueID); err == nil && iss.Assignee == actor {
+			_ = claimer.UpdateIssue(ctx, issueID, map[string]interface{}{"status": "open", "assignee": ""}, actor)
+		}
+	}, nil
+}
@@ -0,0 +1,148 @@
+package convoy
+
+import (
+	"context"
+	"sort"
+	"strings"
+)
+
+// maxCycleSearch bounds how many issues a cycle search visits, so a huge
+// dependency graph can't stall a feed.
+const maxCycleSearch = 1000
+
+// FindBlockingCycles follows blocking dependencies out from issueIDs and
+// returns every cycle among unclosed issues. Issues in a cycle wait on each
+// other, so isIssueBlocked skips all of them forever. Each cycle lists its
+// issues in blocking order starting from the lowest ID: [a b c] means a is
+// blocked by b, b by c, and c by a. A self-loop is a one-issue cycle.
+func FindBlockingCycles(ctx context.Context, store IssueStore, issueIDs []string, resolver *StoreResolver) [][]string {
+	if store == nil || len(issueIDs) == 0 {
+		return nil
+	}
+	return findCycles(issueIDs, openBlockers(ctx, store, resolver))
+}
+
+// openBlockers returns an edge function for findCycles: the unclosed issues
+// blocking a given issue.
+func openBlockers(ctx context.Context, store IssueStore, resolver *StoreResolver) func(string) []string {
+	return func(id string) []string {
+		deps, err := issueDeps(ctx, store, id, resolver)
+		if err != nil {
+			return nil
+		}
+		var out []string
+		for _, d := range deps {
+			if !blockingDepTypes[string(d.DependencyType)] {
+				continue
+			}
+			if s := string(d.Status); s == "closed" || s == "tombstone" {
+				continue
+			}
+			out = append(out, extractIssueID(d.ID))
+		}
+		return out
+	}
+}
+
+// cycleThrough returns the cycle that blocking issueID on blockerID would
+// close, or nil if blockerID doesn't already wait on issueID. The new edge
+// is searched first, so whenever issueID is reachable from blockerID the
+// search finds a cycle that uses it.
+func cycleThrough(ctx context.Context, store IssueStore, issueID, blockerID string, resolver *StoreResolver) []string {
+	blockers := openBlockers(ctx, store, resolver)
+	edges := func(id string) []string {
+		if id == issueID {
+			return append([]string{blockerID}, blockers(id)...)
+		}
+		return blockers(id)
+	}
+	for _, c := range findCycles([]string{issueID}, edges) {
+		for i, id := range c {
+			if id == issueID && c[(i+1)%len(c)] == blockerID {
+				return c
+			}
+		}
+	}
+	return nil
+}
+
+// FindConvoyCycles returns the blocking cycles among a convoy's unclosed
+// members. See FindBlockingCycles.
+func FindConvoyCycles(ctx context.Context, store IssueStore, townRoot, convoyID string, resolver *StoreResolver) [][]string {
+	var ids []string
+	for _, t := range getConvoyTrackedIssues(ctx, store, convoyID, townRoot, resolver) {
+		if t.Status != "closed" && t.Status != "tombstone" {
+			ids = append(ids, t.ID)
+		}
+	}
+	return FindBlockingCycles(ctx, store, ids, resolver)
+}
+
+// FormatCycle renders a cycle as "a → b → c → a".
+func FormatCycle(cycle []string) string {
+	if len(cycle) == 0 {
+		return ""
+	}
+	return strings.Join(append(append([]string(nil), cycle...), cycle[0]), " → ")
+}
+
+// findCycles runs a depth-first search from each root over edges and
+// returns the distinct cycles it finds, rotated to start at their lowest ID
+// and sorted.
+func findCycles(roots []string, edges func(string) []string) [][]string {
+	const (
+		unvisited = iota
+		onPath
+		done
+	)
+	state := make(map[string]int)
+	var path []string
+	seen := make(map[string]bool)
+	var cycles [][]string
+
+	var visit func(id string)
+	visit = func(id string) {
+		if len(state) >= maxCycleSearch {
+			return
+		}
+		state[id] = onPath
+		path = append(path, id)
+		for _, next := range edges(id) {
+			switch state[next] {
+			case onPath:
+				start := len(path) - 1
+				for path[start] != next {
+					start--
+				}
+				c := rotateCycle(path[start:])
+				if key := strings.Join(c, " "); !seen[key] {
+					seen[key] = true
+					cycles = append(cycles, c)
+				}
+			case unvisited:
+				visit(next)
+			}
+		}
+		path = path[:len(path)-1]
+		state[id] = done
+	}
+	for _, id := range roots {
+		if state[id] == unvisited {
+			visit(id)
+		}
+	}
+
+	sort.Slice(cycles, func(i, j int) bool { return strings.Join(cycles[i], " ") < strings.Join(cycles[j], " ") })
+	return cycles
+}
+
+// rotateCycle returns a copy of cycle starting at its lowest ID.
+func rotateCycle(cycle []string) []string {
+	low := 0
+	for i, id := range cycle {
+		if id < cycle[low] {
+			low = i
+		}
+	}
+	return append(append([]string(nil), cycle[low:]...), cycle[:low]...)
+}
@@ -0,0 +1,88 @@
+package convoy
+
+import (
+	"context"
+	"reflect"
+	"strings"
+	"testing"
+
+	beadsdk "github.com/steveyegge/beads"
+)
+
+func TestFindBlockingCycles_ThreeNodeCycle(t *testing.T) {
+	store := newMemStore(
+		memIssue("test-a", beadsdk.StatusOpen, ""),
+		memIssue("test-b", beadsdk.StatusOpen, ""),
+		memIssue("test-c", beadsdk.StatusOpen, ""),
+		memIssue("test-d", beadsdk.StatusOpen, ""),
+	)
+	store.addDep("test-b", "test-a", "blocks")
+	store.addDep("test-c", "test-b", "blocks")
+	store.addDep("test-a", "test-c", "blocks")
+	store.addDep("test-d", "test-a", "blocks") // blocked by the cycle, not part of it
+
+	got := FindBlockingCycles(context.Background(), store, []string{"test-d", "test-c"}, nil)
+	want := [][]string{{"test-a", "test-c", "test-b"}}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("FindBlockingCycles() = %v, want %v", got, want)
+	}
+	if s := FormatCycle(got[0]); s != "test-a → test-c → test-b → test-a" {
+		t.Errorf("FormatCycle() = %q", s)
+	}
+
+	// Closing one issue breaks the cycle.
+	store.setStatus("test-b", beadsdk.StatusClosed)
+	if got := FindBlockingCycles(context.Background(), store, []string{"test-a", "test-c"}, nil); len(got) != 0 {
+		t.Errorf("after closing test-b, cycles = %v, want none", got)
+	}
+}
+
+func TestFindBlockingCycles_SelfLoop(t *testing.T) {
+	store := newMemStore(
+		memIssue("test-a", beadsdk.StatusOpen, ""),
+		memIssue("test-b", beadsdk.StatusOpen, ""),
+	)
+	store.addDep("test-a", "test-a", "blocks")
+	store.addDep("test-b", "test-a", "related") // not a blocking type
+
+	got := FindBlockingCycles(context.Background(), store, []string{"test-a", "test-b"}, nil)
+	if want := [][]string{{"test-a"}}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("FindBlockingCycles() = %v, want %v", got, want)
+	}
+	if s := FormatCycle(got[0]); s != "test-a → test-a" {
+		t.Errorf("FormatCycle() = %q", s)
+	}
+}
+
+func TestMemStore_FeedConvoyReportsCycle(t *testing.T) {
+	store := newMemStore(
+		memIssue("test-convoy", beadsdk.StatusOpen, ""),
+		memIssue("test-a", beadsdk.StatusOpen, ""),
+		memIssue("test-b", beadsdk.StatusOpen, ""),
+		memIssue("test-c", beadsdk.StatusOpen, ""),
+	)
+	for _, id := range []string{"test-a", "test-b", "test-c"} {
+		store.addDep("test-convoy", id, "tracks")
+	}
+	store.addDep("test-a", "test-b", "blocks")
+	store.addDep("test-b", "test-c", "blocks")
+	store.addDep("test-c", "test-a", "blocks")
+
+	townRoot := setupTownRoot(t)
+	gtPath, _ := makeGTStub(t, 0)
+	logger, logs := makeLogger()
+
+	result := FeedConvoy(context.Background(), store, townRoot, "test-convoy", "test", logger, gtPath, nil, FeedOptions{Strategy: FeedFIFO}, nil)
+	if result.IssueID != "" {
+		t.Fatalf("FeedConvoy() dispatched %s from a fully cyclic convoy", result.IssueID)
+	}
+	if want := [][]string{{"test-a", "test-b", "test-c"}}; !reflect.DeepEqual(result.Cycles, want) {
+		t.Errorf("Cycles = %v, want %v", result.Cycles, want)
+	}
+	if got := FindConvoyCycles(context.Background(), store, townRoot, "test-convoy", nil); !reflect.DeepEqual(got, result.Cycles) {
+		t.Errorf("FindConvoyCycles() = %v, want %v", got, result.Cycles)
+	}
+	if !strings.Contains(strings.Join(*logs, "\n"), "dependency cycle test-a → test-b → test-c → test-a") {
+		t.Errorf("logs = %v, want the cycle named", *logs)
+	}
+}
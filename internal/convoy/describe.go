@@ -0,0 +1,112 @@
+package convoy
+
+import (
+	"context"
+	"fmt"
+	"sort"
+)
+
+// IssueDetail is a single issue with its links resolved, for gt issue show.
+type IssueDetail struct {
+	ID       string `json:"id"`
+	Provider string `json:"provider,omitempty"` // from an external: reference, if one was given
+	Title    string `json:"title"`
+	Type     string `json:"type"`
+	Status   string `json:"status"`
+	Assignee string `json:"assignee,omitempty"`
+	Priority int    `json:"priority"`
+
+	// Blockers are the issue's blocking dependencies with their current
+	// status. Open is true for those still holding the issue back.
+	Blockers []Blocker `json:"blockers"`
+
+	// Convoys lists the convoys that track the issue.
+	Convoys []string `json:"convoys"`
+}
+
+// Blocker is one blocking dependency of an issue.
+type Blocker struct {
+	ID       string `json:"id"`
+	Provider string `json:"provider,omitempty"` // see IssueRef
+	Type     string `json:"type"`               // dependency type, e.g. "blocks"
+	Status   string `json:"status"`
+	Open     bool   `json:"open"`
+}
+
+// Blocked reports whether any blocker is still open.
+func (d *IssueDetail) Blocked() bool {
+	for _, b := range d.Blockers {
+		if b.Open {
+			return true
+		}
+	}
+	return false
+}
+
+// DescribeIssue loads an issue from its home store and resolves its blockers
+// to their current statuses. Tracking convoys are looked up in town, the
+// store convoys live in (usually hq); town may be the same store as home.
+func DescribeIssue(ctx context.Context, home, town IssueStore, issueID string) (*IssueDetail, error) {
+	ref := ParseIssueRef(issueID)
+	issueID = ref.ID
+	iss, err := home.GetIssue(ctx, issueID)
+	if err != nil {
+		return nil, fmt.Errorf("issue %s: %w", issueID, err)
+	}
+	if iss == nil {
+		return nil, fmt.Errorf("issue %s not found", issueID)
+	}
+
+	detail := &IssueDetail{
+		ID:       iss.ID,
+		Provider: ref.Provider,
+		Title:    iss.Title,
+		Type:     string(iss.IssueType),
+		Status:   string(iss.Status),
+		Assignee: iss.Assignee,
+		Priority: iss.Priority,
+		Blockers: make([]Blocker, 0),
+		Convoys:  getTrackingConvoys(ctx, town, issueID, nil),
+	}
+
+	deps, err := home.GetDependenciesWithMetadata(ctx, issueID)
+	if err != nil {
+		return nil, fmt.Errorf("dependencies of %s: %w", issueID, err)
+	}
+	var ids []string
+	for _, d := range deps {
+		if !blockingDepTypes[string(d.DependencyType)] {
+			continue
+		}
+		dep := ParseIssueRef(d.ID)
+		detail.Blockers = append(detail.Blockers, Blocker{
+			ID:       dep.ID,
+			Provider: dep.Provider,
+			Type:     string(d.DependencyType),
+			Status:   string(d.Status),
+		})
+		ids = append(ids, dep.ID)
+	}
+
+	// The dependency snapshot can lag for cross-rig blockers; prefer a fresh read.
+	if len(ids) > 0 {
+		if fresh, err := home.GetIssuesByIDs(ctx, ids); err == nil {
+			byID := make(map[string]string, len(fresh))
+			for _, f := range fresh {
+				byID[f.ID] = string(f.Status)
+			}
+			for i := range detail.Blockers {
+				if s, ok := byID[detail.Blockers[i].ID]; ok {
+					detail.Blockers[i].Status = s
+				}
+			}
+		}
+	}
+	for i := range detail.Blockers {
+		s := detail.Blockers[i].Status
+		detail.Blockers[i].Open = s != "closed" && s != "tombstone"
+	}
+	sort.Slice(detail.Blockers, func(i, j int) bool { return detail.Blockers[i].ID < detail.Blockers[j].ID })
+	sort.Strings(detail.Convoys)
+	return detail, nil
+}
@@ -0,0 +1,59 @@
+package convoy
+
+import (
+	"context"
+	"testing"
+
+	beadsdk "github.com/steveyegge/beads"
+)
+
+func TestDescribeIssue(t *testing.T) {
+	store := newMemStore(
+		memIssue("test-convoy", beadsdk.StatusOpen, ""),
+		memIssue("test-issue", beadsdk.StatusOpen, "testrig/polecats/alpha"),
+		memIssue("test-done", beadsdk.StatusClosed, ""),
+		memIssue("test-open", beadsdk.StatusInProgress, ""),
+		memIssue("test-parent", beadsdk.StatusOpen, ""),
+		// A blocker tracked elsewhere, as the dependency snapshot records it.
+		memIssue("external:gh:test-remote", beadsdk.StatusOpen, ""),
+	)
+	store.addDep("test-convoy", "test-issue", "tracks")
+	store.addDep("test-issue", "test-open", "blocks")
+	store.addDep("test-issue", "test-done", "blocks")
+	store.addDep("test-issue", "test-parent", "parent-child")
+	store.addDep("test-issue", "external:gh:test-remote", "blocks")
+
+	detail, err := DescribeIssue(context.Background(), store, store, "external:test:test-issue")
+	if err != nil {
+		t.Fatalf("DescribeIssue: %v", err)
+	}
+	if detail.ID != "test-issue" || detail.Provider != "test" || detail.Assignee != "testrig/polecats/alpha" {
+		t.Errorf("detail = %+v", detail)
+	}
+	if len(detail.Convoys) != 1 || detail.Convoys[0] != "test-convoy" {
+		t.Errorf("Convoys = %v, want [test-convoy]", detail.Convoys)
+	}
+	want := []Blocker{
+		{ID: "test-done", Type: "blocks", Status: "closed", Open: false},
+		{ID: "test-open", Type: "blocks", Status: "in_progress", Open: true},
+		{ID: "test-remote", Provider: "gh", Type: "blocks", Status: "open", Open: true},
+	}
+	if len(detail.Blockers) != len(want) {
+		t.Fatalf("Blockers = %+v, want %+v", detail.Blockers, want)
+	}
+	for i := range want {
+		if detail.Blockers[i] != want[i] {
+			t.Errorf("Blockers[%d] = %+v, want %+v", i, detail.Blockers[i], want[i])
+		}
+	}
+	if !detail.Blocked() {
+		t.Error("Blocked() = false, want true")
+	}
+}
+
+func TestDescribeIssue_NotFound(t *testing.T) {
+	store := newMemStore()
+	if _, err := DescribeIssue(context.Background(), store, store, "test-missing"); err == nil {
+		t.Error("DescribeIssue() for a missing issue: want error")
+	}
+}
@@ -0,0 +1,192 @@
+package convoy
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/steveyegge/gastown/internal/config"
+)
+
+// EventType names a convoy transition.
+type EventType string
+
+const (
+	// EventIssueDispatched: a ready issue was slung to a rig.
+	EventIssueDispatched EventType = "issue_dispatched"
+	// EventIssueCompleted: a tracked issue closed and its convoy was checked.
+	EventIssueCompleted EventType = "issue_completed"
+	// EventConvoyAdvanced: a close fed the convoy's next ready issue.
+	EventConvoyAdvanced EventType = "convoy_advanced"
+	// EventConvoyCompleted: the completion check closed the convoy.
+	EventConvoyCompleted EventType = "convoy_completed"
+	// EventCapacityThrottled: ready issues were left open because every
+	// rig they route to is at convoy.max_per_rig.
+	EventCapacityThrottled EventType = "capacity_throttled"
+	// EventIssueTimedOut: an issue stayed in progress past its
+	// convoy.timeouts limit; Action records what the watchdog did.
+	EventIssueTimedOut EventType = "issue_timed_out"
+	// EventIssueHandled: the feed acted on a non-slingable message,
+	// decision, or event issue and closed it; Action is "delivered" or
+	// "recorded".
+	EventIssueHandled EventType = "issue_handled"
+	// EventIssueSkipped: a feed passed over an open, unassigned issue;
+	// Reason says why. Skips are reported only to FeedOptions.Decisions,
+	// never to the event sink, since every poll would repeat them.
+	EventIssueSkipped EventType = "issue_skipped"
+)
+
+// Event is a structured convoy transition for external tools.
+type Event struct {
+	Time     time.Time `json:"ts"`
+	Type     EventType `json:"type"`
+	ConvoyID string    `json:"convoy_id"`
+	IssueID  string    `json:"issue_id,omitempty"`
+	Rig      string    `json:"rig,omitempty"`
+	Assignee string    `json:"assignee,omitempty"` // forced sling target (gt convoy feed --assignee)
+	Caller   string    `json:"caller,omitempty"`   // e.g. "daemon", "gt close"
+	Ready    int       `json:"ready,omitempty"`    // ready issues left open (capacity_throttled)
+	Action   string    `json:"action,omitempty"`   // action taken (issue_timed_out, issue_handled)
+	Reason   string    `json:"reason,omitempty"`   // why an issue was passed over (issue_skipped)
+	DryRun   bool      `json:"dry_run,omitempty"`
+}
+
+// EventSink receives convoy events. Emit is called synchronously on the
+// feeding path, so implementations should be quick and must not panic;
+// delivery failures are the sink's to handle.
+type EventSink interface {
+	Emit(Event)
+}
+
+// NopSink discards events. It is the default sink.
+type NopSink struct{}
+
+// Emit implements EventSink.
+func (NopSink) Emit(Event) {}
+
+// JSONLSink appends each event as a JSON line to a file. The file is opened
+// per event so several gt processes (daemon, gt close) can share it.
+type JSONLSink struct {
+	mu   sync.Mutex
+	path string
+}
+
+// NewJSONLSink returns a sink appending to path, creating it if needed.
+func NewJSONLSink(path string) *JSONLSink {
+	return &JSONLSink{path: path}
+}
+
+// Emit implements EventSink. Write errors are dropped: events are
+// best-effort and must never block convoy feeding.
+func (s *JSONLSink) Emit(e Event) {
+	data, err := json.Marshal(e)
+	if err != nil {
+		return
+	}
+	data = append(data, '\n')
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	f, err := os.OpenFile(s.path, os.O_WRONLY|os.O_CREATE|os.O_APPEND, 0644)
+	if err != nil {
+		return
+	}
+	defer f.Close()
+	_, _ = f.Write(data)
+}
+
+// MultiSink fans each event out to several sinks in order.
+type MultiSink []EventSink
+
+// Emit implements EventSink.
+func (m MultiSink) Emit(e Event) {
+	for _, s := range m {
+		s.Emit(e)
+	}
+}
+
+// Close closes every sink that supports it, giving each up to timeout.
+func (m MultiSink) Close(timeout time.Duration) {
+	for _, s := range m {
+		if c, ok := s.(interface{ Close(time.Duration) }); ok {
+			c.Close(timeout)
+		}
+	}
+}
+
+// EventSinkFor returns the sinks configured by convoy.event_log and
+// convoy.webhook, or NopSink when neither is set. A relative event_log
+// path is resolved against townRoot. logger receives webhook warnings.
+func EventSinkFor(townRoot string, cfg config.ConvoyConfig, logger func(format string, args ...interface{})) EventSink {
+	var sinks MultiSink
+	if cfg.EventLog != "" {
+		path := cfg.EventLog
+		if !filepath.IsAbs(path) {
+			path = filepath.Join(townRoot, path)
+		}
+		sinks = append(sinks, NewJSONLSink(path))
+	}
+	if cfg.Webhook != nil && cfg.Webhook.URL != "" {
+		sinks = append(sinks, NewWebhookSink(*cfg.Webhook, logger))
+	}
+	switch len(sinks) {
+	case 0:
+		return NopSink{}
+	case 1:
+		return sinks[0]
+	}
+	return sinks
+}
+
+// eventSink holds the active sink; see SetEventSink.
+var eventSink atomic.Value // of sinkHolder
+
+// sinkHolder gives atomic.Value one concrete type across sink implementations.
+type sinkHolder struct{ EventSink }
+
+// SetEventSink installs the sink convoy operations emit to. Passing nil
+// restores NopSink. Called once at startup from town settings; safe for
+// concurrent use.
+func SetEventSink(s EventSink) {
+	if s == nil {
+		s = NopSink{}
+	}
+	eventSink.Store(sinkHolder{s})
+}
+
+// CloseEventSink flushes the active sink if it buffers events (such as
+// WebhookSink), waiting up to timeout. Call it once before the process exits.
+func CloseEventSink(timeout time.Duration) {
+	h, ok := eventSink.Load().(sinkHolder)
+	if !ok {
+		return
+	}
+	if c, ok := h.EventSink.(interface{ Close(time.Duration) }); ok {
+		c.Close(timeout)
+	}
+}
+
+// decide reports a feed decision to decisions, if set, and with logged to the
+// active sink as well. Events are stamped like emit's.
+func decide(decisions EventSink, e Event, logged bool) {
+	if logged {
+		emit(e)
+	}
+	if decisions != nil {
+		e.Time = time.Now().UTC()
+		decisions.Emit(e)
+	}
+}
+
+// emit stamps e with the current time and sends it to the active sink.
+func emit(e Event) {
+	h, ok := eventSink.Load().(sinkHolder)
+	if !ok {
+		return
+	}
+	e.Time = time.Now().UTC()
+	h.Emit(e)
+}
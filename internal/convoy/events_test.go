@@ -0,0 +1,179 @@
+package convoy
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"reflect"
+	"sync"
+	"testing"
+
+	beadsdk "github.com/steveyegge/beads"
+	"github.com/steveyegge/gastown/internal/config"
+)
+
+// recordingSink collects emitted events for assertions.
+type recordingSink struct {
+	mu     sync.Mutex
+	events []Event
+}
+
+func (r *recordingSink) Emit(e Event) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.events = append(r.events, e)
+}
+
+func (r *recordingSink) types() []EventType {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	var out []EventType
+	for _, e := range r.events {
+		out = append(out, e.Type)
+	}
+	return out
+}
+
+func useRecordingSink(t *testing.T) *recordingSink {
+	t.Helper()
+	sink := &recordingSink{}
+	SetEventSink(sink)
+	t.Cleanup(func() { SetEventSink(nil) })
+	return sink
+}
+
+func TestEvents_CloseFeedsNext(t *testing.T) {
+	sink := useRecordingSink(t)
+	store := newMemStore(
+		memIssue("test-convoy", beadsdk.StatusOpen, ""),
+		memIssue("test-done", beadsdk.StatusClosed, "testrig/polecats/alpha"),
+		memIssue("test-next", beadsdk.StatusOpen, ""),
+	)
+	store.addDep("test-convoy", "test-done", "tracks")
+	store.addDep("test-convoy", "test-next", "tracks")
+
+	townRoot := setupTownRoot(t)
+	gtPath, _ := makeGTStub(t, 0)
+
+	CheckConvoysForIssue(context.Background(), store, townRoot, "test-done", "test", nil, gtPath, nil)
+
+	want := []EventType{EventIssueCompleted, EventIssueDispatched, EventConvoyAdvanced}
+	if got := sink.types(); !reflect.DeepEqual(got, want) {
+		t.Fatalf("event types = %v, want %v", got, want)
+	}
+	dispatched := sink.events[1]
+	if dispatched.ConvoyID != "test-convoy" || dispatched.IssueID != "test-next" || dispatched.Rig != "testrig" || dispatched.Caller != "test" {
+		t.Errorf("dispatched event = %+v", dispatched)
+	}
+	if dispatched.Time.IsZero() {
+		t.Error("event time not set")
+	}
+}
+
+func TestEvents_CapacityThrottled(t *testing.T) {
+	sink := useRecordingSink(t)
+	store := newMemStore(
+		memIssue("test-convoy", beadsdk.StatusOpen, ""),
+		memIssue("test-busy", beadsdk.StatusInProgress, "testrig/polecats/bravo"),
+		memIssue("test-next", beadsdk.StatusOpen, ""),
+	)
+	store.addDep("test-convoy", "test-busy", "tracks")
+	store.addDep("test-convoy", "test-next", "tracks")
+
+	townRoot := setupTownRoot(t)
+	gtPath, _ := makeGTStub(t, 0)
+
+	FeedConvoy(context.Background(), store, townRoot, "test-convoy", "test", nil, gtPath, nil, FeedOptions{MaxPerRig: 1}, nil)
+
+	if got, want := sink.types(), []EventType{EventCapacityThrottled}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("event types = %v, want %v", got, want)
+	}
+	if sink.events[0].Ready != 1 {
+		t.Errorf("Ready = %d, want 1", sink.events[0].Ready)
+	}
+}
+
+func TestEvents_DryRunNotEmitted(t *testing.T) {
+	sink := useRecordingSink(t)
+	store := newMemStore(
+		memIssue("test-convoy", beadsdk.StatusOpen, ""),
+		memIssue("test-next", beadsdk.StatusOpen, ""),
+	)
+	store.addDep("test-convoy", "test-next", "tracks")
+
+	FeedConvoy(context.Background(), store, setupTownRoot(t), "test-convoy", "test", nil, "", nil, FeedOptions{DryRun: true}, nil)
+	if got := sink.types(); len(got) != 0 {
+		t.Errorf("event types = %v, want none for a dry run", got)
+	}
+}
+
+func TestEvents_FeedDecisions(t *testing.T) {
+	sink := useRecordingSink(t)
+	store := newMemStore(
+		memIssue("test-convoy", beadsdk.StatusOpen, ""),
+		memIssue("test-blocker", beadsdk.StatusInProgress, "testrig/polecats/alpha"),
+		memIssue("test-blocked", beadsdk.StatusOpen, ""),
+		memIssue("test-next", beadsdk.StatusOpen, ""),
+	)
+	store.addDep("test-convoy", "test-blocked", "tracks")
+	store.addDep("test-convoy", "test-next", "tracks")
+	store.addDep("test-blocked", "test-blocker", "blocks")
+
+	decisions := &recordingSink{}
+	FeedConvoy(context.Background(), store, setupTownRoot(t), "test-convoy", "test", nil, "", nil, FeedOptions{DryRun: true, Strategy: FeedFIFO, Decisions: decisions}, nil)
+
+	want := []EventType{EventIssueSkipped, EventIssueDispatched}
+	if got := decisions.types(); !reflect.DeepEqual(got, want) {
+		t.Fatalf("decision types = %v, want %v", got, want)
+	}
+	if skip := decisions.events[0]; skip.IssueID != "test-blocked" || skip.Reason != "blocked by open dependencies" || !skip.DryRun {
+		t.Errorf("skip decision = %+v", skip)
+	}
+	if pick := decisions.events[1]; pick.IssueID != "test-next" || pick.Rig != "testrig" || !pick.DryRun || pick.Time.IsZero() {
+		t.Errorf("dispatch decision = %+v", pick)
+	}
+	if got := sink.types(); len(got) != 0 {
+		t.Errorf("event types = %v, want dry-run decisions kept off the event sink", got)
+	}
+}
+
+func TestJSONLSink(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "convoy-events.jsonl")
+	sink := NewJSONLSink(path)
+	sink.Emit(Event{Type: EventIssueDispatched, ConvoyID: "hq-cv-1", IssueID: "gt-1", Rig: "gastown"})
+	sink.Emit(Event{Type: EventConvoyCompleted, ConvoyID: "hq-cv-1"})
+
+	f, err := os.Open(path)
+	if err != nil {
+		t.Fatalf("open: %v", err)
+	}
+	defer f.Close()
+	var got []Event
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		var e Event
+		if err := json.Unmarshal(scanner.Bytes(), &e); err != nil {
+			t.Fatalf("line %q: %v", scanner.Text(), err)
+		}
+		got = append(got, e)
+	}
+	if len(got) != 2 || got[0].IssueID != "gt-1" || got[1].Type != EventConvoyCompleted {
+		t.Errorf("events = %+v", got)
+	}
+}
+
+func TestEventSinkFor(t *testing.T) {
+	if _, ok := EventSinkFor("/town", config.ConvoyConfig{}, nil).(NopSink); !ok {
+		t.Error("EventSinkFor() without event_log should be NopSink")
+	}
+	sink, ok := EventSinkFor("/town", config.ConvoyConfig{EventLog: "logs/convoy.jsonl"}, nil).(*JSONLSink)
+	if !ok || sink.path != filepath.Join("/town", "logs/convoy.jsonl") {
+		t.Errorf("EventSinkFor(relative) = %+v, want JSONLSink under town root", sink)
+	}
+	sink, ok = EventSinkFor("/town", config.ConvoyConfig{EventLog: "/var/log/convoy.jsonl"}, nil).(*JSONLSink)
+	if !ok || sink.path != "/var/log/convoy.jsonl" {
+		t.Errorf("EventSinkFor(absolute) = %+v", sink)
+	}
+}
@@ -0,0 +1,52 @@
+package convoy
+
+import (
+	"net/url"
+	"path"
+	"strings"
+)
+
+// IssueRef is an issue reference split into the tracker it came from and
+// the bare bead ID. bd dep add records cross-rig and third-party issues as
+// "external:provider:id".
+type IssueRef struct {
+	Provider string `json:"provider,omitempty"` // "" for a plain bead ID
+	ID       string `json:"id"`
+	Raw      string `json:"raw"` // the reference as written
+}
+
+// ParseIssueRef parses an issue reference. The provider is the outermost
+// external: wrapper's (external:gh:external:gt:gt-abc is from gh), and
+// nested wrappers collapse to the innermost ID. A tracker URL
+// (https://tracker/issues/gt-abc/) reduces to its last path segment, with
+// the URL's host as the provider unless a wrapper named one. Malformed
+// input is returned as the ID, with no provider.
+func ParseIssueRef(raw string) IssueRef {
+	ref := IssueRef{ID: raw, Raw: raw}
+	for strings.HasPrefix(ref.ID, "external:") {
+		parts := strings.SplitN(ref.ID, ":", 3)
+		if len(parts) != 3 {
+			break
+		}
+		if ref.Provider == "" {
+			ref.Provider = parts[1]
+		}
+		ref.ID = parts[2]
+	}
+	if strings.HasPrefix(ref.ID, "https://") || strings.HasPrefix(ref.ID, "http://") {
+		if u, err := url.Parse(ref.ID); err == nil {
+			if last := path.Base(strings.TrimRight(u.Path, "/")); last != "." && last != "/" {
+				if ref.Provider == "" {
+					ref.Provider = u.Host
+				}
+				ref.ID = last
+			}
+		}
+	}
+	return ref
+}
+
+// String returns the reference as written.
+func (r IssueRef) String() string {
+	return r.Raw
+}
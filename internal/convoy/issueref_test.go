@@ -0,0 +1,34 @@
+package convoy
+
+import "testing"
+
+func TestParseIssueRef(t *testing.T) {
+	tests := []struct {
+		raw  string
+		want IssueRef
+	}{
+		{"gt-abc", IssueRef{ID: "gt-abc"}},
+		{"external:gh:gt-abc", IssueRef{Provider: "gh", ID: "gt-abc"}},
+		{"external:gh:external:gt:gt-abc", IssueRef{Provider: "gh", ID: "gt-abc"}},
+		{"external:gh:https://github.com/o/r/issues/42", IssueRef{Provider: "gh", ID: "42"}},
+		{"https://tracker.example.com/issues/gt-abc/", IssueRef{Provider: "tracker.example.com", ID: "gt-abc"}},
+		{"https://tracker.example.com/", IssueRef{ID: "https://tracker.example.com/"}},
+		{"external:", IssueRef{ID: "external:"}},
+		{"", IssueRef{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.raw, func(t *testing.T) {
+			tt.want.Raw = tt.raw
+			got := ParseIssueRef(tt.raw)
+			if got != tt.want {
+				t.Errorf("ParseIssueRef(%q) = %+v, want %+v", tt.raw, got, tt.want)
+			}
+			if got.String() != tt.raw {
+				t.Errorf("String() = %q, want %q", got.String(), tt.raw)
+			}
+			if id := extractIssueID(tt.raw); id != got.ID {
+				t.Errorf("extractIssueID(%q) = %q, want %q", tt.raw, id, got.ID)
+			}
+		})
+	}
+}
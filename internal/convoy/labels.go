@@ -0,0 +1,43 @@
+package convoy
+
+import (
+	"fmt"
+	"slices"
+)
+
+// LabelSelector narrows feeding to issues by label, so a feeder process can
+// specialize (e.g. only "frontend" work, or everything but "urgent"). The
+// zero value matches every issue.
+type LabelSelector struct {
+	// Include lists labels an issue must all carry to be fed.
+	Include []string
+	// Exclude lists labels that keep an issue from being fed.
+	Exclude []string
+}
+
+// Empty reports whether the selector matches every issue.
+func (s LabelSelector) Empty() bool {
+	return len(s.Include) == 0 && len(s.Exclude) == 0
+}
+
+// Matches reports whether an issue with these labels passes the selector.
+func (s LabelSelector) Matches(labels []string) bool {
+	return s.mismatch(labels) == ""
+}
+
+// mismatch returns why labels fail the selector, or "" if they pass.
+// Exclusions are checked first so the reason names the label that vetoed
+// the issue.
+func (s LabelSelector) mismatch(labels []string) string {
+	for _, l := range s.Exclude {
+		if slices.Contains(labels, l) {
+			return fmt.Sprintf("excluded label %q", l)
+		}
+	}
+	for _, l := range s.Include {
+		if !slices.Contains(labels, l) {
+			return fmt.Sprintf("missing label %q", l)
+		}
+	}
+	return ""
+}
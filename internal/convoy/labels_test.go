@@ -0,0 +1,31 @@
+package convoy
+
+import "testing"
+
+func TestLabelSelector(t *testing.T) {
+	tests := []struct {
+		name   string
+		sel    LabelSelector
+		labels []string
+		want   string
+	}{
+		{"empty selector matches unlabeled", LabelSelector{}, nil, ""},
+		{"include present", LabelSelector{Include: []string{"frontend"}}, []string{"frontend", "urgent"}, ""},
+		{"include missing", LabelSelector{Include: []string{"frontend"}}, []string{"backend"}, `missing label "frontend"`},
+		{"include needs all", LabelSelector{Include: []string{"frontend", "urgent"}}, []string{"frontend"}, `missing label "urgent"`},
+		{"exclude absent", LabelSelector{Exclude: []string{"urgent"}}, []string{"frontend"}, ""},
+		{"exclude present", LabelSelector{Exclude: []string{"urgent"}}, []string{"urgent"}, `excluded label "urgent"`},
+		{"exclude wins over include", LabelSelector{Include: []string{"frontend"}, Exclude: []string{"urgent"}}, []string{"frontend", "urgent"}, `excluded label "urgent"`},
+		{"labels are case-sensitive", LabelSelector{Include: []string{"Frontend"}}, []string{"frontend"}, `missing label "Frontend"`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.sel.mismatch(tt.labels); got != tt.want {
+				t.Errorf("mismatch(%v) = %q, want %q", tt.labels, got, tt.want)
+			}
+			if got := tt.sel.Matches(tt.labels); got != (tt.want == "") {
+				t.Errorf("Matches(%v) = %v, want %v", tt.labels, got, tt.want == "")
+			}
+		})
+	}
+}
@@ -0,0 +1,21 @@
+package convoy
+
+import "github.com/steveyegge/gastown/internal/gtlog"
+
+// leveledLogger splits a caller's logger by gtlog level. Every convoy entry
+// point still takes a plain printf-style logger; each message goes through
+// the level that fits it, so per-issue skip reasons only show at debug while
+// dispatches, failures, and cycles reach the caller at the default level.
+type leveledLogger struct {
+	debugf, infof, warnf, errorf gtlog.Logger
+}
+
+// levels wraps logger for leveled use. A nil logger discards everything.
+func levels(logger gtlog.Logger) leveledLogger {
+	return leveledLogger{
+		debugf: gtlog.Filter(logger, gtlog.Debug),
+		infof:  gtlog.Filter(logger, gtlog.Info),
+		warnf:  gtlog.Filter(logger, gtlog.Warn),
+		errorf: gtlog.Filter(logger, gtlog.Error),
+	}
+}
@@ -0,0 +1,820 @@
+package convoy
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"runtime"
+	"slices"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	beadsdk "github.com/steveyegge/beads"
+	"github.com/steveyegge/gastown/internal/config"
+)
+
+// memStore is an in-memory IssueStore for tests that exercise convoy logic
+// without a Dolt database.
+type memStore struct {
+	mu     sync.Mutex
+	issues map[string]*beadsdk.Issue
+	deps   []*beadsdk.Dependency
+}
+
+func newMemStore(issues ...*beadsdk.Issue) *memStore {
+	s := &memStore{issues: make(map[string]*beadsdk.Issue)}
+	for _, iss := range issues {
+		s.issues[iss.ID] = iss
+	}
+	return s
+}
+
+// addDep records that issueID depends on dependsOnID with the given type
+// (e.g. "tracks" from a convoy, "blocks" between issues).
+func (s *memStore) addDep(issueID, dependsOnID, depType string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.deps = append(s.deps, &beadsdk.Dependency{
+		IssueID:     issueID,
+		DependsOnID: dependsOnID,
+		Type:        beadsdk.DependencyType(depType),
+	})
+}
+
+func (s *memStore) setStatus(id string, status beadsdk.Status) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.issues[id].Status = status
+}
+
+func (s *memStore) GetIssue(_ context.Context, id string) (*beadsdk.Issue, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	iss, ok := s.issues[id]
+	if !ok {
+		return nil, fmt.Errorf("issue %s not found", id)
+	}
+	cp := *iss
+	return &cp, nil
+}
+
+// ClaimIssue mirrors the Dolt store: assign and start the issue only if it
+// is unassigned, succeeding idempotently for the same actor.
+func (s *memStore) ClaimIssue(_ context.Context, id, actor string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	iss, ok := s.issues[id]
+	if !ok {
+		return fmt.Errorf("failed to get issue for claim: issue %s not found", id)
+	}
+	if iss.Assignee != "" && iss.Assignee != actor {
+		return fmt.Errorf("%w by %s", ErrAlreadyClaimed, iss.Assignee)
+	}
+	iss.Assignee, iss.Status = actor, beadsdk.StatusInProgress
+	return nil
+}
+
+func (s *memStore) UpdateIssue(_ context.Context, id string, updates map[string]interface{}, _ string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	iss, ok := s.issues[id]
+	if !ok {
+		return fmt.Errorf("issue %s not found", id)
+	}
+	if v, ok := updates["status"].(string); ok {
+		iss.Status = beadsdk.Status(v)
+	}
+	if v, ok := updates["assignee"].(string); ok {
+		iss.Assignee = v
+	}
+	if v, ok := updates["title"].(string); ok {
+		iss.Title = v
+	}
+	if v, ok := updates["description"].(string); ok {
+		iss.Description = v
+	}
+	if v, ok := updates["priority"].(int); ok {
+		iss.Priority = v
+	}
+	if v, ok := updates["issue_type"].(string); ok {
+		iss.IssueType = beadsdk.IssueType(v)
+	}
+	return nil
+}
+
+func (s *memStore) AddLabel(_ context.Context, issueID, label, _ string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	iss, ok := s.issues[issueID]
+	if !ok {
+		return fmt.Errorf("issue %s not found", issueID)
+	}
+	if !slices.Contains(iss.Labels, label) {
+		iss.Labels = append(slices.Clone(iss.Labels), label)
+	}
+	return nil
+}
+
+func (s *memStore) RemoveLabel(_ context.Context, issueID, label, _ string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	iss, ok := s.issues[issueID]
+	if !ok {
+		return fmt.Errorf("issue %s not found", issueID)
+	}
+	iss.Labels = slices.DeleteFunc(slices.Clone(iss.Labels), func(l string) bool { return l == label })
+	if len(iss.Labels) == 0 {
+		iss.Labels = nil
+	}
+	return nil
+}
+
+func (s *memStore) CloseIssue(_ context.Context, id, _, _, _ string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	iss, ok := s.issues[id]
+	if !ok {
+		return fmt.Errorf("issue %s not found", id)
+	}
+	iss.Status, iss.Assignee = beadsdk.StatusClosed, ""
+	return nil
+}
+
+// CreateIssue stores a copy of issue, assigning a test- ID when it has none.
+func (s *memStore) CreateIssue(_ context.Context, issue *beadsdk.Issue, _ string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if issue.ID == "" {
+		issue.ID = fmt.Sprintf("test-new%d", len(s.issues)+1)
+	}
+	if _, exists := s.issues[issue.ID]; exists {
+		return fmt.Errorf("issue %s already exists", issue.ID)
+	}
+	if issue.Status == "" {
+		issue.Status = beadsdk.StatusOpen
+	}
+	cp := *issue
+	s.issues[issue.ID] = &cp
+	return nil
+}
+
+// SearchIssues returns copies of the issues matching filter's Statuses,
+// IDPrefix, IssueType, and Assignee, sorted by ID and cut to filter.Limit;
+// the query and other fields are ignored.
+func (s *memStore) SearchIssues(_ context.Context, _ string, filter beadsdk.IssueFilter) ([]*beadsdk.Issue, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	var out []*beadsdk.Issue
+	for _, iss := range s.issues {
+		if len(filter.Statuses) > 0 && !slices.Contains(filter.Statuses, iss.Status) {
+			continue
+		}
+		if !strings.HasPrefix(iss.ID, filter.IDPrefix) {
+			continue
+		}
+		if filter.IssueType != nil && iss.IssueType != *filter.IssueType {
+			continue
+		}
+		if filter.Assignee != nil && iss.Assignee != *filter.Assignee {
+			continue
+		}
+		cp := *iss
+		out = append(out, &cp)
+	}
+	slices.SortFunc(out, func(a, b *beadsdk.Issue) int { return strings.Compare(a.ID, b.ID) })
+	if filter.Limit > 0 && len(out) > filter.Limit {
+		out = out[:filter.Limit]
+	}
+	return out, nil
+}
+
+// DeleteIssue removes an issue and every dependency on or of it.
+func (s *memStore) DeleteIssue(_ context.Context, id string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if _, ok := s.issues[id]; !ok {
+		return fmt.Errorf("issue %s not found", id)
+	}
+	delete(s.issues, id)
+	s.deps = slices.DeleteFunc(s.deps, func(d *beadsdk.Dependency) bool { return d.IssueID == id || d.DependsOnID == id })
+	return nil
+}
+
+func (s *memStore) AddDependency(_ context.Context, dep *beadsdk.Dependency, _ string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	cp := *dep
+	s.deps = append(s.deps, &cp)
+	return nil
+}
+
+func (s *memStore) RemoveDependency(_ context.Context, issueID, dependsOnID, _ string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for i, d := range s.deps {
+		if d.IssueID == issueID && d.DependsOnID == dependsOnID {
+			s.deps = append(s.deps[:i], s.deps[i+1:]...)
+			return nil
+		}
+	}
+	return fmt.Errorf("no dependency of %s on %s", issueID, dependsOnID)
+}
+
+func (s *memStore) GetIssuesByIDs(_ context.Context, ids []string) ([]*beadsdk.Issue, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	var out []*beadsdk.Issue
+	for _, id := range ids {
+		if iss, ok := s.issues[id]; ok {
+			cp := *iss
+			out = append(out, &cp)
+		}
+	}
+	return out, nil
+}
+
+func (s *memStore) GetDependenciesWithMetadata(_ context.Context, issueID string) ([]*beadsdk.IssueWithDependencyMetadata, error) {
+	return s.related(issueID, func(d *beadsdk.Dependency) (string, string) { return d.IssueID, d.DependsOnID }), nil
+}
+
+func (s *memStore) GetDependentsWithMetadata(_ context.Context, issueID string) ([]*beadsdk.IssueWithDependencyMetadata, error) {
+	return s.related(issueID, func(d *beadsdk.Dependency) (string, string) { return d.DependsOnID, d.IssueID }), nil
+}
+
+// related returns the issues at the far end of every dependency whose near
+// end is issueID, as chosen by ends.
+func (s *memStore) related(issueID string, ends func(*beadsdk.Dependency) (near, far string)) []*beadsdk.IssueWithDependencyMetadata {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	var out []*beadsdk.IssueWithDependencyMetadata
+	for _, d := range s.deps {
+		near, far := ends(d)
+		if near != issueID {
+			continue
+		}
+		iss, ok := s.issues[far]
+		if !ok {
+			continue
+		}
+		out = append(out, &beadsdk.IssueWithDependencyMetadata{Issue: *iss, DependencyType: d.Type})
+	}
+	return out
+}
+
+func memIssue(id string, status beadsdk.Status, assignee string) *beadsdk.Issue {
+	return &beadsdk.Issue{ID: id, Title: id, Status: status, Assignee: assignee, Priority: 2, IssueType: beadsdk.TypeTask}
+}
+
+func readGTLog(t *testing.T, logPath string) string {
+	t.Helper()
+	data, err := os.ReadFile(logPath)
+	if err != nil && !os.IsNotExist(err) {
+		t.Fatalf("reading gt stub log: %v", err)
+	}
+	return string(data)
+}
+
+func TestMemStore_FeedConvoySkipsAssignedAndBlocked(t *testing.T) {
+	store := newMemStore(
+		memIssue("test-convoy", beadsdk.StatusOpen, ""),
+		memIssue("test-assigned", beadsdk.StatusInProgress, "testrig/polecats/alpha"),
+		memIssue("test-blocker", beadsdk.StatusOpen, "testrig/polecats/bravo"),
+		memIssue("test-blocked", beadsdk.StatusOpen, ""),
+		memIssue("test-ready", beadsdk.StatusOpen, ""),
+	)
+	for _, id := range []string{"test-assigned", "test-blocker", "test-blocked", "test-ready"} {
+		store.addDep("test-convoy", id, "tracks")
+	}
+	store.addDep("test-blocked", "test-blocker", "blocks")
+
+	townRoot := setupTownRoot(t)
+	gtPath, logPath := makeGTStub(t, 0)
+	logger, _ := makeLogger()
+
+	result := FeedConvoy(context.Background(), store, townRoot, "test-convoy", "test", logger, gtPath, nil, FeedOptions{Strategy: FeedFIFO}, nil)
+	if result.IssueID != "test-ready" || result.Rig != "testrig" {
+		t.Errorf("FeedConvoy() = %+v, want test-ready on testrig", result)
+	}
+	if len(result.Blocked) != 1 || result.Blocked[0] != "test-blocked" {
+		t.Errorf("Blocked = %v, want [test-blocked]", result.Blocked)
+	}
+	if log := readGTLog(t, logPath); !strings.Contains(log, "sling test-ready testrig") {
+		t.Errorf("gt stub log = %q, want sling of test-ready", log)
+	}
+}
+
+func TestMemStore_CheckConvoysForIssueFeedsNext(t *testing.T) {
+	store := newMemStore(
+		memIssue("test-convoy", beadsdk.StatusOpen, ""),
+		memIssue("test-done", beadsdk.StatusClosed, "testrig/polecats/alpha"),
+		memIssue("test-next", beadsdk.StatusOpen, ""),
+	)
+	store.addDep("test-convoy", "test-done", "tracks")
+	store.addDep("test-convoy", "test-next", "tracks")
+
+	townRoot := setupTownRoot(t)
+	gtPath, logPath := makeGTStub(t, 0)
+
+	result := CheckConvoysForIssue(context.Background(), store, townRoot, "test-done", "test", nil, gtPath, nil)
+	if len(result.ConvoyIDs) != 1 || result.ConvoyIDs[0] != "test-convoy" {
+		t.Fatalf("ConvoyIDs = %v, want [test-convoy]", result.ConvoyIDs)
+	}
+	if len(result.Fed) != 1 || result.Fed[0].IssueID != "test-next" {
+		t.Errorf("Fed = %+v, want test-next", result.Fed)
+	}
+	log := readGTLog(t, logPath)
+	if !strings.Contains(log, "convoy check test-convoy") || !strings.Contains(log, "sling test-next testrig") {
+		t.Errorf("gt stub log = %q, want convoy check then sling", log)
+	}
+}
+
+func TestMemStore_StagedConvoyNotFed(t *testing.T) {
+	store := newMemStore(
+		memIssue("test-convoy", beadsdk.Status("staged_ready"), ""),
+		memIssue("test-done", beadsdk.StatusClosed, ""),
+		memIssue("test-next", beadsdk.StatusOpen, ""),
+	)
+	store.addDep("test-convoy", "test-done", "tracks")
+	store.addDep("test-convoy", "test-next", "tracks")
+
+	townRoot := setupTownRoot(t)
+	gtPath, logPath := makeGTStub(t, 0)
+
+	result := CheckConvoysForIssue(context.Background(), store, townRoot, "test-done", "test", nil, gtPath, nil)
+	if len(result.Fed) != 0 {
+		t.Errorf("Fed = %+v, want nothing for a staged convoy", result.Fed)
+	}
+	if log := readGTLog(t, logPath); strings.Contains(log, "sling") {
+		t.Errorf("gt stub log = %q, staged convoy must not be slung", log)
+	}
+
+	// Launching the convoy lets the next close feed it.
+	store.setStatus("test-convoy", beadsdk.StatusOpen)
+	result = CheckConvoysForIssue(context.Background(), store, townRoot, "test-done", "test", nil, gtPath, nil)
+	if len(result.Fed) != 1 || result.Fed[0].IssueID != "test-next" {
+		t.Errorf("Fed after launch = %+v, want test-next", result.Fed)
+	}
+}
+
+func TestMemStore_CapacityLimitLeavesIssueOpen(t *testing.T) {
+	store := newMemStore(
+		memIssue("test-convoy", beadsdk.StatusOpen, ""),
+		memIssue("test-done", beadsdk.StatusClosed, "testrig/polecats/alpha"),
+		memIssue("test-busy", beadsdk.StatusInProgress, "testrig/polecats/bravo"),
+		memIssue("test-next", beadsdk.StatusOpen, ""),
+	)
+	for _, id := range []string{"test-done", "test-busy", "test-next"} {
+		store.addDep("test-convoy", id, "tracks")
+	}
+
+	townRoot := setupTownRoot(t)
+	settings := config.NewTownSettings()
+	settings.Convoy = &config.ConvoyConfig{MaxPerRig: 1}
+	if err := config.SaveTownSettings(config.TownSettingsPath(townRoot), settings); err != nil {
+		t.Fatalf("SaveTownSettings: %v", err)
+	}
+	gtPath, logPath := makeGTStub(t, 0)
+	logger, logs := makeLogger()
+
+	// A one-shot close check (as gt close runs it) honors convoy.max_per_rig.
+	result := CheckConvoysForIssue(context.Background(), store, townRoot, "test-done", "test", logger, gtPath, nil)
+	if len(result.Fed) != 0 {
+		t.Fatalf("Fed = %+v, want no dispatch at capacity", result.Fed)
+	}
+	if log := readGTLog(t, logPath); strings.Contains(log, "sling") {
+		t.Errorf("gt stub log = %q, test-next must not be slung to the busy rig", log)
+	}
+	if !strings.Contains(strings.Join(*logs, "\n"), "no capacity") {
+		t.Errorf("logs = %v, want a no capacity message", *logs)
+	}
+	if iss, _ := store.GetIssue(context.Background(), "test-next"); iss.Status != beadsdk.StatusOpen || iss.Assignee != "" {
+		t.Errorf("test-next = %s/%q, want open and unassigned", iss.Status, iss.Assignee)
+	}
+}
+
+func TestMemStore_ConcurrentFeedsDispatchOnce(t *testing.T) {
+	store := newMemStore(
+		memIssue("test-convoy", beadsdk.StatusOpen, ""),
+		memIssue("test-ready", beadsdk.StatusOpen, ""),
+	)
+	store.addDep("test-convoy", "test-ready", "tracks")
+
+	townRoot := setupTownRoot(t)
+	gtPath, logPath := makeGTStub(t, 0)
+	logger, _ := makeLogger()
+	var logMu sync.Mutex
+	safeLogger := func(format string, args ...interface{}) {
+		logMu.Lock()
+		defer logMu.Unlock()
+		logger(format, args...)
+	}
+
+	// Two feeders (e.g. a restarted watcher and gt close) race on one issue.
+	var wg sync.WaitGroup
+	results := make([]*FeedResult, 2)
+	for i := range results {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			results[i] = FeedConvoy(context.Background(), store, townRoot, "test-convoy", "test", safeLogger, gtPath, nil, FeedOptions{}, nil)
+		}(i)
+	}
+	wg.Wait()
+
+	if n := strings.Count(readGTLog(t, logPath), "sling test-ready"); n != 1 {
+		t.Errorf("test-ready slung %d times, want exactly once", n)
+	}
+	fed := 0
+	for _, r := range results {
+		if r.IssueID == "test-ready" {
+			fed++
+		}
+	}
+	if fed != 1 {
+		t.Errorf("results = %+v %+v, want one feeder to dispatch", results[0], results[1])
+	}
+	if iss, _ := store.GetIssue(context.Background(), "test-ready"); !IsDispatchClaim(iss.Assignee) {
+		t.Errorf("assignee = %q, want a dispatch claim", iss.Assignee)
+	}
+}
+
+func TestMemStore_AlreadyClaimedSkipped(t *testing.T) {
+	store := newMemStore(
+		memIssue("test-convoy", beadsdk.StatusOpen, ""),
+		memIssue("test-ready", beadsdk.StatusOpen, ""),
+	)
+	store.addDep("test-convoy", "test-ready", "tracks")
+
+	townRoot := setupTownRoot(t)
+	gtPath, logPath := makeGTStub(t, 0)
+	logger, logs := makeLogger()
+
+	// Another worker assigns the issue between the feeder's read and its claim.
+	claimer := &claimRacer{memStore: store, winner: "testrig/polecats/alpha"}
+	result := FeedConvoy(context.Background(), claimer, townRoot, "test-convoy", "test", logger, gtPath, nil, FeedOptions{}, nil)
+
+	if result.IssueID != "" {
+		t.Errorf("FeedConvoy() dispatched %s, want skip", result.IssueID)
+	}
+	if log := readGTLog(t, logPath); strings.Contains(log, "sling") {
+		t.Errorf("gt stub log = %q, want no sling", log)
+	}
+	if !strings.Contains(strings.Join(*logs, "\n"), "test-ready already claimed (issue already claimed by testrig/polecats/alpha)") {
+		t.Errorf("logs = %v, want an already claimed skip", *logs)
+	}
+}
+
+func TestMemStore_FailedDispatchReleasesClaim(t *testing.T) {
+	store := newMemStore(
+		memIssue("test-convoy", beadsdk.StatusOpen, ""),
+		memIssue("test-ready", beadsdk.StatusOpen, ""),
+	)
+	store.addDep("test-convoy", "test-ready", "tracks")
+
+	gtPath, _ := makeGTStub(t, 1)
+	FeedConvoy(context.Background(), store, setupTownRoot(t), "test-convoy", "test", nil, gtPath, nil, FeedOptions{}, nil)
+
+	if iss, _ := store.GetIssue(context.Background(), "test-ready"); iss.Status != beadsdk.StatusOpen || iss.Assignee != "" {
+		t.Errorf("test-ready = %s/%q after failed sling, want open and unassigned", iss.Status, iss.Assignee)
+	}
+}
+
+// claimRacer assigns the issue to winner just before the feeder's claim.
+type claimRacer struct {
+	*memStore
+	winner string
+}
+
+func (c *claimRacer) ClaimIssue(ctx context.Context, id, actor string) error {
+	_ = c.memStore.ClaimIssue(ctx, id, c.winner)
+	return c.memStore.ClaimIssue(ctx, id, actor)
+}
+
+func TestMemStore_FeedConvoyTypeRouteBeatsRoundRobin(t *testing.T) {
+	bug := memIssue("test-bug", beadsdk.StatusOpen, "")
+	bug.IssueType = beadsdk.TypeBug
+	store := newMemStore(
+		memIssue("test-convoy", beadsdk.StatusOpen, ""),
+		bug,
+		memIssue("test-task", beadsdk.StatusOpen, ""),
+	)
+	store.addDep("test-convoy", "test-bug", "tracks")
+	store.addDep("test-convoy", "test-task", "tracks")
+
+	townRoot := setupTownRoot(t)
+	gtPath, logPath := makeGTStub(t, 0)
+	logger, _ := makeLogger()
+	opts := FeedOptions{
+		Strategy:    FeedFIFO,
+		RigStrategy: RigRoundRobin,
+		Rotation:    &RigRotation{},
+		TypeRoutes:  map[string]string{"bug": "firefighter"},
+	}
+
+	rigs := map[string]string{}
+	for i := 0; i < 2; i++ {
+		result := FeedConvoy(context.Background(), store, townRoot, "test-convoy", "test", logger, gtPath, nil, opts, nil)
+		rigs[result.IssueID] = result.Rig
+	}
+	if rigs["test-bug"] != "firefighter" || rigs["test-task"] != "testrig" {
+		t.Errorf("dispatched rigs = %v, want test-bug on firefighter and test-task on testrig", rigs)
+	}
+
+	log := readGTLog(t, logPath)
+	if !strings.Contains(log, "sling test-bug firefighter") || !strings.Contains(log, "--allow-cross-rig") {
+		t.Errorf("gt stub log = %q, want cross-rig sling of test-bug to firefighter", log)
+	}
+	if strings.Contains(log, "--force") {
+		t.Errorf("gt stub log = %q, type routes must not sling with --force", log)
+	}
+	if strings.Contains(log, "sling test-bug testrig") {
+		t.Errorf("gt stub log = %q, test-bug must not follow its prefix", log)
+	}
+}
+
+func TestMemStore_FeedConvoyForcedAssignee(t *testing.T) {
+	epic := memIssue("test-epic", beadsdk.StatusOpen, "")
+	epic.IssueType = beadsdk.TypeEpic
+	store := newMemStore(
+		memIssue("test-convoy", beadsdk.StatusOpen, ""),
+		epic,
+		memIssue("test-blocked", beadsdk.StatusOpen, ""),
+		memIssue("test-blocker", beadsdk.StatusOpen, "otherrig/polecats/beta"),
+		memIssue("test-ready", beadsdk.StatusOpen, ""),
+	)
+	store.addDep("test-convoy", "test-epic", "tracks")
+	store.addDep("test-convoy", "test-blocked", "tracks")
+	store.addDep("test-convoy", "test-ready", "tracks")
+	store.addDep("test-blocked", "test-blocker", "blocks")
+
+	townRoot := setupTownRoot(t)
+	gtPath, logPath := makeGTStub(t, 0)
+	logger, logs := makeLogger()
+	opts := FeedOptions{Strategy: FeedFIFO, MaxPerRig: 1, Assignee: "otherrig/polecats/alpha"}
+
+	result := FeedConvoy(context.Background(), store, townRoot, "test-convoy", "test", logger, gtPath, nil, opts, nil)
+	if result.IssueID != "test-ready" || result.Rig != "otherrig" || result.Assignee != "otherrig/polecats/alpha" {
+		t.Fatalf("FeedConvoy() = %+v, want test-ready forced to otherrig/polecats/alpha", *result)
+	}
+	if !slices.Contains(result.Blocked, "test-blocked") {
+		t.Errorf("Blocked = %v, want test-blocked still passed over", result.Blocked)
+	}
+
+	log := readGTLog(t, logPath)
+	if !strings.Contains(log, "sling test-ready otherrig/polecats/alpha --no-boot --allow-cross-rig") || strings.Contains(log, "--force") {
+		t.Errorf("gt stub log = %q, want cross-rig sling of test-ready to otherrig/polecats/alpha without --force", log)
+	}
+	if strings.Contains(log, "test-epic") || strings.Contains(log, "test-blocked") {
+		t.Errorf("gt stub log = %q, epic and blocked issues must not be slung", log)
+	}
+	if !strings.Contains(strings.Join(*logs, "\n"), "(forced assignee)") {
+		t.Errorf("logs = %v, want the dispatch reported as forced", *logs)
+	}
+}
+
+func TestMemStore_FeedConvoyLabelSelector(t *testing.T) {
+	labeled := func(id string, labels ...string) *beadsdk.Issue {
+		iss := memIssue(id, beadsdk.StatusOpen, "")
+		iss.Labels = labels
+		return iss
+	}
+	store := newMemStore(
+		memIssue("test-convoy", beadsdk.StatusOpen, ""),
+		labeled("test-plain"),
+		labeled("test-backend", "backend"),
+		labeled("test-hotfix", "frontend", "urgent"),
+		labeled("test-ui", "frontend"),
+	)
+	for _, id := range []string{"test-plain", "test-backend", "test-hotfix", "test-ui"} {
+		store.addDep("test-convoy", id, "tracks")
+	}
+
+	townRoot := setupTownRoot(t)
+	gtPath, _ := makeGTStub(t, 0)
+
+	tests := []struct {
+		name string
+		sel  LabelSelector
+		want []string
+	}{
+		{"no selector", LabelSelector{}, []string{"test-plain", "test-backend", "test-hotfix", "test-ui"}},
+		{"include", LabelSelector{Include: []string{"frontend"}}, []string{"test-hotfix", "test-ui"}},
+		{"exclude", LabelSelector{Exclude: []string{"urgent"}}, []string{"test-plain", "test-backend", "test-ui"}},
+		{"include and exclude", LabelSelector{Include: []string{"frontend"}, Exclude: []string{"urgent"}}, []string{"test-ui"}},
+		{"no match", LabelSelector{Include: []string{"docs"}}, nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			opts := FeedOptions{Strategy: FeedFIFO, DryRun: true, Labels: tt.sel, Pending: map[string]string{}}
+			var got []string
+			for {
+				result := FeedConvoy(context.Background(), store, townRoot, "test-convoy", "test", nil, gtPath, nil, opts, nil)
+				if result.IssueID == "" {
+					break
+				}
+				got = append(got, result.IssueID)
+				opts.Pending[result.IssueID] = result.Rig
+			}
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("fed %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMemStore_CancelledFeedDispatchesNothing(t *testing.T) {
+	store := newMemStore(
+		memIssue("test-convoy", beadsdk.StatusOpen, ""),
+		memIssue("test-ready", beadsdk.StatusOpen, ""),
+	)
+	store.addDep("test-convoy", "test-ready", "tracks")
+
+	gtPath, logPath := makeGTStub(t, 0)
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	result := FeedConvoy(ctx, store, setupTownRoot(t), "test-convoy", "test", nil, gtPath, nil, FeedOptions{Drain: true}, nil)
+
+	if result.IssueID != "" {
+		t.Errorf("FeedConvoy() after cancel dispatched %s, want nothing", result.IssueID)
+	}
+	if log := readGTLog(t, logPath); strings.Contains(log, "sling") {
+		t.Errorf("gt stub log = %q, want no sling after cancel", log)
+	}
+}
+
+func TestMemStore_CancelDuringDispatch(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("skipping on windows")
+	}
+	for _, drain := range []bool{true, false} {
+		t.Run(fmt.Sprintf("drain=%v", drain), func(t *testing.T) {
+			store := newMemStore(
+				memIssue("test-convoy", beadsdk.StatusOpen, ""),
+				memIssue("test-a", beadsdk.StatusOpen, ""),
+				memIssue("test-b", beadsdk.StatusOpen, ""),
+			)
+			store.addDep("test-convoy", "test-a", "tracks")
+			store.addDep("test-convoy", "test-b", "tracks")
+
+			// The sling outlives the cancel that arrives while it runs.
+			dir := t.TempDir()
+			logPath := filepath.Join(dir, "gt.log")
+			gtPath := filepath.Join(dir, "gt")
+			script := fmt.Sprintf("#!/bin/sh\necho \"$*\" >> %q\nsleep 0.5\necho done >> %q\n", logPath, logPath)
+			if err := os.WriteFile(gtPath, []byte(script), 0755); err != nil {
+				t.Fatalf("WriteFile gt stub: %v", err)
+			}
+
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+			time.AfterFunc(100*time.Millisecond, cancel)
+			opts := FeedOptions{Strategy: FeedFIFO, Drain: drain}
+			result := FeedConvoy(ctx, store, setupTownRoot(t), "test-convoy", "test", nil, gtPath, nil, opts, nil)
+
+			log := readGTLog(t, logPath)
+			if strings.Contains(log, "sling test-b") {
+				t.Errorf("gt stub log = %q, want no dispatch started after cancel", log)
+			}
+			if drain {
+				if result.IssueID != "test-a" || !strings.Contains(log, "done") {
+					t.Errorf("result = %+v, log = %q, want test-a's sling to finish", *result, log)
+				}
+				return
+			}
+			if result.IssueID != "" {
+				t.Errorf("result = %+v, want the killed sling to count as failed", *result)
+			}
+			if iss, _ := store.GetIssue(context.Background(), "test-a"); iss.Status != beadsdk.StatusOpen || iss.Assignee != "" {
+				t.Errorf("test-a = %s/%q after killed sling, want the claim released", iss.Status, iss.Assignee)
+			}
+		})
+	}
+}
+
+func TestMemStore_StageTwoWaitsForStageOne(t *testing.T) {
+	convoy := memIssue("test-convoy", beadsdk.StatusOpen, "")
+	convoy.Description = "stages: test-a=1,test-b=2"
+	store := newMemStore(
+		convoy,
+		memIssue("test-a", beadsdk.StatusOpen, ""),
+		memIssue("test-b", beadsdk.StatusOpen, ""),
+	)
+	store.addDep("test-convoy", "test-a", "tracks")
+	store.addDep("test-convoy", "test-b", "tracks")
+
+	townRoot := setupTownRoot(t)
+	gtPath, logPath := makeGTStub(t, 0)
+	logger, _ := makeLogger()
+
+	result := FeedConvoy(context.Background(), store, townRoot, "test-convoy", "test", logger, gtPath, nil, FeedOptions{}, nil)
+	if result.IssueID != "test-a" {
+		t.Fatalf("fed %q, want test-a from stage 1", result.IssueID)
+	}
+	if !slices.Contains(result.Blocked, "test-b") {
+		t.Errorf("Blocked = %v, want test-b held for stage 2", result.Blocked)
+	}
+	if log := readGTLog(t, logPath); strings.Contains(log, "sling test-b") {
+		t.Fatalf("gt stub log = %q, test-b slung before stage 1 closed", log)
+	}
+
+	store.setStatus("test-a", beadsdk.StatusClosed)
+	check := CheckConvoysForIssueWithOptions(context.Background(), store, townRoot, "test-a", "test", logger, gtPath, nil, FeedOptions{}, nil)
+	if len(check.Fed) != 1 || check.Fed[0].IssueID != "test-b" {
+		t.Fatalf("Fed = %+v, want test-b once stage 1 closed", check.Fed)
+	}
+}
+
+func TestMemStore_FeedDeliversMessageAndClosesIt(t *testing.T) {
+	sink := useRecordingSink(t)
+	msg := memIssue("test-msg", beadsdk.StatusOpen, "")
+	msg.IssueType = "message"
+	msg.Title = "Rig gastown is out of disk"
+	store := newMemStore(
+		memIssue("test-convoy", beadsdk.StatusOpen, ""),
+		msg,
+		memIssue("test-task", beadsdk.StatusOpen, ""),
+	)
+	store.addDep("test-convoy", "test-msg", "tracks")
+	store.addDep("test-convoy", "test-task", "tracks")
+
+	townRoot := setupTownRoot(t)
+	gtPath, logPath := makeGTStub(t, 0)
+	logger, _ := makeLogger()
+
+	result := FeedConvoy(context.Background(), store, townRoot, "test-convoy", "test", logger, gtPath, nil, FeedOptions{}, nil)
+	if !slices.Equal(result.Handled, []string{"test-msg"}) {
+		t.Errorf("Handled = %v, want [test-msg]", result.Handled)
+	}
+	if result.IssueID != "test-task" {
+		t.Errorf("fed %q, want test-task still dispatched alongside the message", result.IssueID)
+	}
+
+	log := readGTLog(t, logPath)
+	if !strings.Contains(log, "mayor chat --quiet Message test-msg: Rig gastown is out of disk") {
+		t.Errorf("gt stub log = %q, want the message delivered with gt mayor chat", log)
+	}
+	if strings.Contains(log, "sling test-msg") {
+		t.Errorf("gt stub log = %q, a message must not be slung", log)
+	}
+	if iss, _ := store.GetIssue(context.Background(), "test-msg"); iss.Status != beadsdk.StatusClosed {
+		t.Errorf("test-msg status = %s, want closed after delivery", iss.Status)
+	}
+	if !slices.Contains(sink.types(), EventIssueHandled) {
+		t.Errorf("events = %v, want %s", sink.types(), EventIssueHandled)
+	}
+}
+
+func TestMemStore_FeedLeavesMessageOpenWhenDeliveryFails(t *testing.T) {
+	msg := memIssue("test-msg", beadsdk.StatusOpen, "")
+	msg.IssueType = "message"
+	store := newMemStore(memIssue("test-convoy", beadsdk.StatusOpen, ""), msg)
+	store.addDep("test-convoy", "test-msg", "tracks")
+
+	townRoot := setupTownRoot(t)
+	gtPath, _ := makeGTStub(t, 1)
+	logger, _ := makeLogger()
+
+	result := FeedConvoy(context.Background(), store, townRoot, "test-convoy", "test", logger, gtPath, nil, FeedOptions{}, nil)
+	if len(result.Handled) != 0 {
+		t.Errorf("Handled = %v, want none when gt mayor chat fails", result.Handled)
+	}
+	iss, _ := store.GetIssue(context.Background(), "test-msg")
+	if iss.Status != beadsdk.StatusOpen || iss.Assignee != "" {
+		t.Errorf("test-msg = %s/%q, want open and unclaimed for the next feed", iss.Status, iss.Assignee)
+	}
+}
+
+func TestMemStore_DryRunPendingSkipsAndCountsAgainstCapacity(t *testing.T) {
+	store := newMemStore(
+		memIssue("test-convoy", beadsdk.StatusOpen, ""),
+		memIssue("test-a", beadsdk.StatusOpen, ""),
+		memIssue("test-b", beadsdk.StatusOpen, ""),
+	)
+	store.addDep("test-convoy", "test-a", "tracks")
+	store.addDep("test-convoy", "test-b", "tracks")
+
+	townRoot := setupTownRoot(t)
+	gtPath, _ := makeGTStub(t, 0)
+	opts := FeedOptions{DryRun: true, Strategy: FeedFIFO, Pending: make(map[string]string)}
+
+	first := FeedConvoy(context.Background(), store, townRoot, "test-convoy", "test", nil, gtPath, nil, opts, nil)
+	if first.IssueID != "test-a" {
+		t.Fatalf("first dry run picked %q, want test-a", first.IssueID)
+	}
+	opts.Pending[first.IssueID] = first.Rig
+
+	second := FeedConvoy(context.Background(), store, townRoot, "test-convoy", "test", nil, gtPath, nil, opts, nil)
+	if second.IssueID != "test-b" {
+		t.Errorf("second dry run picked %q, want test-b (test-a is pending)", second.IssueID)
+	}
+
+	// The pending pick fills the rig's only slot.
+	opts.MaxPerRig = 1
+	capped := FeedConvoy(context.Background(), store, townRoot, "test-convoy", "test", nil, gtPath, nil, opts, nil)
+	if capped.IssueID != "" || !capped.NoRigAvailable {
+		t.Errorf("capped dry run = %+v, want no rig available", capped)
+	}
+}
@@ -0,0 +1,97 @@
+package convoy
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	beadsdk "github.com/steveyegge/beads"
+)
+
+// Message is one recorded exchange with the Mayor, as saved by
+// gt mayor chat --save.
+type Message struct {
+	Prompt   string
+	Response string
+	Session  string    // tmux session that was messaged
+	Sender   string    // who sent the prompt
+	At       time.Time // when the response was captured
+}
+
+// MessageStore is implemented by stores that can record a message issue and
+// attach it to a convoy. The beads store does.
+type MessageStore interface {
+	DependencyStore
+	IssueCloser
+	CreateIssue(ctx context.Context, issue *beadsdk.Issue, actor string) error
+}
+
+// messageTitleLen caps the prompt excerpt in a saved message's title.
+const messageTitleLen = 60
+
+// SaveMessage records msg as a message issue and returns its ID. The issue
+// is closed as soon as it is created: it is a record, and left open under a
+// convoy the feed would treat it as an actionable message and deliver it to
+// the Mayor again. With convoyID set, the convoy (which must exist) tracks
+// the issue once it is closed.
+func SaveMessage(ctx context.Context, store MessageStore, msg Message, convoyID, actor string) (string, error) {
+	if convoyID != "" {
+		if err := CheckConvoy(ctx, store, convoyID); err != nil {
+			return "", err
+		}
+	}
+
+	issue := &beadsdk.Issue{
+		Title:       messageTitle(msg.Prompt),
+		Description: messageDescription(msg),
+		IssueType:   beadsdk.IssueType("message"),
+		Priority:    4,
+		Sender:      msg.Sender,
+	}
+	if err := store.CreateIssue(ctx, issue, actor); err != nil {
+		return "", fmt.Errorf("creating message: %w", err)
+	}
+	if err := store.CloseIssue(ctx, issue.ID, "Recorded Mayor chat", actor, msg.Session); err != nil {
+		return issue.ID, fmt.Errorf("closing message %s: %w", issue.ID, err)
+	}
+	if convoyID != "" {
+		dep := &beadsdk.Dependency{IssueID: convoyID, DependsOnID: issue.ID, Type: beadsdk.DependencyType("tracks")}
+		if err := store.AddDependency(ctx, dep, actor); err != nil {
+			return issue.ID, fmt.Errorf("adding message %s to convoy %s: %w", issue.ID, convoyID, err)
+		}
+	}
+	return issue.ID, nil
+}
+
+// CheckConvoy returns an error unless convoyID names a convoy in store.
+func CheckConvoy(ctx context.Context, store IssueStore, convoyID string) error {
+	cv, err := store.GetIssue(ctx, convoyID)
+	if err != nil || cv == nil {
+		return fmt.Errorf("convoy %s not found", convoyID)
+	}
+	if cv.IssueType != "convoy" {
+		return fmt.Errorf("%s is not a convoy (type %s)", convoyID, cv.IssueType)
+	}
+	return nil
+}
+
+// messageTitle names a saved message by the first line of its prompt.
+func messageTitle(prompt string) string {
+	line, _, _ := strings.Cut(strings.TrimSpace(prompt), "\n")
+	if r := []rune(line); len(r) > messageTitleLen {
+		line = strings.TrimSpace(string(r[:messageTitleLen-1])) + "…"
+	}
+	return "Mayor chat: " + line
+}
+
+// messageDescription lays out a saved message: session and time as
+// description fields, then the prompt and response in full.
+func messageDescription(msg Message) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "session: %s\n", msg.Session)
+	fmt.Fprintf(&b, "at: %s\n", msg.At.UTC().Format(time.RFC3339))
+	fmt.Fprintf(&b, "\n## Prompt\n\n%s\n", strings.TrimSpace(msg.Prompt))
+	fmt.Fprintf(&b, "\n## Response\n\n%s\n", strings.TrimSpace(msg.Response))
+	return b.String()
+}
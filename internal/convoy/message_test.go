@@ -0,0 +1,78 @@
+package convoy
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	beadsdk "github.com/steveyegge/beads"
+)
+
+func TestSaveMessage_RoundTrip(t *testing.T) {
+	cv := memIssue("test-convoy", beadsdk.StatusOpen, "")
+	cv.IssueType = "convoy"
+	store := newMemStore(cv)
+	at := time.Date(2026, 10, 14, 9, 30, 0, 0, time.UTC)
+	msg := Message{
+		Prompt:   "Which rig has the most open work?\nList the top three.",
+		Response: "gastown, then beads, then wyvern.",
+		Session:  "hq-mayor",
+		Sender:   "overseer",
+		At:       at,
+	}
+
+	id, err := SaveMessage(context.Background(), store, msg, "test-convoy", "overseer")
+	if err != nil {
+		t.Fatalf("SaveMessage: %v", err)
+	}
+
+	got, err := store.GetIssue(context.Background(), id)
+	if err != nil {
+		t.Fatalf("GetIssue(%s): %v", id, err)
+	}
+	if got.IssueType != "message" || got.Status != beadsdk.StatusClosed || got.Sender != "overseer" {
+		t.Errorf("saved issue type=%q status=%q sender=%q, want a closed message from overseer", got.IssueType, got.Status, got.Sender)
+	}
+	if got.Title != "Mayor chat: Which rig has the most open work?" {
+		t.Errorf("title = %q", got.Title)
+	}
+	for _, want := range []string{"session: hq-mayor", "at: 2026-10-14T09:30:00Z", msg.Prompt, msg.Response} {
+		if !strings.Contains(got.Description, want) {
+			t.Errorf("description missing %q:\n%s", want, got.Description)
+		}
+	}
+
+	tracked := getConvoyTrackedIssues(context.Background(), store, "test-convoy", setupTownRoot(t), nil)
+	if len(tracked) != 1 || tracked[0].ID != id {
+		t.Fatalf("convoy tracks %+v, want only %s", tracked, id)
+	}
+
+	// A recorded message is closed, so feeding the convoy leaves it alone.
+	result := FeedConvoy(context.Background(), store, setupTownRoot(t), "test-convoy", "test", nil, "", nil, FeedOptions{DryRun: true}, nil)
+	if len(result.Handled) != 0 || result.IssueID != "" {
+		t.Errorf("FeedConvoy() = %+v, want the saved message left alone", result)
+	}
+}
+
+func TestSaveMessage_RequiresConvoy(t *testing.T) {
+	store := newMemStore(memIssue("test-task", beadsdk.StatusOpen, ""))
+	msg := Message{Prompt: "hi", Response: "hello", Session: "hq-mayor", At: time.Now()}
+
+	for _, convoyID := range []string{"test-missing", "test-task"} {
+		if _, err := SaveMessage(context.Background(), store, msg, convoyID, "overseer"); err == nil {
+			t.Errorf("SaveMessage(convoy %s) succeeded, want an error", convoyID)
+		}
+	}
+	if n := len(store.issues); n != 1 {
+		t.Errorf("store has %d issues, want nothing created for a bad convoy", n)
+	}
+}
+
+func TestMessageTitle_Truncates(t *testing.T) {
+	long := strings.Repeat("x", 100)
+	got := messageTitle(long)
+	if r := []rune(strings.TrimPrefix(got, "Mayor chat: ")); len(r) != messageTitleLen || !strings.HasSuffix(got, "…") {
+		t.Errorf("messageTitle(100 x) = %q, want %d runes ending in …", got, messageTitleLen)
+	}
+}
@@ -0,0 +1,74 @@
+package convoy
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+	"sync/atomic"
+)
+
+// FeedMetrics counts a feeder's decisions and tracks its queue, for
+// scraping by Prometheus (gt convoy feed --watch --metrics-addr). Install
+// it as FeedOptions.Decisions, alone or in a MultiSink, to collect the
+// counters, and pass each FeedResult to Observe to update the gauges.
+// Safe for concurrent use; the zero value is ready to use.
+type FeedMetrics struct {
+	// ConvoyID labels every series, so one scrape config can cover
+	// several feeders.
+	ConvoyID string
+
+	dispatched      atomic.Int64
+	skippedBlocked  atomic.Int64
+	skippedCapacity atomic.Int64
+	dispatchErrors  atomic.Int64
+	ready           atomic.Int64
+	busy            atomic.Int64
+}
+
+// Emit implements EventSink. Dry-run decisions are not counted.
+func (m *FeedMetrics) Emit(e Event) {
+	if e.DryRun {
+		return
+	}
+	switch e.Type {
+	case EventIssueDispatched:
+		m.dispatched.Add(1)
+	case EventCapacityThrottled:
+		m.skippedCapacity.Add(int64(e.Ready))
+	case EventIssueSkipped:
+		switch {
+		case e.Reason == skipBlocked || strings.HasPrefix(e.Reason, skipStageWaiting):
+			m.skippedBlocked.Add(1)
+		case strings.HasPrefix(e.Reason, skipDispatchFailed):
+			m.dispatchErrors.Add(1)
+		}
+	}
+}
+
+// Observe sets the gauges from a feed's result.
+func (m *FeedMetrics) Observe(r *FeedResult) {
+	if r == nil {
+		return
+	}
+	m.ready.Store(int64(r.Ready))
+	m.busy.Store(int64(r.InFlight))
+}
+
+// ServeHTTP writes the metrics in the Prometheus text exposition format.
+func (m *FeedMetrics) ServeHTTP(w http.ResponseWriter, _ *http.Request) {
+	w.Header().Set("Content-Type", "text/plain; version=0.0.4; charset=utf-8")
+	labels := fmt.Sprintf("{convoy=%q}", m.ConvoyID)
+	for _, s := range []struct {
+		name, kind, help string
+		value            int64
+	}{
+		{"gt_convoy_feed_dispatched_total", "counter", "Issues slung by the feed.", m.dispatched.Load()},
+		{"gt_convoy_feed_skipped_blocked_total", "counter", "Times a ready issue was passed over for open dependencies or a waiting stage.", m.skippedBlocked.Load()},
+		{"gt_convoy_feed_skipped_capacity_total", "counter", "Times a ready issue was left open because its rig was at the in-flight limit.", m.skippedCapacity.Load()},
+		{"gt_convoy_feed_dispatch_errors_total", "counter", "gt sling runs that failed.", m.dispatchErrors.Load()},
+		{"gt_convoy_feed_ready_issues", "gauge", "Ready issues left open after the last feed.", m.ready.Load()},
+		{"gt_convoy_feed_busy_polecats", "gauge", "Convoy issues assigned and not yet closed after the last feed.", m.busy.Load()},
+	} {
+		fmt.Fprintf(w, "# HELP %s %s\n# TYPE %s %s\n%s%s %d\n", s.name, s.help, s.name, s.kind, s.name, labels, s.value)
+	}
+}
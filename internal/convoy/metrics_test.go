@@ -0,0 +1,75 @@
+package convoy
+
+import (
+	"context"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	beadsdk "github.com/steveyegge/beads"
+)
+
+func TestFeedMetrics(t *testing.T) {
+	store := newMemStore(
+		memIssue("test-convoy", beadsdk.StatusOpen, ""),
+		memIssue("test-busy", beadsdk.StatusInProgress, "testrig/polecats/bravo"),
+		memIssue("test-blocked", beadsdk.StatusOpen, ""),
+		memIssue("test-blocker", beadsdk.StatusOpen, ""),
+		memIssue("test-a", beadsdk.StatusOpen, ""),
+		memIssue("test-b", beadsdk.StatusOpen, ""),
+	)
+	for _, id := range []string{"test-busy", "test-blocked", "test-a", "test-b"} {
+		store.addDep("test-convoy", id, "tracks")
+	}
+	store.addDep("test-blocked", "test-blocker", "blocks")
+
+	townRoot := setupTownRoot(t)
+	okGT, _ := makeGTStub(t, 0)
+	failGT, _ := makeGTStub(t, 1)
+	m := &FeedMetrics{ConvoyID: "test-convoy"}
+	feed := func(gtPath string, maxPerRig int) *FeedResult {
+		opts := FeedOptions{Strategy: FeedFIFO, MaxPerRig: maxPerRig, Decisions: m}
+		r := FeedConvoy(context.Background(), store, townRoot, "test-convoy", "test", nil, gtPath, nil, opts, nil)
+		m.Observe(r)
+		return r
+	}
+
+	// At capacity: both ready issues are left open.
+	if r := feed(okGT, 1); !r.NoRigAvailable {
+		t.Fatalf("feed at capacity = %+v, want NoRigAvailable", *r)
+	}
+	// Both slings fail.
+	if r := feed(failGT, 0); r.IssueID != "" || r.Ready != 2 || r.InFlight != 1 {
+		t.Fatalf("failing feed = %+v, want no dispatch, 2 ready, 1 in flight", *r)
+	}
+	// test-a goes out.
+	if r := feed(okGT, 0); r.IssueID != "test-a" || r.Ready != 1 || r.InFlight != 2 {
+		t.Fatalf("feed = %+v, want test-a dispatched, 1 ready, 2 in flight", *r)
+	}
+	// Dry runs are not counted, even though this one passes over the blocked issue.
+	dry := FeedConvoy(context.Background(), store, townRoot, "test-convoy", "test", nil, okGT, nil, FeedOptions{DryRun: true, Decisions: m}, nil)
+	if dry.IssueID == "" {
+		t.Fatal("dry run found nothing to feed")
+	}
+
+	rec := httptest.NewRecorder()
+	m.ServeHTTP(rec, httptest.NewRequest("GET", "/metrics", nil))
+	body := rec.Body.String()
+	for _, want := range []string{
+		`gt_convoy_feed_dispatched_total{convoy="test-convoy"} 1`,
+		`gt_convoy_feed_skipped_blocked_total{convoy="test-convoy"} 3`,
+		`gt_convoy_feed_skipped_capacity_total{convoy="test-convoy"} 2`,
+		`gt_convoy_feed_dispatch_errors_total{convoy="test-convoy"} 2`,
+		`gt_convoy_feed_ready_issues{convoy="test-convoy"} 1`,
+		`gt_convoy_feed_busy_polecats{convoy="test-convoy"} 2`,
+		"# TYPE gt_convoy_feed_dispatched_total counter",
+		"# TYPE gt_convoy_feed_ready_issues gauge",
+	} {
+		if !strings.Contains(body, want) {
+			t.Errorf("metrics missing %q:\n%s", want, body)
+		}
+	}
+	if ct := rec.Header().Get("Content-Type"); !strings.HasPrefix(ct, "text/plain") {
+		t.Errorf("Content-Type = %q, want text/plain", ct)
+	}
+}
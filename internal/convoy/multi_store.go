@@ -93,6 +93,15 @@ func (r *StoreResolver) ResolveDepsWithMetadata(ctx context.Context, issueID str
 	return deps
 }
 
+// storeFor returns the store holding id, or nil if the resolver is nil or
+// has no store for it.
+func (r *StoreResolver) storeFor(id string) beadsdk.Storage {
+	if r == nil || len(r.stores) == 0 {
+		return nil
+	}
+	return r.stores[r.storeForID(id)]
+}
+
 // storeForID returns the store name for a given issue ID based on prefix routing.
 // Returns "hq" for town-level prefixes, rig name for rig prefixes, or "" if unknown.
 func (r *StoreResolver) storeForID(id string) string {
@@ -14,6 +14,9 @@ import (
 // setupTestStoreWithPrefix opens a test store and sets a specific prefix.
 func setupTestStoreWithPrefix(t *testing.T, prefix string) (beadsdk.Storage, func()) {
 	t.Helper()
+	if doltUnavailable != nil {
+		t.Skipf("beads store unavailable: %v", doltUnavailable)
+	}
 	t.Setenv("BEADS_TEST_MODE", "1")
 
 	dir := t.TempDir()
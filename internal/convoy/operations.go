@@ -10,9 +10,12 @@ import (
 	"os/exec"
 	"sort"
 	"strings"
+	"sync/atomic"
+	"time"
 
 	beadsdk "github.com/steveyegge/beads"
 	"github.com/steveyegge/gastown/internal/beads"
+	"github.com/steveyegge/gastown/internal/config"
 	"github.com/steveyegge/gastown/internal/util"
 )
 
@@ -26,7 +29,7 @@ import (
 //
 // Parameters:
 //   - ctx: context for storage operations
-//   - store: beads storage for dependency/issue queries (nil skips convoy checks)
+//   - store: issue store for dependency/issue queries (nil skips convoy checks)
 //   - townRoot: path to the town root directory
 //   - issueID: the issue ID that was just closed
 //   - caller: identifier for logging (e.g., "Convoy")
@@ -34,69 +37,113 @@ import (
 //   - gtPath: resolved path to the gt binary (e.g. from exec.LookPath or daemon config)
 //   - resolver: optional StoreResolver for cross-database issue resolution (nil falls back to subprocess)
 //
-// Returns the convoy IDs that were checked (may be empty if issue isn't tracked).
-func CheckConvoysForIssue(ctx context.Context, store beadsdk.Storage, townRoot, issueID, caller string, logger func(format string, args ...interface{}), gtPath string, isRigParked func(string) bool, resolver ...*StoreResolver) []string {
+// Returns a summary of the convoys that track the issue and what happened to
+// each. The result is the zero value when store is nil or the issue isn't
+// tracked.
+func CheckConvoysForIssue(ctx context.Context, store IssueStore, townRoot, issueID, caller string, logger func(format string, args ...interface{}), gtPath string, isRigParked func(string) bool, resolver ...*StoreResolver) CheckResult {
+	// Extract optional resolver (variadic for backward compatibility)
+	var res *StoreResolver
+	if len(resolver) > 0 {
+		res = resolver[0]
+	}
+	return CheckConvoysForIssueWithOptions(ctx, store, townRoot, issueID, caller, logger, gtPath, isRigParked, townFeedOptions(townRoot), res)
+}
+
+// townFeedOptions returns the town's configured feed options, so one-shot
+// callers honor the same capacity limits as the daemon.
+func townFeedOptions(townRoot string) FeedOptions {
+	if townRoot == "" {
+		return FeedOptions{}
+	}
+	return LoadFeedOptions(townRoot)
+}
+
+// CheckConvoysForIssueWithOptions is like CheckConvoysForIssue but applies
+// feedOpts to the continuation feed (rig strategy, per-rig limit, rotation).
+func CheckConvoysForIssueWithOptions(ctx context.Context, store IssueStore, townRoot, issueID, caller string, logger func(format string, args ...interface{}), gtPath string, isRigParked func(string) bool, feedOpts FeedOptions, res *StoreResolver) CheckResult {
+	var result CheckResult
 	if logger == nil {
 		logger = func(format string, args ...interface{}) {} // no-op
 	}
+	log := levels(logger)
 	if isRigParked == nil {
 		isRigParked = func(string) bool { return false }
 	}
 	if store == nil {
-		return nil
-	}
-
-	// Extract optional resolver (variadic for backward compatibility)
-	var res *StoreResolver
-	if len(resolver) > 0 {
-		res = resolver[0]
+		return result
 	}
 
 	// Find convoys tracking this issue
 	convoyIDs := getTrackingConvoys(ctx, store, issueID, logger)
 	if len(convoyIDs) == 0 {
-		return nil
+		return result
 	}
+	result.ConvoyIDs = convoyIDs
 
-	logger("%s: %s tracked by %d convoy(s): %v", caller, issueID, len(convoyIDs), convoyIDs)
+	log.debugf("%s: %s tracked by %d convoy(s): %v", caller, issueID, len(convoyIDs), convoyIDs)
 
 	// Run convoy check for each tracking convoy
 	// Note: gt convoy check is idempotent and handles already-closed convoys
 	for _, convoyID := range convoyIDs {
 		if isConvoyClosed(ctx, store, convoyID) {
-			logger("%s: convoy %s already closed, skipping", caller, convoyID)
+			log.infof("%s: convoy %s already closed, skipping", caller, convoyID)
+			result.Skipped = append(result.Skipped, convoyID)
 			continue
 		}
 
 		if isConvoyStaged(ctx, store, convoyID) {
-			logger("%s: convoy %s is staged (not yet launched), skipping", caller, convoyID)
+			log.infof("%s: convoy %s is staged (not yet launched), skipping", caller, convoyID)
+			result.Skipped = append(result.Skipped, convoyID)
 			continue
 		}
 
-		logger("%s: checking convoy %s", caller, convoyID)
+		emit(Event{Type: EventIssueCompleted, ConvoyID: convoyID, IssueID: issueID, Caller: caller})
+
+		log.infof("%s: checking convoy %s", caller, convoyID)
 		if err := runConvoyCheck(ctx, townRoot, convoyID, gtPath); err != nil {
-			logger("%s: convoy %s check failed: %s", caller, convoyID, util.FirstLine(err.Error()))
+			log.warnf("%s: convoy %s check failed: %s", caller, convoyID, util.FirstLine(err.Error()))
 		}
 
 		// Continuation feed: if convoy is still open after the completion check,
 		// reactively dispatch the next ready issue. This makes convoy feeding
 		// event-driven instead of relying on polling-based patrol cycles.
-		if !isConvoyClosed(ctx, store, convoyID) {
-			feedNextReadyIssue(ctx, store, townRoot, convoyID, caller, logger, gtPath, isRigParked, res)
+		if isConvoyClosed(ctx, store, convoyID) {
+			result.Completed = append(result.Completed, convoyID)
+			emit(Event{Type: EventConvoyCompleted, ConvoyID: convoyID, IssueID: issueID, Caller: caller})
+			continue
+		}
+		fed := FeedConvoy(ctx, store, townRoot, convoyID, caller, logger, gtPath, isRigParked, feedOpts, res)
+		if fed.IssueID != "" {
+			result.Fed = append(result.Fed, ConvoyFeed{ConvoyID: convoyID, FeedResult: *fed})
+			if !fed.DryRun {
+				emit(Event{Type: EventConvoyAdvanced, ConvoyID: convoyID, IssueID: fed.IssueID, Rig: fed.Rig, Caller: caller})
+			}
 		}
 	}
 
-	return convoyIDs
+	return result
+}
+
+// CheckResult summarizes what CheckConvoysForIssue did for an issue.
+type CheckResult struct {
+	ConvoyIDs []string     // convoys tracking the issue
+	Completed []string     // convoys the completion check closed
+	Skipped   []string     // convoys already closed or still staged
+	Fed       []ConvoyFeed // next issues dispatched to keep convoys moving
+}
+
+// ConvoyFeed records an issue dispatched from a convoy.
+type ConvoyFeed struct {
+	ConvoyID string
+	FeedResult
 }
 
 // getTrackingConvoys returns convoy IDs that track the given issue.
 // Uses SDK GetDependentsWithMetadata filtered by type "tracks".
-func getTrackingConvoys(ctx context.Context, store beadsdk.Storage, issueID string, logger func(format string, args ...interface{})) []string {
+func getTrackingConvoys(ctx context.Context, store IssueStore, issueID string, logger func(format string, args ...interface{})) []string {
 	dependents, err := store.GetDependentsWithMetadata(ctx, issueID)
 	if err != nil {
-		if logger != nil {
-			logger("Convoy: getTrackingConvoys(%s) store error: %v", issueID, err)
-		}
+		levels(logger).warnf("Convoy: getTrackingConvoys(%s) store error: %v", issueID, err)
 		return nil
 	}
 
@@ -110,7 +157,7 @@ func getTrackingConvoys(ctx context.Context, store beadsdk.Storage, issueID stri
 }
 
 // isConvoyClosed checks if a convoy is already closed.
-func isConvoyClosed(ctx context.Context, store beadsdk.Storage, convoyID string) bool {
+func isConvoyClosed(ctx context.Context, store IssueStore, convoyID string) bool {
 	issue, err := store.GetIssue(ctx, convoyID)
 	if err != nil || issue == nil {
 		return false
@@ -121,7 +168,7 @@ func isConvoyClosed(ctx context.Context, store beadsdk.Storage, convoyID string)
 // isConvoyStaged checks if a convoy is in a staged state (not yet launched).
 // Staged convoys have statuses like "staged_ready" or "staged_warnings".
 // They should not be fed until they are launched (transitioned to "open").
-func isConvoyStaged(ctx context.Context, store beadsdk.Storage, convoyID string) bool {
+func isConvoyStaged(ctx context.Context, store IssueStore, convoyID string) bool {
 	issue, err := store.GetIssue(ctx, convoyID)
 	if err != nil || issue == nil {
 		return false // fail-open: if we can't read, assume not staged
@@ -149,18 +196,54 @@ func runConvoyCheck(ctx context.Context, townRoot, convoyID, gtPath string) erro
 
 // trackedIssue holds basic info about an issue tracked by a convoy.
 type trackedIssue struct {
-	ID        string `json:"id"`
-	Status    string `json:"status"`
-	Assignee  string `json:"assignee"`
-	Priority  int    `json:"priority"`
-	IssueType string `json:"issue_type"`
-}
-
-// slingableTypes are bead types that can be dispatched via gt sling.
-// Only leaf work items are slingable — containers (epic) and non-work types
-// (decision, message, event) are excluded. Unknown/empty types are treated
-// as slingable (beads default to "task" when IssueType is empty).
-var slingableTypes = map[string]bool{
+	ID         string    `json:"id"`
+	Status     string    `json:"status"`
+	Assignee   string    `json:"assignee"`
+	Priority   int       `json:"priority"`
+	IssueType  string    `json:"issue_type"`
+	Labels     []string  `json:"labels,omitempty"`
+	AssignedAt time.Time `json:"-"` // from the assigned_at field gt sling writes; zero if unknown
+}
+
+// SlingableSet is a set of bead types that can be dispatched via gt sling.
+type SlingableSet map[string]bool
+
+// NewSlingableSet builds a set from a list of type names. The empty type is
+// always included, since beads default to "task" when IssueType is empty.
+// An empty list yields the built-in defaults.
+func NewSlingableSet(types []string) SlingableSet {
+	if len(types) == 0 {
+		return DefaultSlingableSet()
+	}
+	set := SlingableSet{"": true}
+	for _, t := range types {
+		if t = strings.TrimSpace(t); t != "" {
+			set[t] = true
+		}
+	}
+	return set
+}
+
+// DefaultSlingableSet returns the built-in slingable types. Only leaf work
+// items are slingable — containers (epic) and non-work types (decision,
+// message, event) are excluded.
+func DefaultSlingableSet() SlingableSet {
+	set := make(SlingableSet, len(slingableTypes))
+	for t := range slingableTypes {
+		set[t] = true
+	}
+	return set
+}
+
+// Allows reports whether issueType is in the set.
+func (s SlingableSet) Allows(issueType string) bool {
+	return s[issueType]
+}
+
+// slingableTypes are the built-in bead types that can be dispatched via gt
+// sling. Unknown/empty types are treated as slingable (beads default to
+// "task" when IssueType is empty).
+var slingableTypes = SlingableSet{
 	"task":    true,
 	"bug":     true,
 	"feature": true,
@@ -168,10 +251,47 @@ var slingableTypes = map[string]bool{
 	"":        true, // Empty type defaults to task
 }
 
+// activeSlingable is the set consulted by IsSlingableType. Nil means the
+// built-in defaults.
+var activeSlingable atomic.Pointer[SlingableSet]
+
+// SetSlingableTypes replaces the set consulted by IsSlingableType. An empty
+// list restores the built-in defaults.
+func SetSlingableTypes(types []string) {
+	if len(types) == 0 {
+		activeSlingable.Store(nil)
+		return
+	}
+	set := NewSlingableSet(types)
+	activeSlingable.Store(&set)
+}
+
+// LoadSlingableTypes applies convoy.slingable_types from the town settings,
+// falling back to the built-in defaults when unset or unreadable.
+func LoadSlingableTypes(townRoot string) {
+	settings, err := config.LoadOrCreateTownSettings(config.TownSettingsPath(townRoot))
+	if err != nil {
+		SetSlingableTypes(nil)
+		return
+	}
+	SetSlingableTypes(settings.ConvoySettings().SlingableTypes)
+}
+
 // IsSlingableType reports whether a bead type can be dispatched via gt sling.
+// Uses the town's configured set if one was loaded, else the built-in defaults.
 // Exported for use by cmd/convoy.go stranded scan path.
 func IsSlingableType(issueType string) bool {
-	return slingableTypes[issueType]
+	if set := activeSlingable.Load(); set != nil {
+		return set.Allows(issueType)
+	}
+	return slingableTypes.Allows(issueType)
+}
+
+// IsReadyCandidate reports whether an issue passes the per-issue part of the
+// feed predicate: open, unassigned, and slingable. Feeding additionally skips
+// blocked issues and parked rigs, which need the store and town to check.
+func IsReadyCandidate(status, assignee, issueType string) bool {
+	return status == "open" && assignee == "" && IsSlingableType(issueType)
 }
 
 // blockingDepTypes are dependency types that prevent an issue from being
@@ -198,24 +318,14 @@ var blockingDepTypes = map[string]bool{
 // by querying the appropriate rig store for fresh status. Without a resolver,
 // this falls back to the hq store's dependency metadata snapshot, which may
 // be stale for cross-rig issues (see GH #2624).
-func isIssueBlocked(ctx context.Context, store beadsdk.Storage, issueID string, resolver *StoreResolver) bool {
+func isIssueBlocked(ctx context.Context, store IssueStore, issueID string, resolver *StoreResolver) bool {
 	if store == nil {
 		return false // fail-open: no store means we can't check deps
 	}
 
-	// Try the resolver first for cross-database accuracy. The resolver looks up
-	// deps in the issue's home store (based on prefix routing), which returns
-	// current status. Fall back to hq store if resolver is nil or returns nothing.
-	var deps []*beadsdk.IssueWithDependencyMetadata
-	if resolver != nil {
-		deps = resolver.ResolveDepsWithMetadata(ctx, issueID)
-	}
-	if len(deps) == 0 {
-		var err error
-		deps, err = store.GetDependenciesWithMetadata(ctx, issueID)
-		if err != nil {
-			return false // On error, assume not blocked (fail-open)
-		}
+	deps, err := issueDeps(ctx, store, issueID, resolver)
+	if err != nil {
+		return false // On error, assume not blocked (fail-open)
 	}
 
 	// For cross-rig blocking deps, the metadata snapshot status may be stale.
@@ -274,6 +384,56 @@ func isIssueBlocked(ctx context.Context, store beadsdk.Storage, issueID string,
 	return false
 }
 
+// issueDeps returns an issue's dependencies. The resolver is tried first for
+// cross-database accuracy: it looks up deps in the issue's home store (based
+// on prefix routing), which returns current status. It falls back to the hq
+// store if the resolver is nil or returns nothing.
+func issueDeps(ctx context.Context, store IssueStore, issueID string, resolver *StoreResolver) ([]*beadsdk.IssueWithDependencyMetadata, error) {
+	if resolver != nil {
+		if deps := resolver.ResolveDepsWithMetadata(ctx, issueID); len(deps) > 0 {
+			return deps, nil
+		}
+	}
+	return store.GetDependenciesWithMetadata(ctx, issueID)
+}
+
+// FeedResult describes the issue a convoy feed chose and where it was sent.
+// IssueID is empty if no issue was ready.
+type FeedResult struct {
+	IssueID string `json:"issue_id,omitempty"`
+	Rig     string `json:"rig,omitempty"` // sling target; gt sling picks the polecat
+	DryRun  bool   `json:"dry_run"`       // true if the dispatch was skipped
+
+	// Assignee is the worker the issue was slung to when
+	// FeedOptions.Assignee forced the target instead of routing.
+	Assignee string `json:"assignee,omitempty"`
+
+	// Blocked lists open, unassigned issues that were passed over because
+	// of unclosed blocking dependencies. They become candidates again once
+	// their blockers close.
+	Blocked []string `json:"blocked,omitempty"`
+
+	// Handled lists actionable issues (messages, decisions, events) the
+	// feed acted on and closed itself. They don't count as the dispatch.
+	Handled []string `json:"handled,omitempty"`
+
+	// NoRigAvailable is set when ready issues exist but every rig they
+	// route to is at FeedOptions.MaxPerRig.
+	NoRigAvailable bool `json:"no_rig_available,omitempty"`
+
+	// Cycles lists dependency cycles among the blocked issues. Issues in a
+	// cycle wait on each other and are never ready until one link is removed.
+	Cycles [][]string `json:"cycles,omitempty"`
+
+	// Ready counts ready issues the feed found but left open: everything
+	// but the one it dispatched, including candidates whose sling failed.
+	Ready int `json:"ready"`
+
+	// InFlight counts the convoy's assigned, unclosed issues after the
+	// feed, including its own dispatch: the polecats busy on this convoy.
+	InFlight int `json:"in_flight"`
+}
+
 // feedNextReadyIssue finds the next ready issue in a convoy and dispatches it
 // via gt sling. A ready issue is one that is open, with no assignee, and not
 // blocked by unclosed dependencies. This provides reactive (event-driven)
@@ -281,40 +441,185 @@ func isIssueBlocked(ctx context.Context, store beadsdk.Storage, issueID string,
 //
 // Only one issue is dispatched per call. When that issue completes, the
 // next close event triggers another feed cycle.
-// gtPath is the resolved path to the gt binary.
-func feedNextReadyIssue(ctx context.Context, store beadsdk.Storage, townRoot, convoyID, caller string, logger func(format string, args ...interface{}), gtPath string, isRigParked func(string) bool, resolver *StoreResolver) {
+// gtPath is the resolved path to the gt binary. labels narrows the
+// candidates on top of the open, unassigned, slingable rule; the zero
+// selector feeds any issue.
+func feedNextReadyIssue(ctx context.Context, store IssueStore, townRoot, convoyID, caller string, logger func(format string, args ...interface{}), gtPath string, isRigParked func(string) bool, labels LabelSelector, resolver *StoreResolver) {
+	opts := townFeedOptions(townRoot)
+	opts.Labels = labels
+	FeedConvoy(ctx, store, townRoot, convoyID, caller, logger, gtPath, isRigParked, opts, resolver)
+}
+
+// FeedStrategy controls the order in which ready issues are considered.
+type FeedStrategy string
+
+const (
+	// FeedByPriority considers issues by priority (lower = higher), then ID.
+	FeedByPriority FeedStrategy = "priority"
+	// FeedFIFO considers issues in the order the convoy tracks them.
+	FeedFIFO FeedStrategy = "fifo"
+)
+
+// ParseFeedStrategy validates a strategy name. Empty means FeedByPriority.
+func ParseFeedStrategy(name string) (FeedStrategy, error) {
+	switch FeedStrategy(name) {
+	case "", FeedByPriority:
+		return FeedByPriority, nil
+	case FeedFIFO:
+		return FeedFIFO, nil
+	}
+	return "", fmt.Errorf("unknown feed strategy %q (expected %q or %q)", name, FeedByPriority, FeedFIFO)
+}
+
+// Skip reasons FeedConvoy reports that FeedMetrics classifies. The
+// dispatch failure and stage reasons are prefixes.
+const (
+	skipBlocked        = "blocked by open dependencies"
+	skipStageWaiting   = "stage "
+	skipDispatchFailed = "dispatch failed: "
+)
+
+// FeedOptions controls optional FeedConvoy behavior.
+type FeedOptions struct {
+	// DryRun computes and logs the routing decision without running gt sling.
+	DryRun bool
+
+	// Drain lets a dispatch already under way finish when ctx is
+	// cancelled, instead of killing its gt sling. Either way no new
+	// dispatch starts after cancellation. gt convoy feed --watch drains on
+	// shutdown; the daemon does not, so its shutdown isn't held up.
+	Drain bool
+
+	// Strategy orders the candidates. Empty means FeedByPriority.
+	Strategy FeedStrategy
+
+	// RigStrategy picks between ready issues bound for different rigs.
+	// Empty means RigByOrder.
+	RigStrategy RigStrategy
+
+	// Rotation carries round-robin state across feeds. Optional; without it
+	// round-robin starts from the first rig by name each time.
+	Rotation *RigRotation
+
+	// MaxPerRig, if positive, stops feeding a rig once it has this many
+	// in-flight issues from the convoy. When every ready issue's rig is
+	// at the limit, FeedResult.NoRigAvailable is set.
+	MaxPerRig int
+
+	// TypeRoutes maps issue types to the rig they are dispatched to,
+	// ahead of prefix routing (convoy.type_routes).
+	TypeRoutes map[string]string
+
+	// Pending maps issues already picked by earlier dry-run feeds in the
+	// same run to their rig. They are skipped and counted as in flight, so
+	// repeated dry runs step through the backlog as real dispatches would.
+	Pending map[string]string
+
+	// Labels, if not empty, limits feeding to issues that pass the
+	// selector. Other issues are skipped with their reason, just as
+	// non-slingable types are, and are left for another feeder.
+	Labels LabelSelector
+
+	// Assignee, if set, slings every dispatch to this worker address
+	// (rig/polecats/name or rig/crew/name; see ParseFeedAssignee) instead
+	// of the routed rig. Blocking, stage, and type filters still apply, but
+	// rig routing, RigStrategy, and MaxPerRig do not; a parked assignee rig
+	// still skips the issue.
+	Assignee string
+
+	// Decisions, if set, receives each decision the feed makes as it makes
+	// it, using the event sink's Event type: dispatches (dry-run picks have
+	// DryRun set), handled issues, skips with their reason, and capacity
+	// throttling. Decisions that are also convoy events still go to the
+	// event sink; dry-run picks and skips do not.
+	Decisions EventSink
+}
+
+// orderFeedCandidates sorts tracked issues in place for the strategy.
+func orderFeedCandidates(tracked []trackedIssue, strategy FeedStrategy) {
+	if strategy == FeedFIFO {
+		return
+	}
+	// Sort by priority (lower = higher) then by ID for deterministic tie-breaking.
+	sort.SliceStable(tracked, func(i, j int) bool {
+		if tracked[i].Priority != tracked[j].Priority {
+			return tracked[i].Priority < tracked[j].Priority
+		}
+		return tracked[i].ID < tracked[j].ID
+	})
+}
+
+// FeedConvoy selects the next ready issue in a convoy using the same rules as
+// reactive feeding and dispatches it via gt sling. With opts.DryRun set, the
+// routing decision is computed and logged but gt sling is not run.
+//
+// The result is never nil. Its IssueID is empty if no issue is ready. A
+// failed dispatch moves on to the next candidate, as in reactive feeding.
+func FeedConvoy(ctx context.Context, store IssueStore, townRoot, convoyID, caller string, logger func(format string, args ...interface{}), gtPath string, isRigParked func(string) bool, opts FeedOptions, resolver *StoreResolver) *FeedResult {
+	if logger == nil {
+		logger = func(format string, args ...interface{}) {} // no-op
+	}
+	log := levels(logger)
+	if isRigParked == nil {
+		isRigParked = func(string) bool { return false }
+	}
+
+	result := &FeedResult{DryRun: opts.DryRun}
 	tracked := getConvoyTrackedIssues(ctx, store, convoyID, townRoot, resolver)
 	if len(tracked) == 0 {
-		return
+		return result
 	}
 
-	// Extract base_branch from convoy description fields
+	// Extract base_branch and member stages from convoy description fields
 	var baseBranch string
+	var stages map[string]int
 	if convoy, err := store.GetIssue(ctx, convoyID); err == nil && convoy != nil {
 		if cf := beads.ParseConvoyFields(&beads.Issue{Description: convoy.Description}); cf != nil {
 			baseBranch = cf.BaseBranch
+			if cf.Stages != "" {
+				stages = beads.ParseConvoyStages(cf.Stages)
+			}
 		}
 	}
+	stage := activeStage(tracked, stages)
+	skip := func(issueID, rig, reason string) {
+		decide(opts.Decisions, Event{Type: EventIssueSkipped, ConvoyID: convoyID, IssueID: issueID, Rig: rig, Caller: caller, Reason: reason, DryRun: opts.DryRun}, false)
+	}
 
-	// Sort by priority (lower = higher) then by ID for deterministic tie-breaking.
-	sort.Slice(tracked, func(i, j int) bool {
-		if tracked[i].Priority != tracked[j].Priority {
-			return tracked[i].Priority < tracked[j].Priority
-		}
-		return tracked[i].ID < tracked[j].ID
-	})
+	orderFeedCandidates(tracked, opts.Strategy)
 
-	// Find the first ready issue (open, no assignee, not blocked).
+	// Collect ready issues (open, no assignee, not blocked).
+	var ready []feedCandidate
 	for _, issue := range tracked {
 		if issue.Status != "open" || issue.Assignee != "" {
 			continue
 		}
+		if _, picked := opts.Pending[issue.ID]; picked {
+			continue
+		}
 
 		// Filter non-slingable types: only leaf work items (task, bug,
 		// feature, chore) can be dispatched. Epics, convoys, and other
-		// container types are skipped.
-		if !IsSlingableType(issue.IssueType) {
-			logger("%s: convoy %s: %s has non-slingable type %q, skipping", caller, convoyID, issue.ID, issue.IssueType)
+		// container types are skipped. Actionable types are handled below.
+		actionable := IsActionableType(issue.IssueType)
+		if !actionable && !IsSlingableType(issue.IssueType) {
+			log.debugf("%s: convoy %s: %s has non-slingable type %q, skipping", caller, convoyID, issue.ID, issue.IssueType)
+			skip(issue.ID, "", fmt.Sprintf("non-slingable type %q", issue.IssueType))
+			continue
+		}
+
+		// A label selector leaves other issues for feeders that want them.
+		if reason := opts.Labels.mismatch(issue.Labels); reason != "" {
+			log.debugf("%s: convoy %s: %s has %s, skipping", caller, convoyID, issue.ID, reason)
+			skip(issue.ID, "", reason)
+			continue
+		}
+
+		// Later stages wait until every member of the active stage closes.
+		if n := stageOf(stages, issue.ID); stages != nil && n > stage {
+			log.debugf("%s: convoy %s: %s is in stage %d, waiting for stage %d to close", caller, convoyID, issue.ID, n, stage)
+			skip(issue.ID, "", fmt.Sprintf(skipStageWaiting+"%d waiting for stage %d", n, stage))
+			result.Blocked = append(result.Blocked, issue.ID)
 			continue
 		}
 
@@ -322,38 +627,160 @@ func feedNextReadyIssue(ctx context.Context, store beadsdk.Storage, townRoot, co
 		// non-closed targets prevent dispatch. parent-child is NOT treated
 		// as blocking (consistent with molecule step behavior).
 		if isIssueBlocked(ctx, store, issue.ID, resolver) {
-			logger("%s: convoy %s: %s is blocked, skipping", caller, convoyID, issue.ID)
+			log.debugf("%s: convoy %s: %s is blocked, skipping", caller, convoyID, issue.ID)
+			skip(issue.ID, "", skipBlocked)
+			result.Blocked = append(result.Blocked, issue.ID)
+			continue
+		}
+
+		if actionable {
+			handleFeedActionable(ctx, store, resolver, townRoot, convoyID, caller, gtPath, issue, opts, logger, result)
 			continue
 		}
 
-		// Determine target rig from issue prefix
-		rig := rigForIssue(townRoot, issue.ID)
+		// Determine target rig from a forced assignee, a type route, or
+		// the issue prefix. A forced assignee may be outside the prefix's
+		// rig, so it overrides the cross-rig guard like a type route.
+		var rig string
+		var byType bool
+		if opts.Assignee != "" {
+			rig, _, _, _ = ParseFeedAssignee(opts.Assignee)
+			byType = true
+		} else {
+			rig, byType = routeIssue(townRoot, issue.ID, issue.IssueType, opts.TypeRoutes)
+		}
 		if rig == "" {
-			logger("%s: convoy %s: cannot determine rig for issue %s, skipping", caller, convoyID, issue.ID)
+			log.warnf("%s: convoy %s: cannot determine rig for issue %s, skipping", caller, convoyID, issue.ID)
+			skip(issue.ID, "", "no rig for prefix")
 			continue
 		}
 
 		if isRigParked(rig) {
-			logger("%s: convoy %s: rig %s is parked, skipping %s", caller, convoyID, rig, issue.ID)
+			log.infof("%s: convoy %s: rig %s is parked, skipping %s", caller, convoyID, rig, issue.ID)
+			skip(issue.ID, rig, "rig parked")
+			continue
+		}
+
+		ready = append(ready, feedCandidate{issueID: issue.ID, rig: rig, byType: byType})
+	}
+
+	// Dispatch one issue, moving to the next candidate on failure.
+	load := rigLoad(tracked)
+	for _, rig := range opts.Pending {
+		load[rig]++
+	}
+	result.Ready = len(ready)
+	for _, n := range load {
+		result.InFlight += n
+	}
+	maxPerRig := opts.MaxPerRig
+	if opts.Assignee != "" {
+		maxPerRig = 0 // the caller chose the worker; capacity is theirs to judge
+	}
+	for len(ready) > 0 {
+		if ctx.Err() != nil {
+			log.infof("%s: convoy %s: shutting down, leaving %d ready issue(s) open", caller, convoyID, len(ready))
+			return result
+		}
+		i, ok := pickCandidate(ready, load, opts.RigStrategy, opts.Rotation, maxPerRig)
+		if !ok {
+			log.infof("%s: convoy %s: no capacity: all %d ready issue(s) route to rigs at the %d in-flight limit, leaving them open", caller, convoyID, len(ready), opts.MaxPerRig)
+			result.NoRigAvailable = true
+			decide(opts.Decisions, Event{Type: EventCapacityThrottled, ConvoyID: convoyID, Caller: caller, Ready: len(ready), DryRun: opts.DryRun}, true)
+			return result
+		}
+		c := ready[i]
+		ready = append(ready[:i], ready[i+1:]...)
+		target := c.rig
+		if opts.Assignee != "" {
+			target = opts.Assignee
+		}
+
+		if opts.DryRun {
+			log.infof("%s: convoy %s: would feed next ready issue %s to %s (dry run)", caller, convoyID, c.issueID, target)
+			decide(opts.Decisions, Event{Type: EventIssueDispatched, ConvoyID: convoyID, IssueID: c.issueID, Rig: c.rig, Assignee: opts.Assignee, Caller: caller, DryRun: true}, false)
+			result.IssueID, result.Rig, result.Assignee = c.issueID, c.rig, opts.Assignee
+			result.Ready--
+			return result
+		}
+
+		// Claim before slinging so a concurrent or restarted feeder that
+		// read the same snapshot can't dispatch the issue a second time.
+		dispatchCtx := ctx
+		if opts.Drain {
+			dispatchCtx = context.WithoutCancel(ctx)
+		}
+		release, err := claimForDispatch(dispatchCtx, store, resolver, c.issueID, convoyID)
+		if err != nil {
+			log.infof("%s: convoy %s: %s already claimed (%s), skipping", caller, convoyID, c.issueID, util.FirstLine(err.Error()))
+			skip(c.issueID, c.rig, "already claimed")
 			continue
 		}
 
-		logger("%s: convoy %s: feeding next ready issue %s to %s", caller, convoyID, issue.ID, rig)
-		if err := dispatchIssue(ctx, townRoot, issue.ID, rig, gtPath, baseBranch); err != nil {
-			logger("%s: convoy %s: dispatch %s failed: %s", caller, convoyID, issue.ID, util.FirstLine(err.Error()))
+		switch {
+		case opts.Assignee != "":
+			log.infof("%s: convoy %s: feeding next ready issue %s to %s (forced assignee)", caller, convoyID, c.issueID, target)
+		case c.byType:
+			log.infof("%s: convoy %s: feeding next ready issue %s to %s (type route)", caller, convoyID, c.issueID, c.rig)
+		default:
+			log.infof("%s: convoy %s: feeding next ready issue %s to %s", caller, convoyID, c.issueID, c.rig)
+		}
+		if err := dispatchIssue(dispatchCtx, townRoot, c.issueID, target, gtPath, baseBranch, c.byType); err != nil {
+			release()
+			log.warnf("%s: convoy %s: dispatch %s failed: %s", caller, convoyID, c.issueID, util.FirstLine(err.Error()))
+			skip(c.issueID, c.rig, skipDispatchFailed+util.FirstLine(err.Error()))
 			continue // Try next issue on dispatch failure
 		}
-		return // Successfully dispatched one issue
+		opts.Rotation.advance(c.rig)
+		decide(opts.Decisions, Event{Type: EventIssueDispatched, ConvoyID: convoyID, IssueID: c.issueID, Rig: c.rig, Assignee: opts.Assignee, Caller: caller}, true)
+		result.IssueID, result.Rig, result.Assignee = c.issueID, c.rig, opts.Assignee
+		result.Ready--
+		result.InFlight++
+		return result // Successfully dispatched one issue
 	}
 
-	logger("%s: convoy %s: no ready issues to feed", caller, convoyID)
+	if len(result.Blocked) > 0 {
+		log.infof("%s: convoy %s: no ready issues to feed (%d blocked: %s)", caller, convoyID, len(result.Blocked), strings.Join(result.Blocked, ", "))
+		result.Cycles = FindBlockingCycles(ctx, store, result.Blocked, resolver)
+		for _, c := range result.Cycles {
+			log.errorf("%s: convoy %s: dependency cycle %s: these issues block each other and will never be ready", caller, convoyID, FormatCycle(c))
+		}
+	} else {
+		log.infof("%s: convoy %s: no ready issues to feed", caller, convoyID)
+	}
+	return result
+}
+
+// handleFeedActionable runs handleActionable for one ready issue during a
+// feed, logging the outcome and recording it on result.
+func handleFeedActionable(ctx context.Context, store IssueStore, resolver *StoreResolver, townRoot, convoyID, caller, gtPath string, issue trackedIssue, opts FeedOptions, logger func(format string, args ...interface{}), result *FeedResult) {
+	log := levels(logger)
+	if opts.DryRun {
+		log.infof("%s: convoy %s: would handle %s issue %s (dry run)", caller, convoyID, issue.IssueType, issue.ID)
+		decide(opts.Decisions, Event{Type: EventIssueHandled, ConvoyID: convoyID, IssueID: issue.ID, Caller: caller, DryRun: true}, false)
+		result.Handled = append(result.Handled, issue.ID)
+		return
+	}
+	action, err := handleActionable(ctx, store, resolver, townRoot, convoyID, gtPath, issue)
+	if action == "" {
+		log.warnf("%s: convoy %s: could not handle %s issue %s: %s", caller, convoyID, issue.IssueType, issue.ID, util.FirstLine(err.Error()))
+		decide(opts.Decisions, Event{Type: EventIssueSkipped, ConvoyID: convoyID, IssueID: issue.ID, Caller: caller, Reason: "could not handle: " + util.FirstLine(err.Error())}, false)
+		return
+	}
+	if err != nil {
+		log.warnf("%s: convoy %s: %s %s but %s", caller, convoyID, action, issue.ID, util.FirstLine(err.Error()))
+	} else {
+		log.infof("%s: convoy %s: %s %s issue %s and closed it", caller, convoyID, action, issue.IssueType, issue.ID)
+	}
+	decide(opts.Decisions, Event{Type: EventIssueHandled, ConvoyID: convoyID, IssueID: issue.ID, Caller: caller, Action: action}, true)
+	result.Handled = append(result.Handled, issue.ID)
 }
 
 // getConvoyTrackedIssues returns issues tracked by a convoy with fresh status.
 // Uses SDK GetDependenciesWithMetadata filtered by tracks, then GetIssuesByIDs for current status.
 // When a StoreResolver is provided, cross-rig beads are resolved via direct store queries.
 // Otherwise falls back to bd show subprocess via fetchCrossRigBeadStatus.
-func getConvoyTrackedIssues(ctx context.Context, store beadsdk.Storage, convoyID, townRoot string, resolver *StoreResolver) []trackedIssue {
+func getConvoyTrackedIssues(ctx context.Context, store IssueStore, convoyID, townRoot string, resolver *StoreResolver) []trackedIssue {
 	deps, err := store.GetDependenciesWithMetadata(ctx, convoyID)
 	if err != nil || len(deps) == 0 {
 		return nil
@@ -366,6 +793,7 @@ func getConvoyTrackedIssues(ctx context.Context, store beadsdk.Storage, convoyID
 		assignee  string
 		priority  int
 		issueType string
+		labels    []string
 	}
 	metaByID := make(map[string]depMeta)
 	for _, d := range deps {
@@ -377,6 +805,7 @@ func getConvoyTrackedIssues(ctx context.Context, store beadsdk.Storage, convoyID
 				assignee:  d.Assignee,
 				priority:  d.Priority,
 				issueType: string(d.IssueType),
+				labels:    d.Labels,
 			}
 		}
 	}
@@ -431,11 +860,14 @@ func getConvoyTrackedIssues(ctx context.Context, store beadsdk.Storage, convoyID
 			t.Assignee = fresh.Assignee
 			t.Priority = fresh.Priority
 			t.IssueType = string(fresh.IssueType)
+			t.Labels = fresh.Labels
+			t.AssignedAt = assignedAt(fresh.Description)
 		} else if meta, ok := metaByID[id]; ok {
 			t.Status = meta.status
 			t.Assignee = meta.assignee
 			t.Priority = meta.priority
 			t.IssueType = meta.issueType
+			t.Labels = meta.labels
 		}
 		result = append(result, t)
 	}
@@ -443,15 +875,10 @@ func getConvoyTrackedIssues(ctx context.Context, store beadsdk.Storage, convoyID
 	return result
 }
 
-// extractIssueID strips the external:prefix:id wrapper from bead IDs.
+// extractIssueID strips the external:prefix:id wrapper from bead IDs. See
+// ParseIssueRef, which also keeps the provider.
 func extractIssueID(id string) string {
-	if strings.HasPrefix(id, "external:") {
-		parts := strings.SplitN(id, ":", 3)
-		if len(parts) == 3 {
-			return parts[2]
-		}
-	}
-	return id
+	return ParseIssueRef(id).ID
 }
 
 // rigForIssue determines the rig name for an issue based on its ID prefix.
@@ -499,11 +926,12 @@ func fetchCrossRigBeadStatus(townRoot string, ids []string) map[string]*beadsdk.
 		}
 
 		var items []struct {
-			ID       string `json:"id"`
-			Status   string `json:"status"`
-			Assignee string `json:"assignee"`
-			Priority int    `json:"priority"`
-			Type     string `json:"issue_type"`
+			ID       string   `json:"id"`
+			Status   string   `json:"status"`
+			Assignee string   `json:"assignee"`
+			Priority int      `json:"priority"`
+			Type     string   `json:"issue_type"`
+			Labels   []string `json:"labels"`
 		}
 		if err := json.Unmarshal(out, &items); err != nil {
 			continue
@@ -515,6 +943,7 @@ func fetchCrossRigBeadStatus(townRoot string, ids []string) map[string]*beadsdk.
 				Assignee:  item.Assignee,
 				Priority:  item.Priority,
 				IssueType: beadsdk.IssueType(item.Type),
+				Labels:    item.Labels,
 			}
 		}
 	}
@@ -522,14 +951,20 @@ func fetchCrossRigBeadStatus(townRoot string, ids []string) map[string]*beadsdk.
 	return result
 }
 
-// dispatchIssue dispatches an issue to a rig via gt sling.
+// dispatchIssue dispatches an issue to a rig (or a forced worker address) via gt sling.
 // The context parameter enables cancellation on daemon shutdown.
 // gtPath is the resolved path to the gt binary.
-func dispatchIssue(ctx context.Context, townRoot, issueID, rig, gtPath, baseBranch string) error {
+func dispatchIssue(ctx context.Context, townRoot, issueID, rig, gtPath, baseBranch string, crossRig bool) error {
 	args := []string{"sling", issueID, rig, "--no-boot"}
 	if baseBranch != "" {
 		args = append(args, "--base-branch="+baseBranch)
 	}
+	if crossRig {
+		// A type route or forced assignee overrides only the cross-rig
+		// guard. --force would also reopen the feed's claim and skip sling's
+		// other guards.
+		args = append(args, "--allow-cross-rig")
+	}
 	cmd := exec.CommandContext(ctx, gtPath, args...)
 	cmd.Dir = townRoot
 	util.SetProcessGroup(cmd)
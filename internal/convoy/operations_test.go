@@ -5,12 +5,14 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"reflect"
 	"runtime"
 	"strings"
 	"testing"
 	"time"
 
 	beadsdk "github.com/steveyegge/beads"
+	"github.com/steveyegge/gastown/internal/config"
 )
 
 func TestExtractIssueID(t *testing.T) {
@@ -28,6 +30,13 @@ func TestExtractIssueID(t *testing.T) {
 		{"external:x:", ""},        // 3 parts but empty last part
 		{"simple", "simple"},       // no external prefix
 		{"", ""},                   // empty
+
+		// Nested wrappers collapse to the innermost ID; URLs reduce to the last segment.
+		{"external:gh:external:gt:gt-abc", "gt-abc"},
+		{"https://tracker.example.com/issues/gt-abc", "gt-abc"},
+		{"https://tracker.example.com/issues/gt-abc/", "gt-abc"},
+		{"external:gh:https://github.com/o/r/issues/42", "42"},
+		{"https://tracker.example.com/", "https://tracker.example.com/"}, // no ID segment, as-is
 	}
 
 	for _, tt := range tests {
@@ -69,6 +78,98 @@ func TestIsSlingableType(t *testing.T) {
 	}
 }
 
+func TestIsSlingableType_Configured(t *testing.T) {
+	SetSlingableTypes([]string{"task", "spike", " research "})
+	t.Cleanup(func() { SetSlingableTypes(nil) })
+
+	tests := []struct {
+		issueType string
+		want      bool
+	}{
+		{"task", true},
+		{"spike", true},
+		{"research", true},
+		{"", true},     // empty still defaults to task
+		{"bug", false}, // not in the configured set
+		{"epic", false},
+	}
+	for _, tt := range tests {
+		if got := IsSlingableType(tt.issueType); got != tt.want {
+			t.Errorf("IsSlingableType(%q) = %v, want %v", tt.issueType, got, tt.want)
+		}
+	}
+
+	SetSlingableTypes(nil)
+	if !IsSlingableType("bug") || IsSlingableType("spike") {
+		t.Error("SetSlingableTypes(nil) should restore the built-in defaults")
+	}
+}
+
+func TestLoadSlingableTypes(t *testing.T) {
+	townRoot := t.TempDir()
+	t.Cleanup(func() { SetSlingableTypes(nil) })
+
+	// Missing settings file: defaults.
+	LoadSlingableTypes(townRoot)
+	if !IsSlingableType("chore") {
+		t.Error("expected defaults when settings are missing")
+	}
+
+	settings := config.NewTownSettings()
+	settings.Convoy = &config.ConvoyConfig{SlingableTypes: []string{"spike"}}
+	if err := config.SaveTownSettings(config.TownSettingsPath(townRoot), settings); err != nil {
+		t.Fatalf("SaveTownSettings: %v", err)
+	}
+	LoadSlingableTypes(townRoot)
+	if !IsSlingableType("spike") || IsSlingableType("chore") {
+		t.Error("expected configured set after loading settings")
+	}
+}
+
+func TestOrderFeedCandidates(t *testing.T) {
+	candidates := func() []trackedIssue {
+		return []trackedIssue{
+			{ID: "gt-a1", Priority: 3, IssueType: "task"},
+			{ID: "gt-b2", Priority: 0, IssueType: "bug"},
+			{ID: "gt-c3", Priority: 3, IssueType: "task"},
+		}
+	}
+	ids := func(issues []trackedIssue) []string {
+		var out []string
+		for _, i := range issues {
+			out = append(out, i.ID)
+		}
+		return out
+	}
+
+	tests := []struct {
+		strategy FeedStrategy
+		want     string
+	}{
+		{FeedByPriority, "gt-b2 gt-a1 gt-c3"}, // urgent bug beats earlier low-priority task
+		{"", "gt-b2 gt-a1 gt-c3"},             // default is priority
+		{FeedFIFO, "gt-a1 gt-b2 gt-c3"},       // tracked order untouched
+	}
+	for _, tt := range tests {
+		tracked := candidates()
+		orderFeedCandidates(tracked, tt.strategy)
+		if got := strings.Join(ids(tracked), " "); got != tt.want {
+			t.Errorf("orderFeedCandidates(%q) = %s, want %s", tt.strategy, got, tt.want)
+		}
+	}
+}
+
+func TestParseFeedStrategy(t *testing.T) {
+	for _, name := range []string{"", "priority", "fifo"} {
+		if _, err := ParseFeedStrategy(name); err != nil {
+			t.Errorf("ParseFeedStrategy(%q) error: %v", name, err)
+		}
+	}
+	if _, err := ParseFeedStrategy("random"); err == nil {
+		t.Error("ParseFeedStrategy(\"random\") should fail")
+	}
+}
+
 func TestIsIssueBlocked_NoStore(t *testing.T) {
 	// isIssueBlocked with nil store should fail-open (return false, not panic).
 	// This covers the "store unavailable" failure mode (F-17).
@@ -150,19 +251,19 @@ func TestReadyIssueFilterLogic_FindsReadyIssue(t *testing.T) {
 }
 
 func TestCheckConvoysForIssue_NilStore(t *testing.T) {
-	// Nil store returns nil immediately (no convoy checks).
+	// Nil store returns the zero result immediately (no convoy checks).
 	result := CheckConvoysForIssue(context.Background(), nil, "/nonexistent/path", "gt-test", "test", nil, "gt", nil)
-	if result != nil {
-		t.Errorf("expected nil for nil store, got %v", result)
+	if !reflect.DeepEqual(result, CheckResult{}) {
+		t.Errorf("expected zero result for nil store, got %+v", result)
 	}
 }
 
 func TestCheckConvoysForIssue_NilLogger(t *testing.T) {
 	// Nil logger should not panic — gets replaced with no-op internally.
-	// With nil store, returns nil.
+	// With nil store, returns the zero result.
 	result := CheckConvoysForIssue(context.Background(), nil, "/nonexistent/path", "gt-test", "test", nil, "gt", nil)
-	if result != nil {
-		t.Errorf("expected nil for nil store, got %v", result)
+	if !reflect.DeepEqual(result, CheckResult{}) {
+		t.Errorf("expected zero result for nil store, got %+v", result)
 	}
 }
 
@@ -806,7 +907,7 @@ func TestFeedNextReadyIssue_DispatchesFirstReadyIssue(t *testing.T) {
 	gtPath, logPath := makeGTStub(t, 0)
 	logger, _ := makeLogger()
 
-	feedNextReadyIssue(ctx, store, townRoot, convoy.ID, "test", logger, gtPath, func(string) bool { return false }, nil)
+	feedNextReadyIssue(ctx, store, townRoot, convoy.ID, "test", logger, gtPath, func(string) bool { return false }, LabelSelector{}, nil)
 
 	// Verify gt was called with the ready issue
 	logData, err := os.ReadFile(logPath)
@@ -820,6 +921,64 @@ func TestFeedNextReadyIssue_DispatchesFirstReadyIssue(t *testing.T) {
 	}
 }
 
+func TestFeedConvoy_DryRunSkipsDispatch(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("skipping on windows")
+	}
+
+	store, cleanup := setupTestStore(t)
+	defer cleanup()
+
+	ctx := context.Background()
+	now := time.Now().UTC()
+
+	convoy := &beadsdk.Issue{
+		ID:        "test-convoydry",
+		Title:     "Dry Run Convoy",
+		Status:    beadsdk.StatusOpen,
+		Priority:  2,
+		IssueType: beadsdk.TypeTask,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+	ready := &beadsdk.Issue{
+		ID:        "test-dryready",
+		Title:     "Ready Task",
+		Status:    beadsdk.StatusOpen,
+		Priority:  2,
+		IssueType: beadsdk.TypeTask,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+	for _, iss := range []*beadsdk.Issue{convoy, ready} {
+		if err := store.CreateIssue(ctx, iss, "test"); err != nil {
+			t.Fatalf("CreateIssue %s: %v", iss.ID, err)
+		}
+	}
+	dep := &beadsdk.Dependency{
+		IssueID:     convoy.ID,
+		DependsOnID: ready.ID,
+		Type:        beadsdk.DependencyType("tracks"),
+		CreatedAt:   now,
+		CreatedBy:   "test",
+	}
+	if err := store.AddDependency(ctx, dep, "test"); err != nil {
+		t.Fatalf("AddDependency: %v", err)
+	}
+
+	townRoot := setupTownRoot(t)
+	gtPath, logPath := makeGTStub(t, 0)
+	logger, _ := makeLogger()
+
+	result := FeedConvoy(ctx, store, townRoot, convoy.ID, "test", logger, gtPath, nil, FeedOptions{DryRun: true}, nil)
+	if result.IssueID != "test-dryready" || result.Rig != "testrig" || !result.DryRun {
+		t.Errorf("FeedConvoy(dryRun) = %+v, want test-dryready -> testrig (dry run)", *result)
+	}
+	if _, err := os.Stat(logPath); err == nil {
+		t.Error("dry run should not call gt sling, but stub log exists")
+	}
+}
+
 func TestFeedNextReadyIssue_SkipsEpicAndDispatchesTask(t *testing.T) {
 	if runtime.GOOS == "windows" {
 		t.Skip("skipping on windows")
@@ -883,7 +1042,7 @@ func TestFeedNextReadyIssue_SkipsEpicAndDispatchesTask(t *testing.T) {
 	gtPath, logPath := makeGTStub(t, 0)
 	logger, _ := makeLogger()
 
-	feedNextReadyIssue(ctx, store, townRoot, convoy.ID, "test", logger, gtPath, func(string) bool { return false }, nil)
+	feedNextReadyIssue(ctx, store, townRoot, convoy.ID, "test", logger, gtPath, func(string) bool { return false }, LabelSelector{}, nil)
 
 	logData, err := os.ReadFile(logPath)
 	if err != nil {
@@ -986,7 +1145,7 @@ func TestFeedNextReadyIssue_SkipsBlockedIssue(t *testing.T) {
 	gtPath, logPath := makeGTStub(t, 0)
 	logger, logMsgs := makeLogger()
 
-	feedNextReadyIssue(ctx, store, townRoot, convoy.ID, "test", logger, gtPath, func(string) bool { return false }, nil)
+	feedNextReadyIssue(ctx, store, townRoot, convoy.ID, "test", logger, gtPath, func(string) bool { return false }, LabelSelector{}, nil)
 
 	logData, err := os.ReadFile(logPath)
 	if err != nil {
@@ -1007,6 +1166,86 @@ func TestFeedNextReadyIssue_SkipsBlockedIssue(t *testing.T) {
 	}
 }
 
+func TestFeedConvoy_ReportsBlockedAndPicksUpAfterBlockerCloses(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("skipping on windows")
+	}
+
+	store, cleanup := setupTestStore(t)
+	defer cleanup()
+
+	ctx := context.Background()
+	now := time.Now().UTC()
+
+	convoy := &beadsdk.Issue{
+		ID:        "test-convoyblk",
+		Title:     "Convoy For Unblock Test",
+		Status:    beadsdk.StatusOpen,
+		Priority:  2,
+		IssueType: beadsdk.TypeTask,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+	blocker := &beadsdk.Issue{
+		ID:        "test-blkr",
+		Title:     "Blocker",
+		Status:    beadsdk.StatusOpen,
+		Priority:  1,
+		IssueType: beadsdk.TypeTask,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+	dependent := &beadsdk.Issue{
+		ID:        "test-dpnd",
+		Title:     "Dependent Task",
+		Status:    beadsdk.StatusOpen,
+		Priority:  2,
+		IssueType: beadsdk.TypeTask,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+	for _, iss := range []*beadsdk.Issue{convoy, blocker, dependent} {
+		if err := store.CreateIssue(ctx, iss, "test"); err != nil {
+			t.Fatalf("CreateIssue %s: %v", iss.ID, err)
+		}
+	}
+	deps := []*beadsdk.Dependency{
+		{IssueID: convoy.ID, DependsOnID: dependent.ID, Type: beadsdk.DependencyType("tracks"), CreatedAt: now, CreatedBy: "test"},
+		{IssueID: dependent.ID, DependsOnID: blocker.ID, Type: beadsdk.DepBlocks, CreatedAt: now, CreatedBy: "test"},
+	}
+	for _, dep := range deps {
+		if err := store.AddDependency(ctx, dep, "test"); err != nil {
+			t.Fatalf("AddDependency %s -> %s: %v", dep.IssueID, dep.DependsOnID, err)
+		}
+	}
+
+	townRoot := setupTownRoot(t)
+	gtPath, _ := makeGTStub(t, 0)
+	logger, logMsgs := makeLogger()
+	opts := FeedOptions{DryRun: true}
+
+	first := FeedConvoy(ctx, store, townRoot, convoy.ID, "test", logger, gtPath, nil, opts, nil)
+	if first.IssueID != "" {
+		t.Fatalf("first feed picked %s while its blocker is open", first.IssueID)
+	}
+	if len(first.Blocked) != 1 || first.Blocked[0] != dependent.ID {
+		t.Logf("log messages: %v", *logMsgs)
+		t.Skipf("blocked issue not reported (%v) — likely embedded Dolt nested query limitation", first.Blocked)
+	}
+
+	if err := store.CloseIssue(ctx, blocker.ID, "done", "test", ""); err != nil {
+		t.Fatalf("CloseIssue blocker: %v", err)
+	}
+
+	second := FeedConvoy(ctx, store, townRoot, convoy.ID, "test", logger, gtPath, nil, opts, nil)
+	if second.IssueID != dependent.ID {
+		t.Errorf("after blocker closed, feed picked %q, want %q", second.IssueID, dependent.ID)
+	}
+	if len(second.Blocked) != 0 {
+		t.Errorf("after blocker closed, Blocked = %v, want empty", second.Blocked)
+	}
+}
+
 func TestFeedNextReadyIssue_NoReadyIssues_LogsMessage(t *testing.T) {
 	if runtime.GOOS == "windows" {
 		t.Skip("skipping on windows")
@@ -1069,7 +1308,7 @@ func TestFeedNextReadyIssue_NoReadyIssues_LogsMessage(t *testing.T) {
 	gtPath, _ := makeGTStub(t, 0)
 	logger, logMsgs := makeLogger()
 
-	feedNextReadyIssue(ctx, store, townRoot, convoy.ID, "test", logger, gtPath, func(string) bool { return false }, nil)
+	feedNextReadyIssue(ctx, store, townRoot, convoy.ID, "test", logger, gtPath, func(string) bool { return false }, LabelSelector{}, nil)
 
 	// Verify "no ready issues to feed" was logged
 	found := false
@@ -1136,7 +1375,7 @@ func TestFeedNextReadyIssue_SkipsParkedRig(t *testing.T) {
 	logger, logMsgs := makeLogger()
 
 	// isRigParked always returns true
-	feedNextReadyIssue(ctx, store, townRoot, convoy.ID, "test", logger, gtPath, func(string) bool { return true }, nil)
+	feedNextReadyIssue(ctx, store, townRoot, convoy.ID, "test", logger, gtPath, func(string) bool { return true }, LabelSelector{}, nil)
 
 	// gt should NOT have been called
 	if _, err := os.ReadFile(logPath); err == nil {
@@ -1170,7 +1409,7 @@ func TestDispatchIssue_Success(t *testing.T) {
 	townRoot := t.TempDir()
 	gtPath, logPath := makeGTStub(t, 0)
 
-	err := dispatchIssue(context.Background(), townRoot, "test-abc", "myrig", gtPath, "")
+	err := dispatchIssue(context.Background(), townRoot, "test-abc", "myrig", gtPath, "", false)
 	if err != nil {
 		t.Fatalf("dispatchIssue returned error: %v", err)
 	}
@@ -1194,7 +1433,7 @@ func TestDispatchIssue_Failure(t *testing.T) {
 	townRoot := t.TempDir()
 	gtPath, _ := makeGTStub(t, 1)
 
-	err := dispatchIssue(context.Background(), townRoot, "test-fail", "myrig", gtPath, "")
+	err := dispatchIssue(context.Background(), townRoot, "test-fail", "myrig", gtPath, "", false)
 	if err == nil {
 		t.Fatal("dispatchIssue should return error when gt exits 1")
 	}
@@ -1267,7 +1506,7 @@ func TestCheckConvoysForIssue_SkipsStagedReady(t *testing.T) {
 	result := CheckConvoysForIssue(ctx, store, townRoot, tracked.ID, "DS-07", logger, gtPath, nil)
 
 	// The convoy should be returned (it was found as a tracker)
-	if len(result) == 0 {
+	if len(result.ConvoyIDs) == 0 {
 		t.Skipf("no tracking convoys found — GetDependentsWithMetadata may not work in embedded Dolt")
 	}
 
@@ -1352,7 +1591,7 @@ func TestCheckConvoysForIssue_SkipsStagedWarnings(t *testing.T) {
 
 	result := CheckConvoysForIssue(ctx, store, townRoot, tracked.ID, "DS-08", logger, gtPath, nil)
 
-	if len(result) == 0 {
+	if len(result.ConvoyIDs) == 0 {
 		t.Skipf("no tracking convoys found — GetDependentsWithMetadata may not work in embedded Dolt")
 	}
 
@@ -1442,7 +1681,7 @@ func TestCheckConvoysForIssue_FeedsAfterStagedToOpenTransition(t *testing.T) {
 	gtPath, _ := makeGTStub(t, 0)
 
 	result1 := CheckConvoysForIssue(ctx, store, townRoot, tracked.ID, "DS-10-staged", logger1, gtPath, nil)
-	if len(result1) == 0 {
+	if len(result1.ConvoyIDs) == 0 {
 		t.Skipf("no tracking convoys found — GetDependentsWithMetadata may not work in embedded Dolt")
 	}
 
@@ -0,0 +1,109 @@
+package convoy
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	beadsdk "github.com/steveyegge/beads"
+)
+
+// IssueSearcher is a store that can run filtered issue queries. The beads
+// store is one.
+type IssueSearcher interface {
+	SearchIssues(ctx context.Context, query string, filter beadsdk.IssueFilter) ([]*beadsdk.Issue, error)
+}
+
+var _ IssueSearcher = beadsdk.Storage(nil)
+
+// ActiveStatuses are the statuses of issues that aren't closed. The beads
+// store returns only open and in-progress issues when a filter names no
+// status, so queries for everything still live list these.
+var ActiveStatuses = []beadsdk.Status{beadsdk.StatusOpen, beadsdk.StatusInProgress, beadsdk.StatusBlocked, beadsdk.StatusDeferred, beadsdk.Status("hooked"), beadsdk.Status("pinned")}
+
+// PageOptions bounds an issue listing. A Limit of 0 means no limit.
+type PageOptions struct {
+	Limit  int
+	Offset int
+}
+
+// IssuePage is one page of an ordered issue listing.
+type IssuePage struct {
+	Issues []*beadsdk.Issue
+	Offset int
+	Total  int // matching issues across all pages
+}
+
+// Remaining is the number of matching issues after this page.
+func (p *IssuePage) Remaining() int {
+	return max(0, p.Total-p.Offset-len(p.Issues))
+}
+
+// NextOffset is the offset of the page after this one.
+func (p *IssuePage) NextOffset() int {
+	return p.Offset + len(p.Issues)
+}
+
+// ListIssuePage returns one page of the issues matching filter and, when
+// keep is set, keep. Issues are ordered by priority, then newest first, then
+// ID, as beads lists them; the order is applied here too, so page
+// boundaries don't depend on the store and consecutive pages neither
+// overlap nor skip while the issues are unchanged. The store has no offset
+// or count, so the matches are fetched in full and the page is cut from
+// them; filter.Limit is ignored.
+func ListIssuePage(ctx context.Context, store IssueSearcher, filter beadsdk.IssueFilter, keep func(*beadsdk.Issue) bool, opts PageOptions) (*IssuePage, error) {
+	if opts.Limit < 0 || opts.Offset < 0 {
+		return nil, fmt.Errorf("invalid page: limit %d, offset %d", opts.Limit, opts.Offset)
+	}
+	filter.Limit = 0
+	all, err := store.SearchIssues(ctx, "", filter)
+	if err != nil {
+		return nil, fmt.Errorf("listing issues: %w", err)
+	}
+	matches := all[:0]
+	for _, iss := range all {
+		if keep == nil || keep(iss) {
+			matches = append(matches, iss)
+		}
+	}
+	slices.SortStableFunc(matches, compareIssueOrder)
+
+	page := &IssuePage{Offset: opts.Offset, Total: len(matches)}
+	start := min(opts.Offset, len(matches))
+	end := len(matches)
+	if opts.Limit > 0 {
+		end = min(start+opts.Limit, end)
+	}
+	page.Issues = matches[start:end]
+	return page, nil
+}
+
+// compareIssueOrder orders issues by priority, then newest first, then ID.
+func compareIssueOrder(a, b *beadsdk.Issue) int {
+	if a.Priority != b.Priority {
+		return a.Priority - b.Priority
+	}
+	if c := b.CreatedAt.Compare(a.CreatedAt); c != 0 {
+		return c
+	}
+	return strings.Compare(a.ID, b.ID)
+}
+
+// IssuesWithPrefix returns up to limit issues that aren't closed whose IDs
+// start with prefix, in ID order, for completing a typed ID. Wisps are left
+// out.
+func IssuesWithPrefix(ctx context.Context, store IssueSearcher, prefix string, limit int) ([]*beadsdk.Issue, error) {
+	ephemeral := false
+	issues, err := store.SearchIssues(ctx, "", beadsdk.IssueFilter{
+		Statuses:  ActiveStatuses,
+		IDPrefix:  prefix,
+		Ephemeral: &ephemeral,
+		Limit:     limit,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("listing issues: %w", err)
+	}
+	slices.SortFunc(issues, func(a, b *beadsdk.Issue) int { return strings.Compare(a.ID, b.ID) })
+	return issues, nil
+}
@@ -0,0 +1,118 @@
+package convoy
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	beadsdk "github.com/steveyegge/beads"
+)
+
+func TestListIssuePage_PagesCoverEachIssueOnce(t *testing.T) {
+	base := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	var issues []*beadsdk.Issue
+	for i := 0; i < 23; i++ {
+		iss := memIssue(fmt.Sprintf("gt-%02d", i), beadsdk.StatusOpen, "")
+		iss.Priority = i % 3
+		// Pairs share a creation time so the ID tie-break is exercised.
+		iss.CreatedAt = base.Add(time.Duration(i/2) * time.Hour)
+		issues = append(issues, iss)
+	}
+	store := newMemStore(issues...)
+	ctx := context.Background()
+
+	full, err := ListIssuePage(ctx, store, beadsdk.IssueFilter{}, nil, PageOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if full.Total != 23 || len(full.Issues) != 23 || full.Remaining() != 0 {
+		t.Fatalf("unbounded page: total %d, %d issues, %d remaining", full.Total, len(full.Issues), full.Remaining())
+	}
+	for i := 1; i < len(full.Issues); i++ {
+		if compareIssueOrder(full.Issues[i-1], full.Issues[i]) >= 0 {
+			t.Fatalf("issues %s and %s out of order", full.Issues[i-1].ID, full.Issues[i].ID)
+		}
+	}
+
+	var paged []string
+	for offset := 0; ; {
+		page, err := ListIssuePage(ctx, store, beadsdk.IssueFilter{}, nil, PageOptions{Limit: 5, Offset: offset})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(page.Issues) == 0 {
+			break
+		}
+		if want := 23 - offset - len(page.Issues); page.Remaining() != want {
+			t.Errorf("page at %d: Remaining() = %d, want %d", offset, page.Remaining(), want)
+		}
+		for _, iss := range page.Issues {
+			paged = append(paged, iss.ID)
+		}
+		offset = page.NextOffset()
+	}
+	if len(paged) != len(full.Issues) {
+		t.Fatalf("pages returned %d issues, want %d", len(paged), len(full.Issues))
+	}
+	for i, iss := range full.Issues {
+		if paged[i] != iss.ID {
+			t.Fatalf("paged[%d] = %s, want %s", i, paged[i], iss.ID)
+		}
+	}
+}
+
+func TestListIssuePage_KeepAndBounds(t *testing.T) {
+	store := newMemStore(
+		memIssue("gt-a", beadsdk.StatusOpen, ""),
+		memIssue("gt-b", beadsdk.StatusOpen, "gastown/polecats/alpha"),
+		memIssue("gt-c", beadsdk.StatusOpen, ""),
+	)
+	ctx := context.Background()
+	unassigned := func(iss *beadsdk.Issue) bool { return iss.Assignee == "" }
+
+	page, err := ListIssuePage(ctx, store, beadsdk.IssueFilter{}, unassigned, PageOptions{Limit: 1, Offset: 1})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if page.Total != 2 || len(page.Issues) != 1 || page.Issues[0].ID != "gt-c" || page.Remaining() != 0 {
+		t.Errorf("page = total %d, %v, remaining %d; want total 2, [gt-c], remaining 0", page.Total, page.Issues, page.Remaining())
+	}
+
+	past, err := ListIssuePage(ctx, store, beadsdk.IssueFilter{}, nil, PageOptions{Limit: 2, Offset: 10})
+	if err != nil || len(past.Issues) != 0 || past.Remaining() != 0 {
+		t.Errorf("offset past the end = %v, %v; want an empty page", past, err)
+	}
+
+	if _, err := ListIssuePage(ctx, store, beadsdk.IssueFilter{}, nil, PageOptions{Limit: -1}); err == nil {
+		t.Error("negative limit accepted")
+	}
+}
+
+func TestIssuesWithPrefix(t *testing.T) {
+	closed := memIssue("gt-ab3", beadsdk.StatusClosed, "")
+	store := newMemStore(
+		memIssue("gt-ab2", beadsdk.StatusOpen, ""),
+		memIssue("gt-ab1", beadsdk.StatusInProgress, "gastown/polecats/alpha"),
+		memIssue("gt-xy1", beadsdk.StatusOpen, ""),
+		memIssue("hq-ab1", beadsdk.StatusOpen, ""),
+		closed,
+	)
+	ctx := context.Background()
+
+	issues, err := IssuesWithPrefix(ctx, store, "gt-ab", 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var got []string
+	for _, iss := range issues {
+		got = append(got, iss.ID)
+	}
+	if want := []string{"gt-ab1", "gt-ab2"}; fmt.Sprint(got) != fmt.Sprint(want) {
+		t.Errorf("IssuesWithPrefix(gt-ab) = %v, want %v", got, want)
+	}
+
+	if issues, err := IssuesWithPrefix(ctx, store, "", 2); err != nil || len(issues) != 2 {
+		t.Errorf("IssuesWithPrefix with limit 2 = %d issues, %v", len(issues), err)
+	}
+}
@@ -0,0 +1,182 @@
+package convoy
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/steveyegge/gastown/internal/config"
+	"github.com/steveyegge/gastown/internal/session"
+)
+
+// RigStrategy controls how a feed chooses between ready issues that route to
+// different rigs. An issue's rig is always fixed, by a type route or else its
+// prefix; the strategy only decides which rig receives the next dispatch.
+type RigStrategy string
+
+const (
+	// RigByOrder takes the first ready issue in candidate order (the default).
+	RigByOrder RigStrategy = "order"
+	// RigLeastLoaded prefers the rig with the fewest in-flight convoy issues.
+	RigLeastLoaded RigStrategy = "least-loaded"
+	// RigRoundRobin rotates dispatches across rigs. Rotation state lives in a
+	// RigRotation owned by the caller, so it persists across feeds.
+	RigRoundRobin RigStrategy = "round-robin"
+)
+
+// ParseRigStrategy validates a rig strategy name. Empty means RigByOrder.
+func ParseRigStrategy(name string) (RigStrategy, error) {
+	switch RigStrategy(name) {
+	case "", RigByOrder:
+		return RigByOrder, nil
+	case RigLeastLoaded, RigRoundRobin:
+		return RigStrategy(name), nil
+	}
+	return "", fmt.Errorf("unknown rig strategy %q (expected %q, %q, or %q)", name, RigByOrder, RigLeastLoaded, RigRoundRobin)
+}
+
+// LoadFeedOptions returns feed options from the town's convoy settings
+// (convoy.rig_strategy, convoy.max_per_rig, convoy.type_routes). Unset or
+// invalid settings fall back to the defaults. The returned options carry a
+// fresh RigRotation.
+func LoadFeedOptions(townRoot string) FeedOptions {
+	opts := FeedOptions{Rotation: &RigRotation{}}
+	settings, err := config.LoadOrCreateTownSettings(config.TownSettingsPath(townRoot))
+	if err != nil {
+		return opts
+	}
+	cc := settings.ConvoySettings()
+	if strategy, err := ParseRigStrategy(cc.RigStrategy); err == nil {
+		opts.RigStrategy = strategy
+	}
+	if cc.MaxPerRig > 0 {
+		opts.MaxPerRig = cc.MaxPerRig
+	}
+	opts.TypeRoutes = cc.TypeRoutes
+	return opts
+}
+
+// routeIssue returns the rig an issue is dispatched to. A type route for
+// issueType wins; otherwise the rig comes from the ID prefix. byType reports
+// that a type route chose a rig other than the prefix's, so the dispatch
+// must override gt sling's cross-rig guard.
+func routeIssue(townRoot, issueID, issueType string, typeRoutes map[string]string) (rig string, byType bool) {
+	prefixRig := rigForIssue(townRoot, issueID)
+	if routed := typeRoutes[issueType]; routed != "" {
+		return routed, routed != prefixRig
+	}
+	return prefixRig, false
+}
+
+// ParseFeedAssignee parses a forced feed assignee. A polecat is addressed as
+// session.ParseAssignee reads it (rig/polecats/name); a crew member
+// (rig/crew/name) is the one other worker a feed may target. crew reports
+// which form it was.
+func ParseFeedAssignee(assignee string) (rig, name string, crew, ok bool) {
+	if rig, name, ok := session.ParseAssignee(assignee); ok {
+		return rig, name, false, true
+	}
+	parts := strings.Split(strings.TrimSuffix(assignee, "/"), "/")
+	if len(parts) == 3 && parts[1] == "crew" && parts[0] != "" && parts[2] != "" {
+		return parts[0], parts[2], true, true
+	}
+	return "", "", false, false
+}
+
+// RigRotation remembers the last rig fed for round-robin selection. The zero
+// value is ready to use and safe for concurrent feeds.
+type RigRotation struct {
+	mu   sync.Mutex
+	last string
+}
+
+func (r *RigRotation) lastRig() string {
+	if r == nil {
+		return ""
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.last
+}
+
+func (r *RigRotation) advance(rig string) {
+	if r == nil {
+		return
+	}
+	r.mu.Lock()
+	r.last = rig
+	r.mu.Unlock()
+}
+
+// feedCandidate is a ready issue together with the rig it routes to.
+type feedCandidate struct {
+	issueID string
+	rig     string
+	byType  bool // rig chosen by a type route across rigs; see routeIssue
+}
+
+// rigLoad counts in-flight convoy issues per rig: tracked issues that are not
+// closed and have an assignee. The rig is the first segment of the assignee
+// address (e.g. "gastown" for "gastown/polecats/alpha").
+func rigLoad(tracked []trackedIssue) map[string]int {
+	load := make(map[string]int)
+	for _, t := range tracked {
+		if t.Assignee == "" || t.Status == "closed" || t.Status == "tombstone" {
+			continue
+		}
+		rig, _, _ := strings.Cut(t.Assignee, "/")
+		if rig != "" {
+			load[rig]++
+		}
+	}
+	return load
+}
+
+// pickCandidate returns the index of the candidate to dispatch next, or false
+// if every candidate's rig is already at maxPerRig in-flight issues. A
+// maxPerRig of zero means no limit.
+func pickCandidate(candidates []feedCandidate, load map[string]int, strategy RigStrategy, rotation *RigRotation, maxPerRig int) (int, bool) {
+	var open []int
+	for i, c := range candidates {
+		if maxPerRig > 0 && load[c.rig] >= maxPerRig {
+			continue
+		}
+		open = append(open, i)
+	}
+	if len(open) == 0 {
+		return 0, false
+	}
+
+	switch strategy {
+	case RigLeastLoaded:
+		best := open[0]
+		for _, i := range open[1:] {
+			if load[candidates[i].rig] < load[candidates[best].rig] {
+				best = i
+			}
+		}
+		return best, true
+
+	case RigRoundRobin:
+		rigs := make([]string, 0, len(open))
+		first := make(map[string]int)
+		for _, i := range open {
+			rig := candidates[i].rig
+			if _, seen := first[rig]; !seen {
+				first[rig] = i
+				rigs = append(rigs, rig)
+			}
+		}
+		sort.Strings(rigs)
+		last := rotation.lastRig()
+		for _, rig := range rigs {
+			if rig > last {
+				return first[rig], true
+			}
+		}
+		return first[rigs[0]], true // wrap around
+	}
+
+	return open[0], true
+}
@@ -0,0 +1,172 @@
+package convoy
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/steveyegge/gastown/internal/config"
+)
+
+func TestParseRigStrategy(t *testing.T) {
+	tests := []struct {
+		name    string
+		want    RigStrategy
+		wantErr bool
+	}{
+		{"", RigByOrder, false},
+		{"order", RigByOrder, false},
+		{"least-loaded", RigLeastLoaded, false},
+		{"round-robin", RigRoundRobin, false},
+		{"random", "", true},
+	}
+	for _, tt := range tests {
+		got, err := ParseRigStrategy(tt.name)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("ParseRigStrategy(%q) error = %v, wantErr %v", tt.name, err, tt.wantErr)
+		}
+		if got != tt.want {
+			t.Errorf("ParseRigStrategy(%q) = %q, want %q", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestRigLoad(t *testing.T) {
+	tracked := []trackedIssue{
+		{ID: "gt-1", Status: "hooked", Assignee: "gastown/polecats/alpha"},
+		{ID: "gt-2", Status: "in_progress", Assignee: "gastown/polecats/bravo"},
+		{ID: "gt-3", Status: "closed", Assignee: "gastown/polecats/charlie"},
+		{ID: "bd-1", Status: "hooked", Assignee: "beads/polecats/delta"},
+		{ID: "bd-2", Status: "open"},
+	}
+	want := map[string]int{"gastown": 2, "beads": 1}
+	if got := rigLoad(tracked); !reflect.DeepEqual(got, want) {
+		t.Errorf("rigLoad() = %v, want %v", got, want)
+	}
+}
+
+func TestPickCandidate(t *testing.T) {
+	candidates := []feedCandidate{
+		{issueID: "gt-1", rig: "gastown"},
+		{issueID: "gt-2", rig: "gastown"},
+		{issueID: "bd-1", rig: "beads"},
+	}
+	busy := map[string]int{"gastown": 2}
+	full := map[string]int{"gastown": 1, "beads": 1}
+
+	tests := []struct {
+		name      string
+		strategy  RigStrategy
+		load      map[string]int
+		last      string
+		maxPerRig int
+		want      int
+		wantOK    bool
+	}{
+		{"order takes first", RigByOrder, busy, "", 0, 0, true},
+		{"least-loaded prefers idle rig", RigLeastLoaded, busy, "", 0, 2, true},
+		{"round-robin starts at first rig", RigRoundRobin, busy, "", 0, 2, true},
+		{"round-robin moves past last rig", RigRoundRobin, busy, "beads", 0, 0, true},
+		{"round-robin wraps around", RigRoundRobin, busy, "gastown", 0, 2, true},
+		{"full rig skipped", RigByOrder, busy, "", 2, 2, true},
+		{"all rigs full", RigByOrder, full, "", 1, 0, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rotation := &RigRotation{}
+			rotation.advance(tt.last)
+			got, ok := pickCandidate(candidates, tt.load, tt.strategy, rotation, tt.maxPerRig)
+			if ok != tt.wantOK || (ok && got != tt.want) {
+				t.Errorf("pickCandidate() = (%d, %v), want (%d, %v)", got, ok, tt.want, tt.wantOK)
+			}
+		})
+	}
+}
+
+func TestPickCandidate_RoundRobinAlternates(t *testing.T) {
+	candidates := []feedCandidate{
+		{issueID: "gt-1", rig: "gastown"},
+		{issueID: "bd-1", rig: "beads"},
+	}
+	rotation := &RigRotation{}
+	var got []string
+	for i := 0; i < 4; i++ {
+		idx, ok := pickCandidate(candidates, nil, RigRoundRobin, rotation, 0)
+		if !ok {
+			t.Fatalf("pick %d: no candidate", i)
+		}
+		got = append(got, candidates[idx].rig)
+		rotation.advance(candidates[idx].rig)
+	}
+	want := []string{"beads", "gastown", "beads", "gastown"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("rotation = %v, want %v", got, want)
+	}
+}
+
+func TestLoadFeedOptions(t *testing.T) {
+	townRoot := t.TempDir()
+	settings := config.NewTownSettings()
+	settings.Convoy = &config.ConvoyConfig{RigStrategy: "round-robin", MaxPerRig: 3, TypeRoutes: map[string]string{"bug": "firefighter"}}
+	if err := config.SaveTownSettings(config.TownSettingsPath(townRoot), settings); err != nil {
+		t.Fatalf("SaveTownSettings: %v", err)
+	}
+
+	opts := LoadFeedOptions(townRoot)
+	if opts.RigStrategy != RigRoundRobin {
+		t.Errorf("RigStrategy = %q, want %q", opts.RigStrategy, RigRoundRobin)
+	}
+	if opts.MaxPerRig != 3 {
+		t.Errorf("MaxPerRig = %d, want 3", opts.MaxPerRig)
+	}
+	if opts.TypeRoutes["bug"] != "firefighter" {
+		t.Errorf("TypeRoutes = %v, want bug routed to firefighter", opts.TypeRoutes)
+	}
+	if opts.Rotation == nil {
+		t.Error("Rotation is nil")
+	}
+}
+
+func TestRouteIssue(t *testing.T) {
+	townRoot := setupTownRoot(t)
+	routes := map[string]string{"bug": "firefighter", "chore": "testrig"}
+	tests := []struct {
+		name       string
+		issueType  string
+		wantRig    string
+		wantByType bool
+	}{
+		{"type route wins over prefix", "bug", "firefighter", true},
+		{"type route to the prefix rig needs no override", "chore", "testrig", false},
+		{"unrouted type falls through to prefix", "task", "testrig", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rig, byType := routeIssue(townRoot, "test-abc", tt.issueType, routes)
+			if rig != tt.wantRig || byType != tt.wantByType {
+				t.Errorf("routeIssue() = (%q, %v), want (%q, %v)", rig, byType, tt.wantRig, tt.wantByType)
+			}
+		})
+	}
+}
+
+func TestParseFeedAssignee(t *testing.T) {
+	tests := []struct {
+		in        string
+		rig, name string
+		crew, ok  bool
+	}{
+		{"gastown/polecats/Toast", "gastown", "Toast", false, true},
+		{"gastown/polecats/Toast/", "gastown", "Toast", false, true},
+		{"gastown/crew/mel", "gastown", "mel", true, true},
+		{"gastown/Toast", "", "", false, false},
+		{"gastown/witness", "", "", false, false},
+		{"gastown/crew/", "", "", false, false},
+		{"", "", "", false, false},
+	}
+	for _, tt := range tests {
+		rig, name, crew, ok := ParseFeedAssignee(tt.in)
+		if rig != tt.rig || name != tt.name || crew != tt.crew || ok != tt.ok {
+			t.Errorf("ParseFeedAssignee(%q) = %q, %q, %v, %v; want %q, %q, %v, %v", tt.in, rig, name, crew, ok, tt.rig, tt.name, tt.crew, tt.ok)
+		}
+	}
+}
@@ -0,0 +1,115 @@
+package convoy
+
+import (
+	"context"
+	"slices"
+	"strings"
+
+	beadsdk "github.com/steveyegge/beads"
+)
+
+// MatchKind is how an issue matched a search, best first.
+type MatchKind int
+
+const (
+	MatchID       MatchKind = iota // the query is the issue's ID
+	MatchIDPrefix                  // the ID starts with the query
+	MatchTitle                     // the title contains the query
+	MatchLabel                     // a label contains the query
+	MatchWords                     // every query word is in the title or labels
+	MatchFuzzy                     // the query's letters appear in order in the title
+)
+
+var matchKindNames = [...]string{"id", "id-prefix", "title", "label", "words", "fuzzy"}
+
+func (k MatchKind) String() string {
+	if int(k) < len(matchKindNames) {
+		return matchKindNames[k]
+	}
+	return "unknown"
+}
+
+// minFuzzyQuery is the shortest query matched fuzzily; shorter ones would
+// match most titles.
+const minFuzzyQuery = 3
+
+// IssueMatch is one search result.
+type IssueMatch struct {
+	Issue *beadsdk.Issue
+	Kind  MatchKind
+}
+
+// SearchIssues finds issues in store, closed ones included, whose ID, title,
+// or labels match query, ignoring case. The query is first reduced to a bare
+// ID (see ParseIssueRef), so a pasted external: reference or issue URL
+// finds the issue it names. Results are ranked by MatchKind, an exact ID
+// first, then in list order (see ListIssuePage); limit caps them, with 0 for
+// no limit.
+func SearchIssues(ctx context.Context, store IssueSearcher, query string, limit int) ([]IssueMatch, error) {
+	query = strings.ToLower(extractIssueID(strings.TrimSpace(query)))
+	if query == "" {
+		return nil, nil
+	}
+	issues, err := allIssues(ctx, store)
+	if err != nil {
+		return nil, err
+	}
+	var matches []IssueMatch
+	for _, iss := range issues {
+		if kind, ok := matchIssue(iss, query); ok {
+			matches = append(matches, IssueMatch{Issue: iss, Kind: kind})
+		}
+	}
+	slices.SortStableFunc(matches, func(a, b IssueMatch) int {
+		if a.Kind != b.Kind {
+			return int(a.Kind) - int(b.Kind)
+		}
+		return compareIssueOrder(a.Issue, b.Issue)
+	})
+	if limit > 0 && len(matches) > limit {
+		matches = matches[:limit]
+	}
+	return matches, nil
+}
+
+// matchIssue reports the best way iss matches a lowercased query.
+func matchIssue(iss *beadsdk.Issue, query string) (MatchKind, bool) {
+	id := strings.ToLower(iss.ID)
+	title := strings.ToLower(iss.Title)
+	labels := strings.ToLower(strings.Join(iss.Labels, " "))
+	switch {
+	case id == query:
+		return MatchID, true
+	case strings.HasPrefix(id, query):
+		return MatchIDPrefix, true
+	case strings.Contains(title, query):
+		return MatchTitle, true
+	case slices.ContainsFunc(iss.Labels, func(l string) bool { return strings.Contains(strings.ToLower(l), query) }):
+		return MatchLabel, true
+	}
+	if words := strings.Fields(query); len(words) > 1 && !slices.ContainsFunc(words, func(w string) bool {
+		return !strings.Contains(title, w) && !strings.Contains(labels, w)
+	}) {
+		return MatchWords, true
+	}
+	if len(query) >= minFuzzyQuery && isSubsequence(query, title) {
+		return MatchFuzzy, true
+	}
+	return 0, false
+}
+
+// isSubsequence reports whether s's non-space runes appear in t in order.
+func isSubsequence(s, t string) bool {
+	rest := t
+	for _, r := range s {
+		if r == ' ' {
+			continue
+		}
+		i := strings.IndexRune(rest, r)
+		if i < 0 {
+			return false
+		}
+		rest = rest[i+len(string(r)):]
+	}
+	return true
+}
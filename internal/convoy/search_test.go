@@ -0,0 +1,62 @@
+package convoy
+
+import (
+	"context"
+	"slices"
+	"testing"
+
+	beadsdk "github.com/steveyegge/beads"
+)
+
+func TestSearchIssues_Ranking(t *testing.T) {
+	withTitle := func(id, title string, labels ...string) *beadsdk.Issue {
+		iss := memIssue(id, beadsdk.StatusOpen, "")
+		iss.Title, iss.Labels = title, labels
+		return iss
+	}
+	closed := withTitle("gt-old", "Login page crashes on submit")
+	closed.Status = beadsdk.StatusClosed
+	store := newMemStore(
+		withTitle("gt-abc", "Refactor the feed loop"),
+		withTitle("gt-abcd", "Add metrics"),
+		withTitle("gt-xyz", "Mention gt-abc in docs"),
+		withTitle("gt-lbl", "Unrelated", "area:gt-abc"),
+		withTitle("gt-fix", "Fix the login redirect", "auth"),
+		withTitle("gt-fzz", "Feed dispatcher rework"),
+		closed,
+	)
+	ctx := context.Background()
+
+	ids := func(matches []IssueMatch) []string {
+		var out []string
+		for _, m := range matches {
+			out = append(out, m.Issue.ID+"/"+m.Kind.String())
+		}
+		return out
+	}
+	tests := []struct {
+		name  string
+		query string
+		limit int
+		want  []string
+	}{
+		{"exact ID first", "gt-abc", 0, []string{"gt-abc/id", "gt-abcd/id-prefix", "gt-xyz/title", "gt-lbl/label"}},
+		{"external ref normalized", "external:gt:GT-ABC", 1, []string{"gt-abc/id"}},
+		{"title substring includes closed", "LOGIN", 0, []string{"gt-fix/title", "gt-old/title"}},
+		{"words across title and labels", "login auth", 0, []string{"gt-fix/words"}},
+		{"fuzzy", "fdrwk", 0, []string{"gt-fzz/fuzzy"}},
+		{"short queries are not fuzzy", "fz", 0, nil},
+		{"empty query", "  ", 0, nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := SearchIssues(ctx, store, tt.query, tt.limit)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if g := ids(got); !slices.Equal(g, tt.want) {
+				t.Errorf("SearchIssues(%q) = %v, want %v", tt.query, g, tt.want)
+			}
+		})
+	}
+}
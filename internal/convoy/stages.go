@@ -0,0 +1,61 @@
+package convoy
+
+import "github.com/steveyegge/gastown/internal/beads"
+
+// Ordered stages let a convoy hold back later work until earlier work lands.
+// A member's stage comes from the convoy's "stages:" description field
+// (gt convoy add --stage); members without one are in stage 1. Only the
+// active stage — the lowest one with an unclosed member — is fed. Closing
+// its last member makes the next stage active, so the completion check that
+// follows a close unlocks it. Stages are unrelated to a staged convoy
+// (staged_* status), which is not yet launched at all.
+
+// stageOf returns an issue's stage; unstaged members are in stage 1.
+func stageOf(stages map[string]int, issueID string) int {
+	if n := stages[issueID]; n > 0 {
+		return n
+	}
+	return 1
+}
+
+// activeStage returns the lowest stage with a member that is not closed,
+// or 0 when every member is closed.
+func activeStage(tracked []trackedIssue, stages map[string]int) int {
+	active := 0
+	for _, t := range tracked {
+		if t.Status == "closed" || t.Status == "tombstone" {
+			continue
+		}
+		if n := stageOf(stages, t.ID); active == 0 || n < active {
+			active = n
+		}
+	}
+	return active
+}
+
+// MemberStage is a tracked issue's stage and whether that stage is waiting
+// on an earlier one, for display by gt convoy status.
+type MemberStage struct {
+	Stage   int
+	Waiting bool
+}
+
+// MemberStages assigns each member (issue ID → status) its stage from a
+// convoy's stages description field. Returns nil if the field is empty.
+func MemberStages(stagesField string, statuses map[string]string) map[string]MemberStage {
+	if stagesField == "" {
+		return nil
+	}
+	stages := beads.ParseConvoyStages(stagesField)
+	tracked := make([]trackedIssue, 0, len(statuses))
+	for id, status := range statuses {
+		tracked = append(tracked, trackedIssue{ID: id, Status: status})
+	}
+	active := activeStage(tracked, stages)
+	out := make(map[string]MemberStage, len(statuses))
+	for id := range statuses {
+		n := stageOf(stages, id)
+		out[id] = MemberStage{Stage: n, Waiting: active != 0 && n > active}
+	}
+	return out
+}
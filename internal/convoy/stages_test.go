@@ -0,0 +1,24 @@
+package convoy
+
+import "testing"
+
+func TestMemberStages(t *testing.T) {
+	statuses := map[string]string{"gt-a": "closed", "gt-b": "open", "gt-c": "open", "gt-d": "closed"}
+	got := MemberStages("gt-a=1,gt-b=2,gt-c=3", statuses)
+
+	want := map[string]MemberStage{
+		"gt-a": {Stage: 1},
+		"gt-b": {Stage: 2},
+		"gt-c": {Stage: 3, Waiting: true},
+		"gt-d": {Stage: 1}, // unstaged members are in stage 1
+	}
+	for id, ms := range want {
+		if got[id] != ms {
+			t.Errorf("%s = %+v, want %+v", id, got[id], ms)
+		}
+	}
+
+	if MemberStages("", statuses) != nil {
+		t.Error("convoy without stages should report none")
+	}
+}
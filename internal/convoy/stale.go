@@ -0,0 +1,67 @@
+package convoy
+
+import (
+	"context"
+	"time"
+
+	"github.com/steveyegge/gastown/internal/beads"
+)
+
+// StaleAssignment is a tracked issue that has been assigned for longer than
+// the staleness threshold without closing, typically because the polecat
+// working it crashed.
+type StaleAssignment struct {
+	IssueID    string        `json:"issue_id"`
+	Status     string        `json:"status"`
+	Assignee   string        `json:"assignee"`
+	AssignedAt time.Time     `json:"assigned_at"`
+	Age        time.Duration `json:"age"`
+}
+
+// FindStaleAssignments returns the convoy's in_progress or hooked issues that
+// were assigned more than olderThan before now. Issues without an assigned_at
+// timestamp (slung before it was recorded) are never reported.
+func FindStaleAssignments(ctx context.Context, store IssueStore, townRoot, convoyID string, olderThan time.Duration, now time.Time, resolver *StoreResolver) []StaleAssignment {
+	if store == nil {
+		return nil
+	}
+	return staleAssignments(getConvoyTrackedIssues(ctx, store, convoyID, townRoot, resolver), olderThan, now)
+}
+
+// staleAssignments filters tracked issues down to stale assignments. An issue
+// assigned exactly olderThan ago is not yet stale.
+func staleAssignments(tracked []trackedIssue, olderThan time.Duration, now time.Time) []StaleAssignment {
+	var stale []StaleAssignment
+	for _, t := range tracked {
+		if t.Status != "in_progress" && t.Status != "hooked" {
+			continue
+		}
+		if t.Assignee == "" || t.AssignedAt.IsZero() {
+			continue
+		}
+		if age := now.Sub(t.AssignedAt); age > olderThan {
+			stale = append(stale, StaleAssignment{
+				IssueID:    t.ID,
+				Status:     t.Status,
+				Assignee:   t.Assignee,
+				AssignedAt: t.AssignedAt,
+				Age:        age,
+			})
+		}
+	}
+	return stale
+}
+
+// assignedAt parses the assigned_at attachment field from an issue
+// description. Returns the zero time if the field is missing or malformed.
+func assignedAt(description string) time.Time {
+	fields := beads.ParseAttachmentFields(&beads.Issue{Description: description})
+	if fields == nil || fields.AssignedAt == "" {
+		return time.Time{}
+	}
+	ts, err := time.Parse(time.RFC3339, fields.AssignedAt)
+	if err != nil {
+		return time.Time{}
+	}
+	return ts
+}
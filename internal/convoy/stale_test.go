@@ -0,0 +1,69 @@
+package convoy
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	beadsdk "github.com/steveyegge/beads"
+)
+
+func TestStaleAssignments(t *testing.T) {
+	now := time.Date(2026, 2, 18, 12, 0, 0, 0, time.UTC)
+	threshold := 30 * time.Minute
+
+	tracked := []trackedIssue{
+		{ID: "gt-just", Status: "hooked", Assignee: "gastown/polecats/alpha", AssignedAt: now.Add(-time.Second)},
+		{ID: "gt-edge", Status: "hooked", Assignee: "gastown/polecats/bravo", AssignedAt: now.Add(-threshold)},
+		{ID: "gt-old", Status: "in_progress", Assignee: "gastown/polecats/charlie", AssignedAt: now.Add(-3 * time.Hour)},
+		{ID: "gt-closed", Status: "closed", Assignee: "gastown/polecats/delta", AssignedAt: now.Add(-3 * time.Hour)},
+		{ID: "gt-unknown", Status: "hooked", Assignee: "gastown/polecats/echo"},
+		{ID: "gt-open", Status: "open", AssignedAt: now.Add(-3 * time.Hour)},
+	}
+
+	got := staleAssignments(tracked, threshold, now)
+	if len(got) != 1 || got[0].IssueID != "gt-old" {
+		t.Fatalf("staleAssignments() = %+v, want only gt-old", got)
+	}
+	if got[0].Age != 3*time.Hour {
+		t.Errorf("Age = %v, want 3h", got[0].Age)
+	}
+}
+
+func TestAssignedAt(t *testing.T) {
+	want := time.Date(2026, 2, 18, 12, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name string
+		desc string
+		want time.Time
+	}{
+		{"present", "Do the thing\n\ndispatched_by: mayor\nassigned_at: 2026-02-18T12:00:00Z", want},
+		{"missing", "dispatched_by: mayor", time.Time{}},
+		{"malformed", "assigned_at: yesterday", time.Time{}},
+		{"empty", "", time.Time{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := assignedAt(tt.desc); !got.Equal(tt.want) {
+				t.Errorf("assignedAt() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFindStaleAssignments_ReadsAssignedAtFromStore(t *testing.T) {
+	now := time.Now().UTC()
+	stale := memIssue("test-stale", beadsdk.StatusInProgress, "testrig/polecats/alpha")
+	stale.Description = "assigned_at: " + now.Add(-2*time.Hour).Format(time.RFC3339)
+	fresh := memIssue("test-fresh", beadsdk.StatusInProgress, "testrig/polecats/bravo")
+	fresh.Description = "assigned_at: " + now.Add(-time.Minute).Format(time.RFC3339)
+
+	store := newMemStore(memIssue("test-convoy", beadsdk.StatusOpen, ""), stale, fresh)
+	store.addDep("test-convoy", "test-stale", "tracks")
+	store.addDep("test-convoy", "test-fresh", "tracks")
+
+	got := FindStaleAssignments(context.Background(), store, "", "test-convoy", 30*time.Minute, now, nil)
+	if len(got) != 1 || got[0].IssueID != "test-stale" || got[0].Assignee != "testrig/polecats/alpha" {
+		t.Errorf("FindStaleAssignments() = %+v, want test-stale", got)
+	}
+}
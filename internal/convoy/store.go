@@ -0,0 +1,23 @@
+package convoy
+
+import (
+	"context"
+
+	beadsdk "github.com/steveyegge/beads"
+)
+
+// IssueStore is the subset of beads storage the convoy operations read from.
+// beadsdk.Storage satisfies it, so callers pass their open store directly;
+// tests can supply an in-memory implementation instead of a Dolt database.
+//
+// Dispatch assigns issues by running gt sling, which updates status and
+// assignee in the owning rig's database. The only write through a store is
+// the dispatch claim made just before, when the store is an IssueClaimer.
+type IssueStore interface {
+	GetIssue(ctx context.Context, id string) (*beadsdk.Issue, error)
+	GetIssuesByIDs(ctx context.Context, ids []string) ([]*beadsdk.Issue, error)
+	GetDependenciesWithMetadata(ctx context.Context, issueID string) ([]*beadsdk.IssueWithDependencyMetadata, error)
+	GetDependentsWithMetadata(ctx context.Context, issueID string) ([]*beadsdk.IssueWithDependencyMetadata, error)
+}
+
+var _ IssueStore = beadsdk.Storage(nil)
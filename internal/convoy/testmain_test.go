@@ -8,6 +8,10 @@ import (
 	"github.com/steveyegge/gastown/internal/testutil"
 )
 
+// doltUnavailable is set when no Dolt container could be started. Tests that
+// need a real store skip; memStore-backed tests still run.
+var doltUnavailable error
+
 func TestMain(m *testing.M) {
 	// Start an ephemeral Dolt container for this package's tests.
 	// setupTestStore sets BEADS_TEST_MODE=1, which causes the beads SDK
@@ -16,8 +20,9 @@ func TestMain(m *testing.M) {
 	// container is terminated at cleanup — preventing orphan
 	// accumulation in the shared production Dolt data dir.
 	if err := testutil.EnsureDoltContainerForTestMain(); err != nil {
-		fmt.Fprintf(os.Stderr, "convoy TestMain: skipping — %v\n", err)
-		os.Exit(0)
+		fmt.Fprintf(os.Stderr, "convoy TestMain: skipping store tests — %v\n", err)
+		doltUnavailable = err
+		os.Exit(m.Run())
 	}
 
 	code := m.Run()
@@ -0,0 +1,173 @@
+package convoy
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/steveyegge/gastown/internal/config"
+)
+
+// TimeoutAction is what the watchdog does with an issue past its limit.
+type TimeoutAction string
+
+const (
+	// TimeoutWarn logs the timeout and emits an event. The default.
+	TimeoutWarn TimeoutAction = "warn"
+	// TimeoutReassign also reopens the issue so the next feed re-slings it.
+	TimeoutReassign TimeoutAction = "reassign"
+	// TimeoutKill interrupts the polecat first, then reopens the issue.
+	TimeoutKill TimeoutAction = "kill"
+)
+
+// ParseTimeoutAction validates an action name. Empty means TimeoutWarn.
+func ParseTimeoutAction(name string) (TimeoutAction, error) {
+	switch TimeoutAction(name) {
+	case "", TimeoutWarn:
+		return TimeoutWarn, nil
+	case TimeoutReassign, TimeoutKill:
+		return TimeoutAction(name), nil
+	}
+	return "", fmt.Errorf("unknown timeout action %q (want warn, reassign, or kill)", name)
+}
+
+// TimeoutPolicy holds parsed convoy.timeouts settings. The zero value
+// never times anything out.
+type TimeoutPolicy struct {
+	Default time.Duration
+	ByType  map[string]time.Duration
+	Action  TimeoutAction
+}
+
+// LoadTimeoutPolicy returns the town's convoy.timeouts policy. Invalid
+// durations are ignored and an invalid action falls back to TimeoutWarn.
+func LoadTimeoutPolicy(townRoot string) TimeoutPolicy {
+	policy := TimeoutPolicy{Action: TimeoutWarn}
+	settings, err := config.LoadOrCreateTownSettings(config.TownSettingsPath(townRoot))
+	if err != nil || settings.Convoy == nil || settings.Convoy.Timeouts == nil {
+		return policy
+	}
+	tc := settings.Convoy.Timeouts
+	policy.Default = config.ParseDurationOrDefault(tc.Default, 0)
+	for issueType, limit := range tc.ByType {
+		if d := config.ParseDurationOrDefault(limit, 0); d > 0 {
+			if policy.ByType == nil {
+				policy.ByType = make(map[string]time.Duration)
+			}
+			policy.ByType[issueType] = d
+		}
+	}
+	if action, err := ParseTimeoutAction(tc.Action); err == nil {
+		policy.Action = action
+	}
+	return policy
+}
+
+// Enabled reports whether any issue type can time out.
+func (p TimeoutPolicy) Enabled() bool {
+	return p.Default > 0 || len(p.ByType) > 0
+}
+
+// LimitFor returns the timeout for an issue type; 0 means none. Empty types
+// are treated as tasks, as beads does.
+func (p TimeoutPolicy) LimitFor(issueType string) time.Duration {
+	if issueType == "" {
+		issueType = "task"
+	}
+	if d, ok := p.ByType[issueType]; ok {
+		return d
+	}
+	return p.Default
+}
+
+// TimedOutIssue is an in-progress issue past its type's limit.
+type TimedOutIssue struct {
+	StaleAssignment
+	IssueType string        `json:"issue_type"`
+	Limit     time.Duration `json:"limit"`
+}
+
+// timedOutIssues filters tracked issues down to those assigned longer ago
+// than their type's limit. As with staleAssignments, an issue exactly at its
+// limit has not yet timed out.
+func timedOutIssues(tracked []trackedIssue, policy TimeoutPolicy, now time.Time) []TimedOutIssue {
+	var out []TimedOutIssue
+	for _, t := range tracked {
+		limit := policy.LimitFor(t.IssueType)
+		if limit <= 0 {
+			continue
+		}
+		for _, s := range staleAssignments([]trackedIssue{t}, limit, now) {
+			out = append(out, TimedOutIssue{StaleAssignment: s, IssueType: t.IssueType, Limit: limit})
+		}
+	}
+	return out
+}
+
+// TimeoutHandler carries out watchdog actions. gt supplies one backed by
+// tmux and bd; tests supply fakes.
+type TimeoutHandler interface {
+	// Interrupt stops the assignee's current turn (Ctrl-C in its session).
+	Interrupt(assignee string) error
+	// Reopen unassigns the issue and sets it back to open.
+	Reopen(issueID string) error
+}
+
+// TimeoutWatchdog applies a TimeoutPolicy to a convoy's in-progress issues.
+// It remembers which assignments it has already handled so a long-running
+// watch reports each timeout once; reassigning an issue re-arms it.
+type TimeoutWatchdog struct {
+	Policy  TimeoutPolicy
+	Handler TimeoutHandler
+
+	handled map[string]bool // issue ID + assigned_at
+}
+
+// Check finds the convoy's timed-out issues, applies the policy action to
+// each, and emits EventIssueTimedOut recording the action taken. A failed
+// interrupt still reopens the issue; a failed reopen downgrades the action
+// to a warning. Returns the issues that timed out in this check.
+func (w *TimeoutWatchdog) Check(ctx context.Context, store IssueStore, townRoot, convoyID string, now time.Time, logger func(format string, args ...interface{}), resolver *StoreResolver) []TimedOutIssue {
+	if store == nil || !w.Policy.Enabled() {
+		return nil
+	}
+	if logger == nil {
+		logger = func(string, ...interface{}) {}
+	}
+	if w.handled == nil {
+		w.handled = make(map[string]bool)
+	}
+
+	var fresh []TimedOutIssue
+	for _, t := range timedOutIssues(getConvoyTrackedIssues(ctx, store, convoyID, townRoot, resolver), w.Policy, now) {
+		key := t.IssueID + "@" + t.AssignedAt.Format(time.RFC3339)
+		if w.handled[key] {
+			continue
+		}
+		w.handled[key] = true
+		fresh = append(fresh, t)
+
+		action := w.apply(t, logger)
+		emit(Event{Type: EventIssueTimedOut, ConvoyID: convoyID, IssueID: t.IssueID, Caller: "watchdog", Action: string(action)})
+	}
+	return fresh
+}
+
+func (w *TimeoutWatchdog) apply(t TimedOutIssue, logger func(format string, args ...interface{})) TimeoutAction {
+	log := levels(logger)
+	log.warnf("Watchdog: %s (%s) assigned to %s for %s, over its %s limit", t.IssueID, t.IssueType, t.Assignee, t.Age.Round(time.Second), t.Limit)
+	if w.Policy.Action == TimeoutWarn || w.Handler == nil {
+		return TimeoutWarn
+	}
+	if w.Policy.Action == TimeoutKill {
+		if err := w.Handler.Interrupt(t.Assignee); err != nil {
+			log.warnf("Watchdog: could not interrupt %s: %s", t.Assignee, err)
+		}
+	}
+	if err := w.Handler.Reopen(t.IssueID); err != nil {
+		log.warnf("Watchdog: could not reopen %s: %s", t.IssueID, err)
+		return TimeoutWarn
+	}
+	log.infof("Watchdog: reopened %s for re-slinging", t.IssueID)
+	return w.Policy.Action
+}
@@ -0,0 +1,131 @@
+package convoy
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	beadsdk "github.com/steveyegge/beads"
+	"github.com/steveyegge/gastown/internal/config"
+)
+
+func TestTimedOutIssues_ThresholdBoundary(t *testing.T) {
+	now := time.Date(2026, 2, 18, 12, 0, 0, 0, time.UTC)
+	policy := TimeoutPolicy{Default: time.Hour, ByType: map[string]time.Duration{"bug": 10 * time.Minute}}
+
+	tracked := []trackedIssue{
+		{ID: "gt-at-limit", Status: "in_progress", Assignee: "a", IssueType: "task", AssignedAt: now.Add(-time.Hour)},
+		{ID: "gt-past-limit", Status: "in_progress", Assignee: "b", IssueType: "task", AssignedAt: now.Add(-time.Hour - time.Second)},
+		{ID: "gt-bug", Status: "hooked", Assignee: "c", IssueType: "bug", AssignedAt: now.Add(-11 * time.Minute)},
+		{ID: "gt-bug-fresh", Status: "hooked", Assignee: "d", IssueType: "bug", AssignedAt: now.Add(-10 * time.Minute)},
+		{ID: "gt-untyped", Status: "in_progress", Assignee: "e", AssignedAt: now.Add(-2 * time.Hour)},
+	}
+
+	got := timedOutIssues(tracked, policy, now)
+	want := map[string]time.Duration{"gt-past-limit": time.Hour, "gt-bug": 10 * time.Minute, "gt-untyped": time.Hour}
+	if len(got) != len(want) {
+		t.Fatalf("timedOutIssues() = %+v, want %v", got, want)
+	}
+	for _, issue := range got {
+		if limit, ok := want[issue.IssueID]; !ok || issue.Limit != limit {
+			t.Errorf("%s timed out with limit %v, want %v (listed: %v)", issue.IssueID, issue.Limit, limit, ok)
+		}
+	}
+}
+
+func TestTimeoutPolicy_NoDefaultOnlyTypedLimits(t *testing.T) {
+	policy := TimeoutPolicy{ByType: map[string]time.Duration{"bug": time.Hour}}
+	if policy.LimitFor("feature") != 0 {
+		t.Errorf("LimitFor(feature) = %v, want 0 with no default", policy.LimitFor("feature"))
+	}
+	if !policy.Enabled() || (TimeoutPolicy{}).Enabled() {
+		t.Error("Enabled() should be true only when some limit is set")
+	}
+}
+
+func TestLoadTimeoutPolicy(t *testing.T) {
+	townRoot := t.TempDir()
+	settings := config.NewTownSettings()
+	settings.Convoy = &config.ConvoyConfig{Timeouts: &config.ConvoyTimeoutConfig{
+		Default: "4h",
+		ByType:  map[string]string{"bug": "2h", "chore": "soon"},
+		Action:  "kill",
+	}}
+	if err := config.SaveTownSettings(config.TownSettingsPath(townRoot), settings); err != nil {
+		t.Fatalf("SaveTownSettings: %v", err)
+	}
+
+	policy := LoadTimeoutPolicy(townRoot)
+	if policy.Default != 4*time.Hour || policy.Action != TimeoutKill {
+		t.Errorf("policy = %+v, want 4h default and kill", policy)
+	}
+	if policy.LimitFor("bug") != 2*time.Hour || policy.LimitFor("chore") != 4*time.Hour {
+		t.Errorf("ByType = %v, want bug=2h and the invalid chore limit dropped", policy.ByType)
+	}
+}
+
+// fakeTimeoutHandler records watchdog actions.
+type fakeTimeoutHandler struct {
+	interrupted []string
+	reopened    []string
+	reopenErr   error
+}
+
+func (f *fakeTimeoutHandler) Interrupt(assignee string) error {
+	f.interrupted = append(f.interrupted, assignee)
+	return nil
+}
+
+func (f *fakeTimeoutHandler) Reopen(issueID string) error {
+	f.reopened = append(f.reopened, issueID)
+	return f.reopenErr
+}
+
+func timedOutStore(now time.Time) *memStore {
+	slow := memIssue("test-slow", beadsdk.StatusInProgress, "testrig/polecats/alpha")
+	slow.Description = "assigned_at: " + now.Add(-2*time.Hour).Format(time.RFC3339)
+	store := newMemStore(memIssue("test-convoy", beadsdk.StatusOpen, ""), slow)
+	store.addDep("test-convoy", "test-slow", "tracks")
+	return store
+}
+
+func TestTimeoutWatchdog_Actions(t *testing.T) {
+	now := time.Now().UTC()
+	tests := []struct {
+		action          TimeoutAction
+		reopenErr       error
+		wantInterrupted int
+		wantReopened    int
+		wantEvent       string
+	}{
+		{TimeoutWarn, nil, 0, 0, "warn"},
+		{TimeoutReassign, nil, 0, 1, "reassign"},
+		{TimeoutKill, nil, 1, 1, "kill"},
+		{TimeoutReassign, errors.New("bd down"), 0, 1, "warn"},
+	}
+	for _, tt := range tests {
+		t.Run(string(tt.action), func(t *testing.T) {
+			sink := useRecordingSink(t)
+			handler := &fakeTimeoutHandler{reopenErr: tt.reopenErr}
+			wd := &TimeoutWatchdog{Policy: TimeoutPolicy{Default: time.Hour, Action: tt.action}, Handler: handler}
+			store := timedOutStore(now)
+
+			got := wd.Check(context.Background(), store, "", "test-convoy", now, nil, nil)
+			if len(got) != 1 || got[0].IssueID != "test-slow" {
+				t.Fatalf("Check() = %+v, want test-slow", got)
+			}
+			if len(handler.interrupted) != tt.wantInterrupted || len(handler.reopened) != tt.wantReopened {
+				t.Errorf("interrupted %v, reopened %v", handler.interrupted, handler.reopened)
+			}
+			if len(sink.events) != 1 || sink.events[0].Type != EventIssueTimedOut || sink.events[0].Action != tt.wantEvent {
+				t.Errorf("events = %+v, want one issue_timed_out with action %s", sink.events, tt.wantEvent)
+			}
+
+			// The same assignment is not handled twice.
+			if again := wd.Check(context.Background(), store, "", "test-convoy", now.Add(time.Minute), nil, nil); len(again) != 0 {
+				t.Errorf("second Check() = %+v, want none", again)
+			}
+		})
+	}
+}
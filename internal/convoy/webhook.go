@@ -0,0 +1,167 @@
+package convoy
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/steveyegge/gastown/internal/config"
+)
+
+// Webhook delivery defaults, used when convoy.webhook leaves them unset.
+const (
+	defaultWebhookTimeout = 5 * time.Second
+	defaultWebhookRetries = 2
+
+	// webhookQueueSize bounds events waiting for delivery. When the
+	// endpoint is slow or down and the queue fills, new events are dropped
+	// so convoy feeding never waits on the network.
+	webhookQueueSize = 64
+)
+
+// webhookRetryBackoff is the delay before the first retry; it doubles on
+// each subsequent attempt. A variable so tests can shorten it.
+var webhookRetryBackoff = 500 * time.Millisecond
+
+// WebhookSignatureHeader carries the HMAC of the request body.
+const WebhookSignatureHeader = "X-Gastown-Signature"
+
+// WebhookSink POSTs convoy events as JSON to a URL. Delivery happens on a
+// background goroutine with per-attempt timeouts and retries; Emit never
+// blocks. Call Close before exiting to deliver what is still queued.
+type WebhookSink struct {
+	url     string
+	secret  string
+	client  *http.Client
+	retries int
+	backoff time.Duration
+	logger  func(format string, args ...interface{})
+
+	mu     sync.Mutex
+	closed bool
+	queue  chan Event
+	done   chan struct{}
+}
+
+// NewWebhookSink starts a sink delivering to cfg.URL. Dropped events and
+// failed deliveries are reported through logger, which may be nil.
+func NewWebhookSink(cfg config.ConvoyWebhookConfig, logger func(format string, args ...interface{})) *WebhookSink {
+	if logger == nil {
+		logger = func(format string, args ...interface{}) {} // no-op
+	}
+	retries := defaultWebhookRetries
+	if cfg.Retries != nil && *cfg.Retries >= 0 {
+		retries = *cfg.Retries
+	}
+	s := &WebhookSink{
+		url:     cfg.URL,
+		secret:  cfg.Secret,
+		client:  &http.Client{Timeout: config.ParseDurationOrDefault(cfg.Timeout, defaultWebhookTimeout)},
+		retries: retries,
+		backoff: webhookRetryBackoff,
+		logger:  levels(logger).warnf, // every webhook message is a dropped or stuck delivery
+		queue:   make(chan Event, webhookQueueSize),
+		done:    make(chan struct{}),
+	}
+	go s.run()
+	return s
+}
+
+// Emit implements EventSink. It queues e and returns immediately; if the
+// queue is full the event is dropped with a warning.
+func (s *WebhookSink) Emit(e Event) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.closed {
+		return
+	}
+	select {
+	case s.queue <- e:
+	default:
+		s.logger("convoy webhook: queue full, dropping %s event for %s", e.Type, e.ConvoyID)
+	}
+}
+
+// Close stops accepting events and waits up to timeout for queued ones to
+// be delivered. Events still undelivered after timeout are abandoned.
+func (s *WebhookSink) Close(timeout time.Duration) {
+	s.mu.Lock()
+	if !s.closed {
+		s.closed = true
+		close(s.queue)
+	}
+	s.mu.Unlock()
+
+	select {
+	case <-s.done:
+	case <-time.After(timeout):
+		s.logger("convoy webhook: gave up waiting for %d queued event(s)", len(s.queue))
+	}
+}
+
+func (s *WebhookSink) run() {
+	defer close(s.done)
+	for e := range s.queue {
+		if err := s.deliver(e); err != nil {
+			s.logger("convoy webhook: dropping %s event for %s: %v", e.Type, e.ConvoyID, err)
+		}
+	}
+}
+
+// deliver POSTs one event, retrying network errors, 5xx, and 429 responses.
+func (s *WebhookSink) deliver(e Event) error {
+	body, err := json.Marshal(e)
+	if err != nil {
+		return err
+	}
+
+	backoff := s.backoff
+	for attempt := 0; ; attempt++ {
+		retryable, err := s.post(e, body)
+		if err == nil {
+			return nil
+		}
+		if !retryable || attempt >= s.retries {
+			return err
+		}
+		time.Sleep(backoff)
+		backoff *= 2
+	}
+}
+
+func (s *WebhookSink) post(e Event, body []byte) (retryable bool, err error) {
+	req, err := http.NewRequest(http.MethodPost, s.url, bytes.NewReader(body))
+	if err != nil {
+		return false, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("X-Gastown-Event", string(e.Type))
+	if s.secret != "" {
+		req.Header.Set(WebhookSignatureHeader, SignWebhookBody(s.secret, body))
+	}
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return true, err
+	}
+	_ = resp.Body.Close()
+	if resp.StatusCode >= 200 && resp.StatusCode < 300 {
+		return false, nil
+	}
+	retryable = resp.StatusCode >= 500 || resp.StatusCode == http.StatusTooManyRequests
+	return retryable, fmt.Errorf("%s returned %s", s.url, resp.Status)
+}
+
+// SignWebhookBody returns the X-Gastown-Signature value for body:
+// "sha256=" followed by the hex HMAC-SHA256 of body keyed by secret.
+func SignWebhookBody(secret string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(body)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
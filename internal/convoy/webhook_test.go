@@ -0,0 +1,149 @@
+package convoy
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/steveyegge/gastown/internal/config"
+)
+
+func fastWebhookRetries(t *testing.T) {
+	t.Helper()
+	old := webhookRetryBackoff
+	webhookRetryBackoff = time.Millisecond
+	t.Cleanup(func() { webhookRetryBackoff = old })
+}
+
+func collectLogs() (func(string, ...interface{}), func() string) {
+	var mu sync.Mutex
+	var b strings.Builder
+	return func(format string, args ...interface{}) {
+			mu.Lock()
+			defer mu.Unlock()
+			b.WriteString(strings.TrimSpace(fmt.Sprintf(format, args...)) + "\n")
+		}, func() string {
+			mu.Lock()
+			defer mu.Unlock()
+			return b.String()
+		}
+}
+
+func TestWebhookSink_SignsAndDelivers(t *testing.T) {
+	var got struct {
+		event     Event
+		signature string
+		eventType string
+		body      []byte
+	}
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		got.body, _ = io.ReadAll(r.Body)
+		_ = json.Unmarshal(got.body, &got.event)
+		got.signature = r.Header.Get(WebhookSignatureHeader)
+		got.eventType = r.Header.Get("X-Gastown-Event")
+	}))
+	defer srv.Close()
+
+	sink := NewWebhookSink(config.ConvoyWebhookConfig{URL: srv.URL, Secret: "s3cret"}, nil)
+	sink.Emit(Event{Type: EventIssueCompleted, ConvoyID: "hq-cv-1", IssueID: "gt-1"})
+	sink.Close(5 * time.Second)
+
+	if got.event.IssueID != "gt-1" || got.eventType != string(EventIssueCompleted) {
+		t.Errorf("received event %+v (header %q)", got.event, got.eventType)
+	}
+	if want := SignWebhookBody("s3cret", got.body); got.signature != want {
+		t.Errorf("signature = %q, want %q", got.signature, want)
+	}
+}
+
+func TestWebhookSink_RetriesServerErrors(t *testing.T) {
+	fastWebhookRetries(t)
+	var calls atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if calls.Add(1) < 3 {
+			w.WriteHeader(http.StatusBadGateway)
+		}
+	}))
+	defer srv.Close()
+
+	logger, logs := collectLogs()
+	sink := NewWebhookSink(config.ConvoyWebhookConfig{URL: srv.URL}, logger)
+	sink.Emit(Event{Type: EventIssueDispatched, ConvoyID: "hq-cv-1"})
+	sink.Close(5 * time.Second)
+
+	if n := calls.Load(); n != 3 {
+		t.Errorf("calls = %d, want 3 (two retries)", n)
+	}
+	if l := logs(); l != "" {
+		t.Errorf("logs = %q, want none after a successful retry", l)
+	}
+}
+
+func TestWebhookSink_ClientErrorNotRetried(t *testing.T) {
+	fastWebhookRetries(t)
+	var calls atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+		w.WriteHeader(http.StatusBadRequest)
+	}))
+	defer srv.Close()
+
+	logger, logs := collectLogs()
+	sink := NewWebhookSink(config.ConvoyWebhookConfig{URL: srv.URL}, logger)
+	sink.Emit(Event{Type: EventIssueDispatched, ConvoyID: "hq-cv-1"})
+	sink.Close(5 * time.Second)
+
+	if n := calls.Load(); n != 1 {
+		t.Errorf("calls = %d, want 1", n)
+	}
+	if !strings.Contains(logs(), "dropping issue_dispatched event for hq-cv-1") {
+		t.Errorf("logs = %q, want a dropped-event warning", logs())
+	}
+}
+
+func TestWebhookSink_EndpointDownDoesNotBlock(t *testing.T) {
+	fastWebhookRetries(t)
+	srv := httptest.NewServer(http.HandlerFunc(func(http.ResponseWriter, *http.Request) {}))
+	url := srv.URL
+	srv.Close() // nothing listening
+
+	logger, logs := collectLogs()
+	retries := 0
+	sink := NewWebhookSink(config.ConvoyWebhookConfig{URL: url, Retries: &retries}, logger)
+
+	start := time.Now()
+	for i := 0; i < webhookQueueSize*2; i++ {
+		sink.Emit(Event{Type: EventConvoyAdvanced, ConvoyID: "hq-cv-1"})
+	}
+	if elapsed := time.Since(start); elapsed > time.Second {
+		t.Errorf("Emit took %s with the endpoint down, want non-blocking", elapsed)
+	}
+	sink.Close(5 * time.Second)
+
+	if !strings.Contains(logs(), "dropping convoy_advanced event") {
+		t.Errorf("logs = %q, want dropped-event warnings", logs())
+	}
+	sink.Emit(Event{Type: EventConvoyAdvanced}) // after Close: ignored, no panic
+}
+
+func TestEventSinkFor_Webhook(t *testing.T) {
+	cfg := config.ConvoyConfig{
+		EventLog: "convoy.jsonl",
+		Webhook:  &config.ConvoyWebhookConfig{URL: "http://127.0.0.1:1/hook"},
+	}
+	multi, ok := EventSinkFor(t.TempDir(), cfg, nil).(MultiSink)
+	if !ok || len(multi) != 2 {
+		t.Fatalf("EventSinkFor() = %T, want MultiSink of file and webhook", multi)
+	}
+	if _, ok := multi[1].(*WebhookSink); !ok {
+		t.Errorf("multi[1] = %T, want *WebhookSink", multi[1])
+	}
+	multi.Close(time.Second)
+}
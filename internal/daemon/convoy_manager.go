@@ -78,6 +78,10 @@ type ConvoyManager struct {
 
 	gtPath string
 
+	// feedOpts controls continuation feeds (rig strategy, per-rig limit).
+	// Its RigRotation persists round-robin state across close events.
+	feedOpts convoy.FeedOptions
+
 	// started guards against double-call of Start() which would spawn duplicate goroutines.
 	started atomic.Bool
 
@@ -140,6 +144,7 @@ func NewConvoyManager(townRoot string, logger func(format string, args ...interf
 		openStores:   openStores,
 		isRigParked:  isRigParked,
 		gtPath:       gtPath,
+		feedOpts:     convoy.LoadFeedOptions(townRoot),
 	}
 }
 
@@ -382,7 +387,7 @@ func (m *ConvoyManager) pollStore(name string, store beadsdk.Storage, stores map
 
 		m.logger("Convoy: close detected: %s (from %s)", issueID, name)
 		resolver := convoy.NewStoreResolver(m.townRoot, stores)
-		convoy.CheckConvoysForIssue(m.ctx, hqStore, m.townRoot, issueID, "Convoy", m.logger, m.gtPath, m.isRigParked, resolver)
+		convoy.CheckConvoysForIssueWithOptions(m.ctx, hqStore, m.townRoot, issueID, "Convoy", m.logger, m.gtPath, m.isRigParked, m.feedOpts, resolver)
 	}
 	return nil
 }
@@ -0,0 +1,77 @@
+package deps
+
+import (
+	"context"
+	"fmt"
+	"os/exec"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/steveyegge/gastown/internal/tmux"
+	"github.com/steveyegge/gastown/internal/util"
+)
+
+// MinTmuxVersion is the oldest tmux Gas Town is tested against. Older
+// releases lack hooks and options the session manager relies on.
+const MinTmuxVersion = "3.0"
+
+// TmuxInstallURL is the installation page for tmux.
+const TmuxInstallURL = "https://github.com/tmux/tmux/wiki/Installing"
+
+// TmuxStatus represents the state of the tmux installation.
+type TmuxStatus int
+
+const (
+	TmuxOK         TmuxStatus = iota // tmux found, version compatible
+	TmuxNotFound                     // tmux not in PATH
+	TmuxTooOld                       // tmux found but version too old
+	TmuxExecFailed                   // tmux found but 'tmux -V' failed to execute
+	TmuxUnknown                      // tmux -V ran but output couldn't be parsed
+)
+
+// CheckTmux checks if tmux is installed and compatible.
+// Returns status, the installed version as tmux reports it (e.g. "3.3a"),
+// and diagnostic detail for failure cases.
+func CheckTmux() (TmuxStatus, string, string) {
+	path, err := exec.LookPath(tmux.Binary())
+	if err != nil {
+		return TmuxNotFound, "", ""
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	cmd := exec.CommandContext(ctx, path, "-V")
+	util.SetDetachedProcessGroup(cmd)
+	output, err := cmd.CombinedOutput()
+	if err != nil {
+		detail := strings.TrimSpace(string(output))
+		if detail == "" {
+			detail = err.Error()
+		}
+		return TmuxExecFailed, "", fmt.Sprintf("at %s: %s", path, detail)
+	}
+
+	version, numeric := parseTmuxVersion(string(output))
+	if version == "" {
+		return TmuxUnknown, "", strings.TrimSpace(string(output))
+	}
+
+	if CompareVersions(numeric, MinTmuxVersion) < 0 {
+		return TmuxTooOld, version, ""
+	}
+
+	return TmuxOK, version, ""
+}
+
+var tmuxVersionRe = regexp.MustCompile(`tmux (?:next-)?((\d+)\.(\d+)[a-z]?)`)
+
+// parseTmuxVersion extracts the version from "tmux X.Y[a]" output. It returns
+// the version as reported and its numeric "X.Y" part for comparison.
+func parseTmuxVersion(output string) (version, numeric string) {
+	m := tmuxVersionRe.FindStringSubmatch(output)
+	if len(m) < 4 {
+		return "", ""
+	}
+	return m[1], m[2] + "." + m[3]
+}
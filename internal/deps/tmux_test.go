@@ -0,0 +1,39 @@
+package deps
+
+import "testing"
+
+func TestParseTmuxVersion(t *testing.T) {
+	tests := []struct {
+		input   string
+		version string
+		numeric string
+	}{
+		{"tmux 3.3a", "3.3a", "3.3"},
+		{"tmux 3.4\n", "3.4", "3.4"},
+		{"tmux next-3.5", "3.5", "3.5"},
+		{"tmux 2.9", "2.9", "2.9"},
+		{"tmux master", "", ""},
+		{"", "", ""},
+	}
+
+	for _, tt := range tests {
+		version, numeric := parseTmuxVersion(tt.input)
+		if version != tt.version || numeric != tt.numeric {
+			t.Errorf("parseTmuxVersion(%q) = (%q, %q), want (%q, %q)", tt.input, version, numeric, tt.version, tt.numeric)
+		}
+	}
+}
+
+func TestCheckTmux(t *testing.T) {
+	status, version, _ := CheckTmux()
+
+	if status == TmuxNotFound {
+		t.Skip("tmux not installed, skipping integration test")
+	}
+
+	if status == TmuxOK && version == "" {
+		t.Error("CheckTmux returned TmuxOK but empty version")
+	}
+
+	t.Logf("CheckTmux: status=%d, version=%s", status, version)
+}
@@ -0,0 +1,110 @@
+package doctor
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os/exec"
+	"path/filepath"
+	"sort"
+
+	beadsdk "github.com/steveyegge/beads"
+	"github.com/steveyegge/gastown/internal/convoy"
+)
+
+// ConvoyCycleCheck finds open convoys whose members block each other in a
+// cycle. The feed never dispatches an issue with open blockers, so every
+// issue in a cycle waits forever and the convoy silently stalls.
+type ConvoyCycleCheck struct {
+	BaseCheck
+
+	// find returns each open convoy's blocking cycles. Defaults to
+	// findTownConvoyCycles; tests replace it.
+	find func(townRoot string) (map[string][][]string, error)
+}
+
+// NewConvoyCycleCheck creates a new convoy dependency cycle check.
+func NewConvoyCycleCheck() *ConvoyCycleCheck {
+	return &ConvoyCycleCheck{
+		BaseCheck: BaseCheck{
+			CheckName:        "convoy-dependency-cycles",
+			CheckDescription: "Check that no open convoy has issues blocking each other in a cycle",
+			CheckCategory:    CategoryCore,
+		},
+		find: findTownConvoyCycles,
+	}
+}
+
+// Run reports every blocking cycle in an open convoy, naming its issues.
+func (c *ConvoyCycleCheck) Run(ctx *CheckContext) *CheckResult {
+	byConvoy, err := c.find(ctx.TownRoot)
+	if err != nil {
+		return &CheckResult{
+			Name:    c.Name(),
+			Status:  StatusWarning,
+			Message: fmt.Sprintf("Could not check convoys for dependency cycles: %v", err),
+		}
+	}
+
+	convoyIDs := make([]string, 0, len(byConvoy))
+	for id, cycles := range byConvoy {
+		if len(cycles) > 0 {
+			convoyIDs = append(convoyIDs, id)
+		}
+	}
+	if len(convoyIDs) == 0 {
+		return &CheckResult{
+			Name:    c.Name(),
+			Status:  StatusOK,
+			Message: "No dependency cycles in open convoys",
+		}
+	}
+	sort.Strings(convoyIDs)
+
+	var details []string
+	for _, id := range convoyIDs {
+		for _, cycle := range byConvoy[id] {
+			details = append(details, fmt.Sprintf("%s: %s", id, convoy.FormatCycle(cycle)))
+		}
+	}
+	return &CheckResult{
+		Name:    c.Name(),
+		Status:  StatusError,
+		Message: fmt.Sprintf("%d dependency cycle(s) in %d convoy(s); these issues will never be fed", len(details), len(convoyIDs)),
+		Details: details,
+		FixHint: "Remove one dependency in each cycle: bd dep remove <issue> <blocker>",
+	}
+}
+
+// findTownConvoyCycles lists the town's open convoys with bd and searches
+// each for blocking cycles.
+func findTownConvoyCycles(townRoot string) (map[string][][]string, error) {
+	cmd := exec.Command("bd", "list", "--type=convoy", "--status=open", "--json")
+	cmd.Dir = townRoot
+	out, err := cmd.Output()
+	if err != nil {
+		return nil, fmt.Errorf("listing convoys: %w", err)
+	}
+	var open []struct {
+		ID string `json:"id"`
+	}
+	if err := json.Unmarshal(out, &open); err != nil {
+		return nil, fmt.Errorf("parsing convoy list: %w", err)
+	}
+	if len(open) == 0 {
+		return nil, nil
+	}
+
+	bg := context.Background()
+	store, err := beadsdk.Open(bg, filepath.Join(townRoot, ".beads"))
+	if err != nil {
+		return nil, fmt.Errorf("opening town beads: %w", err)
+	}
+	defer func() { _ = store.Close() }()
+
+	byConvoy := make(map[string][][]string, len(open))
+	for _, c := range open {
+		byConvoy[c.ID] = convoy.FindConvoyCycles(bg, store, townRoot, c.ID, nil)
+	}
+	return byConvoy, nil
+}
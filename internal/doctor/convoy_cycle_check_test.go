@@ -0,0 +1,56 @@
+package doctor
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestConvoyCycleCheck_Metadata(t *testing.T) {
+	check := NewConvoyCycleCheck()
+
+	if check.Name() != "convoy-dependency-cycles" {
+		t.Errorf("Name() = %q, want %q", check.Name(), "convoy-dependency-cycles")
+	}
+	if check.Category() != CategoryCore {
+		t.Errorf("Category() = %q, want %q", check.Category(), CategoryCore)
+	}
+	if check.CanFix() {
+		t.Error("CanFix() should return false (which dependency to drop is a judgment call)")
+	}
+}
+
+func TestConvoyCycleCheck_ReportsCycles(t *testing.T) {
+	check := NewConvoyCycleCheck()
+	check.find = func(string) (map[string][][]string, error) {
+		return map[string][][]string{
+			"hq-cv-b": {{"gt-x"}},
+			"hq-cv-a": {{"gt-a", "gt-b", "gt-c"}},
+			"hq-cv-c": nil,
+		}, nil
+	}
+
+	result := check.Run(&CheckContext{TownRoot: t.TempDir()})
+	if result.Status != StatusError {
+		t.Fatalf("Status = %v, want StatusError", result.Status)
+	}
+	want := []string{"hq-cv-a: gt-a → gt-b → gt-c → gt-a", "hq-cv-b: gt-x → gt-x"}
+	if strings.Join(result.Details, "\n") != strings.Join(want, "\n") {
+		t.Errorf("Details = %q, want %q", result.Details, want)
+	}
+}
+
+func TestConvoyCycleCheck_NoCycles(t *testing.T) {
+	check := NewConvoyCycleCheck()
+	check.find = func(string) (map[string][][]string, error) {
+		return map[string][][]string{"hq-cv-a": nil}, nil
+	}
+	if result := check.Run(&CheckContext{TownRoot: t.TempDir()}); result.Status != StatusOK {
+		t.Errorf("Status = %v, want StatusOK", result.Status)
+	}
+
+	check.find = func(string) (map[string][][]string, error) { return nil, errors.New("bd not found") }
+	if result := check.Run(&CheckContext{TownRoot: t.TempDir()}); result.Status != StatusWarning {
+		t.Errorf("Status = %v, want StatusWarning when convoys can't be listed", result.Status)
+	}
+}
@@ -0,0 +1,52 @@
+package doctor
+
+import (
+	"fmt"
+
+	"github.com/steveyegge/gastown/internal/mayor"
+)
+
+// MayorSessionCheck reports whether the Mayor's tmux session is running.
+// A stopped Mayor is a warning, not an error: the town works without it,
+// but nothing coordinates convoys or answers gt mayor chat.
+type MayorSessionCheck struct {
+	BaseCheck
+}
+
+// NewMayorSessionCheck creates a new Mayor session check.
+func NewMayorSessionCheck() *MayorSessionCheck {
+	return &MayorSessionCheck{
+		BaseCheck: BaseCheck{
+			CheckName:        "mayor-session",
+			CheckDescription: "Check that the Mayor session is running",
+			CheckCategory:    CategoryInfrastructure,
+		},
+	}
+}
+
+// Run checks the Mayor session via the mayor manager.
+func (c *MayorSessionCheck) Run(ctx *CheckContext) *CheckResult {
+	mgr := mayor.NewManager(ctx.TownRoot)
+	running, err := mgr.IsRunning()
+	if err != nil {
+		return &CheckResult{
+			Name:    c.Name(),
+			Status:  StatusWarning,
+			Message: fmt.Sprintf("could not check Mayor session: %v", err),
+			FixHint: "Check that tmux is working (see tmux-binary)",
+		}
+	}
+	if !running {
+		return &CheckResult{
+			Name:    c.Name(),
+			Status:  StatusWarning,
+			Message: fmt.Sprintf("Mayor is not running (session %s)", mgr.SessionName()),
+			FixHint: "Start it with 'gt mayor start'",
+		}
+	}
+	return &CheckResult{
+		Name:    c.Name(),
+		Status:  StatusOK,
+		Message: fmt.Sprintf("Mayor running (session %s)", mgr.SessionName()),
+	}
+}
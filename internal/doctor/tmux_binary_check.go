@@ -0,0 +1,90 @@
+package doctor
+
+import (
+	"fmt"
+
+	"github.com/steveyegge/gastown/internal/deps"
+	"github.com/steveyegge/gastown/internal/tmux"
+)
+
+// TmuxBinaryCheck verifies that tmux is installed, accessible in PATH, and
+// meets the minimum version. Every agent session runs inside tmux.
+type TmuxBinaryCheck struct {
+	BaseCheck
+}
+
+// NewTmuxBinaryCheck creates a new tmux binary availability check.
+func NewTmuxBinaryCheck() *TmuxBinaryCheck {
+	return &TmuxBinaryCheck{
+		BaseCheck: BaseCheck{
+			CheckName:        "tmux-binary",
+			CheckDescription: "Check that tmux is installed and meets minimum version",
+			CheckCategory:    CategoryInfrastructure,
+		},
+	}
+}
+
+// Run checks if tmux is available in PATH and reports its version status.
+func (c *TmuxBinaryCheck) Run(ctx *CheckContext) *CheckResult {
+	// Same lookup the tmux package does before failing a command, so doctor
+	// and gt commands agree on whether tmux is missing.
+	status, version, detail := deps.TmuxNotFound, "", ""
+	if tmux.Available() == nil {
+		status, version, detail = deps.CheckTmux()
+	}
+
+	switch status {
+	case deps.TmuxOK:
+		return &CheckResult{
+			Name:    c.Name(),
+			Status:  StatusOK,
+			Message: fmt.Sprintf("tmux %s", version),
+		}
+
+	case deps.TmuxNotFound:
+		return &CheckResult{
+			Name:    c.Name(),
+			Status:  StatusError,
+			Message: "tmux not found in PATH",
+			Details: []string{
+				"tmux is required to run the Mayor, witnesses, refineries, and polecats",
+			},
+			FixHint: fmt.Sprintf("Install tmux: %s", deps.TmuxInstallURL),
+		}
+
+	case deps.TmuxTooOld:
+		// Older tmux mostly works; surface it without failing the run.
+		return &CheckResult{
+			Name:    c.Name(),
+			Status:  StatusWarning,
+			Message: fmt.Sprintf("tmux %s is older than %s", version, deps.MinTmuxVersion),
+			Details: []string{
+				"Session hooks and window sizing may misbehave on older tmux",
+			},
+			FixHint: fmt.Sprintf("Upgrade tmux: %s", deps.TmuxInstallURL),
+		}
+
+	case deps.TmuxExecFailed:
+		return &CheckResult{
+			Name:    c.Name(),
+			Status:  StatusError,
+			Message: fmt.Sprintf("tmux found but 'tmux -V' failed: %s", detail),
+			FixHint: fmt.Sprintf("Reinstall tmux: %s", deps.TmuxInstallURL),
+		}
+
+	case deps.TmuxUnknown:
+		return &CheckResult{
+			Name:    c.Name(),
+			Status:  StatusWarning,
+			Message: fmt.Sprintf("tmux found but version could not be parsed: %s", detail),
+		}
+	}
+
+	// Unreachable with current TmuxStatus values. Return warning to surface
+	// unexpected states if a new enum value is added without updating this switch.
+	return &CheckResult{
+		Name:    c.Name(),
+		Status:  StatusWarning,
+		Message: "unexpected tmux check status",
+	}
+}
@@ -0,0 +1,40 @@
+package doctor
+
+import (
+	"os/exec"
+	"testing"
+)
+
+func TestTmuxBinaryCheck_Metadata(t *testing.T) {
+	check := NewTmuxBinaryCheck()
+
+	if check.Name() != "tmux-binary" {
+		t.Errorf("Name() = %q, want %q", check.Name(), "tmux-binary")
+	}
+	if check.Category() != CategoryInfrastructure {
+		t.Errorf("Category() = %q, want %q", check.Category(), CategoryInfrastructure)
+	}
+	if check.CanFix() {
+		t.Error("CanFix() should return false (user must install tmux manually)")
+	}
+}
+
+func TestTmuxBinaryCheck_NotInPath(t *testing.T) {
+	t.Setenv("PATH", t.TempDir())
+
+	result := NewTmuxBinaryCheck().Run(&CheckContext{TownRoot: t.TempDir()})
+	if result.Status != StatusError {
+		t.Errorf("Status = %v, want StatusError when tmux is missing", result.Status)
+	}
+}
+
+func TestTmuxBinaryCheck_Installed(t *testing.T) {
+	if _, err := exec.LookPath("tmux"); err != nil {
+		t.Skip("tmux not installed, skipping installed-path test")
+	}
+
+	result := NewTmuxBinaryCheck().Run(&CheckContext{TownRoot: t.TempDir()})
+	if result.Status == StatusError {
+		t.Errorf("Status = StatusError with tmux installed: %s", result.Message)
+	}
+}
@@ -0,0 +1,163 @@
+// Package gtlog is gt's leveled logger for status and diagnostic messages
+// written to stderr.
+//
+// The level is process-wide: gt sets it from --log-level or GT_LOG_LEVEL,
+// and --quiet raises it to warn. Packages that report progress through a
+// caller-supplied printf-style function (a Logger, such as the logger
+// convoy.FeedConvoy takes) pass each message through Filter at its level,
+// so the same threshold applies whether the caller prints to the terminal
+// or writes a daemon log.
+package gtlog
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"sync"
+
+	"github.com/steveyegge/gastown/internal/style"
+	"github.com/steveyegge/gastown/internal/ui"
+)
+
+// EnvLevel names the environment variable that sets the level when
+// --log-level is not given.
+const EnvLevel = "GT_LOG_LEVEL"
+
+// Level is a message severity. Messages below the current level are dropped.
+type Level int
+
+const (
+	Debug Level = iota // diagnostics for troubleshooting: skips, retries, tmux commands
+	Info               // normal progress (the default threshold)
+	Warn               // something went wrong but the command carries on
+	Error              // an operation failed
+)
+
+// String returns the level's name as ParseLevel accepts it.
+func (l Level) String() string {
+	switch l {
+	case Debug:
+		return "debug"
+	case Info:
+		return "info"
+	case Warn:
+		return "warn"
+	case Error:
+		return "error"
+	}
+	return fmt.Sprintf("level(%d)", int(l))
+}
+
+// ParseLevel parses a level name: debug, info, warn (or warning), or error,
+// in any case.
+func ParseLevel(name string) (Level, error) {
+	switch strings.ToLower(strings.TrimSpace(name)) {
+	case "debug":
+		return Debug, nil
+	case "info":
+		return Info, nil
+	case "warn", "warning":
+		return Warn, nil
+	case "error":
+		return Error, nil
+	}
+	return Info, fmt.Errorf("unknown log level %q (want debug, info, warn, or error)", name)
+}
+
+// Logger is the printf-style logging function packages accept from their
+// callers.
+type Logger = func(format string, args ...interface{})
+
+var (
+	mu    sync.RWMutex
+	level           = Info
+	out   io.Writer = os.Stderr
+)
+
+// SetLevel sets the lowest level that is written.
+func SetLevel(l Level) {
+	mu.Lock()
+	defer mu.Unlock()
+	level = l
+}
+
+// GetLevel returns the lowest level that is written.
+func GetLevel() Level {
+	mu.RLock()
+	defer mu.RUnlock()
+	return level
+}
+
+// Quiet raises the level to Warn if it is lower, as --quiet does.
+func Quiet() {
+	mu.Lock()
+	defer mu.Unlock()
+	if level < Warn {
+		level = Warn
+	}
+}
+
+// Enabled reports whether messages at l are written.
+func Enabled(l Level) bool {
+	return l >= GetLevel()
+}
+
+// SetOutput redirects messages, returning the previous writer. Tests use it
+// to capture output; gt writes to stderr.
+func SetOutput(w io.Writer) io.Writer {
+	mu.Lock()
+	defer mu.Unlock()
+	prev := out
+	out = w
+	return prev
+}
+
+// Debugf writes a debug message.
+func Debugf(format string, args ...interface{}) { logf(Debug, format, args...) }
+
+// Infof writes an informational message.
+func Infof(format string, args ...interface{}) { logf(Info, format, args...) }
+
+// Warnf writes a warning.
+func Warnf(format string, args ...interface{}) { logf(Warn, format, args...) }
+
+// Errorf writes an error message. It does not return an error.
+func Errorf(format string, args ...interface{}) { logf(Error, format, args...) }
+
+// At returns a Logger that writes at l, for passing to packages that take
+// one.
+func At(l Level) Logger {
+	return func(format string, args ...interface{}) { logf(l, format, args...) }
+}
+
+// Filter returns a Logger that passes messages to logger only when l is
+// enabled. A nil logger gives a Logger that discards everything.
+func Filter(logger Logger, l Level) Logger {
+	return func(format string, args ...interface{}) {
+		if logger != nil && Enabled(l) {
+			logger(format, args...)
+		}
+	}
+}
+
+func logf(l Level, format string, args ...interface{}) {
+	if !Enabled(l) {
+		return
+	}
+	msg := fmt.Sprintf(format, args...)
+	switch l {
+	case Debug:
+		msg = style.RenderStderr(style.Dim, "debug: "+msg)
+	case Info:
+		msg = style.RenderStderr(style.Dim, msg)
+	case Warn:
+		msg = style.RenderStderr(style.Warning, ui.IconWarn+" Warning:") + " " + msg
+	default:
+		msg = style.RenderStderr(style.Error, ui.IconFail+" Error:") + " " + msg
+	}
+	mu.RLock()
+	w := out
+	mu.RUnlock()
+	fmt.Fprintln(w, msg)
+}
@@ -0,0 +1,71 @@
+package gtlog
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+)
+
+func TestParseLevel(t *testing.T) {
+	for name, want := range map[string]Level{"debug": Debug, "INFO": Info, "warn": Warn, "warning": Warn, " error ": Error} {
+		got, err := ParseLevel(name)
+		if err != nil || got != want {
+			t.Errorf("ParseLevel(%q) = %v, %v; want %v", name, got, err, want)
+		}
+		if got.String() != strings.TrimSuffix(strings.ToLower(strings.TrimSpace(name)), "ing") {
+			t.Errorf("%v.String() = %q", got, got.String())
+		}
+	}
+	if _, err := ParseLevel("verbose"); err == nil {
+		t.Error("ParseLevel(verbose) should fail")
+	}
+}
+
+func TestLevelFiltering(t *testing.T) {
+	var buf bytes.Buffer
+	prevOut, prevLevel := SetOutput(&buf), GetLevel()
+	t.Cleanup(func() { SetOutput(prevOut); SetLevel(prevLevel) })
+
+	SetLevel(Info)
+	Debugf("hidden %d", 1)
+	Infof("shown %d", 2)
+	Warnf("careful")
+	if got := buf.String(); strings.Contains(got, "hidden") || !strings.Contains(got, "shown 2") || !strings.Contains(got, "Warning: careful") {
+		t.Errorf("output at info = %q", got)
+	}
+
+	buf.Reset()
+	Quiet()
+	Infof("chatter")
+	Errorf("broke")
+	if got := buf.String(); strings.Contains(got, "chatter") || !strings.Contains(got, "Error: broke") {
+		t.Errorf("output when quiet = %q", got)
+	}
+
+	SetLevel(Debug)
+	Quiet()
+	if GetLevel() != Warn {
+		t.Errorf("Quiet() from debug = %v, want warn", GetLevel())
+	}
+	SetLevel(Error)
+	Quiet()
+	if GetLevel() != Error {
+		t.Errorf("Quiet() from error = %v, want it unchanged", GetLevel())
+	}
+}
+
+func TestFilter(t *testing.T) {
+	prev := GetLevel()
+	t.Cleanup(func() { SetLevel(prev) })
+	SetLevel(Info)
+
+	var got []string
+	logger := func(format string, args ...interface{}) { got = append(got, format) }
+	Filter(logger, Debug)("skip")
+	Filter(logger, Info)("feed")
+	Filter(logger, Error)("cycle")
+	Filter(nil, Error)("nowhere")
+	if strings.Join(got, ",") != "feed,cycle" {
+		t.Errorf("passed = %v, want [feed cycle]", got)
+	}
+}
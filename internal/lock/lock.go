@@ -308,7 +308,7 @@ func getActiveTmuxSessions() []string {
 		args = append(args, "-L", sock)
 	}
 	args = append(args, "list-sessions", "-F", "#{session_name}:#{session_id}")
-	cmd := execCommand("tmux", args...)
+	cmd := execCommand(tmux.Binary(), args...)
 	output, err := cmd.Output()
 	if err != nil {
 		return nil // tmux not running or not installed
@@ -12,6 +12,7 @@ import (
 	"github.com/steveyegge/gastown/internal/acp"
 	"github.com/steveyegge/gastown/internal/config"
 	"github.com/steveyegge/gastown/internal/constants"
+	"github.com/steveyegge/gastown/internal/gtlog"
 	"github.com/steveyegge/gastown/internal/session"
 	"github.com/steveyegge/gastown/internal/templates"
 	"github.com/steveyegge/gastown/internal/tmux"
@@ -23,6 +24,7 @@ var (
 	ErrNotRunning     = errors.New("mayor not running")
 	ErrAlreadyRunning = errors.New("mayor already running")
 	ErrACPActive      = errors.New("ACP mayor is active")
+	ErrNotReady       = errors.New("mayor did not reach its input prompt")
 )
 
 // Mode represents the mayor session mode.
@@ -95,7 +97,8 @@ func NewManager(townRoot string) *Manager {
 }
 
 // SessionName returns the tmux session name for the mayor.
-// This is a package-level function for convenience.
+// This is a package-level function for convenience. The name honors the
+// town's mayor.session_name setting once session.InitRegistry has run.
 func SessionName() string {
 	return session.MayorSessionName()
 }
@@ -105,6 +108,25 @@ func (m *Manager) SessionName() string {
 	return SessionName()
 }
 
+// NudgeOpts returns the delivery options for nudging the Mayor. They take
+// the town's cross-process nudge lock, so chat, message delivery, and
+// gt nudge mayor take turns at the session with tmux.DefaultNudgeGap
+// between them.
+func (m *Manager) NudgeOpts() tmux.NudgeOpts {
+	return tmux.NudgeOpts{TownRoot: m.townRoot}
+}
+
+// LaunchCommand returns the town's mayor.command setting: the startup
+// command that replaces the one built from the Mayor's agent config.
+// Empty when unset.
+func (m *Manager) LaunchCommand() string {
+	settings, err := config.LoadOrCreateTownSettings(config.TownSettingsPath(m.townRoot))
+	if err != nil || settings.Mayor == nil {
+		return ""
+	}
+	return strings.TrimSpace(settings.Mayor.Command)
+}
+
 // mayorDir returns the working directory for the mayor.
 func (m *Manager) mayorDir() string {
 	return filepath.Join(m.townRoot, "mayor")
@@ -157,6 +179,13 @@ func (m *Manager) StartTMUX(agentOverride string) error {
 		claudeConfigDir = os.Getenv("CLAUDE_CONFIG_DIR")
 	}
 
+	// A configured mayor.command replaces the built command unless an
+	// agent was chosen explicitly.
+	var command string
+	if agentOverride == "" {
+		command = m.LaunchCommand()
+	}
+
 	// Use unified session lifecycle for config → settings → command → create → env → theme → wait.
 	theme := tmux.ResolveSessionTheme(m.townRoot, "", "mayor", "")
 	_, err = session.StartSession(t, session.SessionConfig{
@@ -165,6 +194,7 @@ func (m *Manager) StartTMUX(agentOverride string) error {
 		Role:             "mayor",
 		TownRoot:         m.townRoot,
 		AgentName:        "Mayor",
+		Command:          command,
 		RuntimeConfigDir: claudeConfigDir,
 		Beacon: session.BeaconConfig{
 			Recipient: "mayor",
@@ -228,7 +258,7 @@ func (m *Manager) StartACP(ctx context.Context, agentOverride, rigName string) e
 
 	mayorDir := m.mayorDir()
 	if err := os.Chdir(mayorDir); err != nil {
-		fmt.Fprintf(os.Stderr, "Warning: could not cd to mayor directory: %v\n", err)
+		gtlog.Warnf("could not cd to mayor directory: %v", err)
 	}
 
 	// Initialize ACP components
@@ -236,7 +266,7 @@ func (m *Manager) StartACP(ctx context.Context, agentOverride, rigName string) e
 
 	startupPrompt, err := m.buildACPStartupPrompt()
 	if err != nil {
-		fmt.Fprintf(os.Stderr, "Warning: could not render mayor prime context for ACP startup: %v\n", err)
+		gtlog.Warnf("could not render mayor prime context for ACP startup: %v", err)
 	}
 	proxy.SetStartupPrompt(startupPrompt)
 	proxy.SetPIDFilePath(ACPPidFilePath(m.townRoot))
@@ -246,25 +276,25 @@ func (m *Manager) StartACP(ctx context.Context, agentOverride, rigName string) e
 
 	// Transition Point: Stop TMUX mayor if running, but only after ACP setup is ready.
 	if running, _ := m.IsRunning(); running {
-		fmt.Fprintf(os.Stderr, "Stopping tmux mayor to switch to ACP mode...\n")
+		gtlog.Infof("Stopping tmux mayor to switch to ACP mode...")
 		if err := m.Stop(); err != nil {
-			fmt.Fprintf(os.Stderr, "Warning: could not stop tmux mayor: %v\n", err)
+			gtlog.Warnf("could not stop tmux mayor: %v", err)
 		}
 	}
 
 	// Write ACP PID and agent name after successful transition/stop
 	if err := WriteACPPid(m.townRoot); err != nil {
-		fmt.Fprintf(os.Stderr, "Warning: could not write ACP PID file: %v\n", err)
+		gtlog.Warnf("could not write ACP PID file: %v", err)
 	}
 	if err := WriteACPAgent(m.townRoot, agentName); err != nil {
-		fmt.Fprintf(os.Stderr, "Warning: could not write ACP agent file: %v\n", err)
+		gtlog.Warnf("could not write ACP agent file: %v", err)
 	}
 	defer func() {
 		if err := RemoveACPPid(m.townRoot); err != nil {
-			fmt.Fprintf(os.Stderr, "Warning: could not remove ACP PID file: %v\n", err)
+			gtlog.Warnf("could not remove ACP PID file: %v", err)
 		}
 		if err := RemoveACPAgent(m.townRoot); err != nil {
-			fmt.Fprintf(os.Stderr, "Warning: could not remove ACP agent file: %v\n", err)
+			gtlog.Warnf("could not remove ACP agent file: %v", err)
 		}
 	}()
 
@@ -325,8 +355,22 @@ func (m *Manager) StartACP(ctx context.Context, agentOverride, rigName string) e
 	return proxy.Forward()
 }
 
-// Stop stops the mayor session.
+// stopGraceTimeout bounds how long Stop waits for the agent to exit on its
+// own before the session is killed.
+const stopGraceTimeout = 5 * time.Second
+
+// Stop stops the mayor session, interrupting the agent and giving it
+// stopGraceTimeout to exit before killing the session and its processes.
 func (m *Manager) Stop() error {
+	return m.stop(false)
+}
+
+// Kill stops the mayor session immediately, skipping the graceful phase.
+func (m *Manager) Kill() error {
+	return m.stop(true)
+}
+
+func (m *Manager) stop(force bool) error {
 	t := tmux.NewTmux()
 	sessionID := m.SessionName()
 
@@ -339,12 +383,15 @@ func (m *Manager) Stop() error {
 		return ErrNotRunning
 	}
 
-	// Try graceful shutdown first (best-effort interrupt)
-	_ = t.SendKeysRaw(sessionID, "C-c")
-	time.Sleep(100 * time.Millisecond)
-
-	// Kill the session and all its processes
-	if err := t.KillSessionWithProcesses(sessionID); err != nil {
+	if force {
+		err = t.KillSessionWithProcesses(sessionID)
+	} else {
+		err = t.KillSessionGraceful(sessionID, stopGraceTimeout)
+		if errors.Is(err, tmux.ErrSessionNotFound) {
+			return nil // exited during the graceful phase
+		}
+	}
+	if err != nil {
 		return fmt.Errorf("killing session: %w", err)
 	}
 
@@ -357,6 +404,55 @@ func (m *Manager) IsRunning() (bool, error) {
 	return t.HasSession(m.SessionName())
 }
 
+// IsIdle reports whether the mayor is sitting at its input prompt with no
+// work in progress. Returns ErrNotRunning if the session doesn't exist.
+func (m *Manager) IsIdle() (bool, error) {
+	t := tmux.NewTmux()
+	running, err := t.HasSession(m.SessionName())
+	if err != nil {
+		return false, fmt.Errorf("checking session: %w", err)
+	}
+	if !running {
+		return false, ErrNotRunning
+	}
+	return t.IsIdle(m.SessionName()), nil
+}
+
+// WaitForIdle blocks until the mayor returns to its input prompt or the
+// timeout expires (tmux.ErrIdleTimeout).
+func (m *Manager) WaitForIdle(timeout time.Duration) error {
+	return tmux.NewTmux().WaitForIdle(m.SessionName(), timeout)
+}
+
+// promptPollInterval is how often WaitForPrompt checks the pane.
+const promptPollInterval = 500 * time.Millisecond
+
+// WaitForPrompt blocks until the mayor's pane shows the agent's input prompt,
+// meaning the agent has started and can accept a message. Unlike WaitForIdle
+// it does not wait for in-progress work (such as the cold-start beacon) to
+// finish. Returns ErrNotRunning if the session is gone and ErrNotReady if
+// the prompt does not appear within timeout.
+func (m *Manager) WaitForPrompt(timeout time.Duration) error {
+	t := tmux.NewTmux()
+	deadline := time.Now().Add(timeout)
+	for {
+		running, err := t.HasSession(m.SessionName())
+		if err != nil {
+			return fmt.Errorf("checking session: %w", err)
+		}
+		if !running {
+			return ErrNotRunning
+		}
+		if t.IsAtPrompt(m.SessionName(), nil) {
+			return nil
+		}
+		if time.Now().After(deadline) {
+			return ErrNotReady
+		}
+		time.Sleep(promptPollInterval)
+	}
+}
+
 // Status returns information about the mayor session.
 func (m *Manager) Status() (*tmux.SessionInfo, error) {
 	t := tmux.NewTmux()
@@ -378,7 +378,7 @@ func (m *Manager) SetAgentStateWithRetry(name string, state string) error {
 // assigneeID returns the beads assignee identifier for a polecat.
 // Format: "rig/polecats/polecatName" (e.g., "gastown/polecats/Toast")
 func (m *Manager) assigneeID(name string) string {
-	return fmt.Sprintf("%s/polecats/%s", m.rig.Name, name)
+	return session.FormatAssignee(m.rig.Name, name)
 }
 
 // agentBeadID returns the agent bead ID for a polecat.
@@ -2456,9 +2456,8 @@ func (m *Manager) DetectStalePolecats(threshold int) ([]*StalenessInfo, error) {
 
 // checkTmuxSession checks if a tmux session exists.
 func checkTmuxSession(sessionName string) bool {
-	// Use has-session command which returns 0 if session exists
-	cmd := tmux.BuildCommand("has-session", "-t", sessionName)
-	return cmd.Run() == nil
+	exists, err := tmux.NewTmux().HasSession(sessionName)
+	return err == nil && exists
 }
 
 // countCommitsBehind counts how many commits a worktree is behind origin/<defaultBranch>.
@@ -466,7 +466,7 @@ func (m *SessionManager) Start(polecat string, opts SessionStartOptions) error {
 	envVarsToInject := map[string]string{
 		"GT_RIG":          m.rig.Name,
 		"GT_POLECAT":      polecat,
-		"GT_ROLE":         fmt.Sprintf("%s/polecats/%s", m.rig.Name, polecat),
+		"GT_ROLE":         session.FormatAssignee(m.rig.Name, polecat),
 		"GT_POLECAT_PATH": workDir,
 		"GT_TOWN_ROOT":    townRoot,
 		"GT_RUN":          runID,
@@ -539,7 +539,7 @@ func (m *SessionManager) Start(polecat string, opts SessionStartOptions) error {
 
 	// Hook the issue to the polecat if provided via --issue flag
 	if opts.Issue != "" {
-		agentID := fmt.Sprintf("%s/polecats/%s", m.rig.Name, polecat)
+		agentID := session.FormatAssignee(m.rig.Name, polecat)
 		if err := m.hookIssue(opts.Issue, agentID, workDir); err != nil {
 			style.PrintWarning("could not hook issue %s: %v", opts.Issue, err)
 		}
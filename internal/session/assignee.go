@@ -0,0 +1,30 @@
+package session
+
+import "strings"
+
+// polecatsSegment is the middle segment of a polecat's address.
+const polecatsSegment = "polecats"
+
+// FormatAssignee returns the beads assignee (and mail address) for a polecat:
+// "<rig>/polecats/<name>", e.g. "gastown/polecats/Toast".
+func FormatAssignee(rig, name string) string {
+	return rig + "/" + polecatsSegment + "/" + name
+}
+
+// ParseAssignee splits a polecat assignee of the form "<rig>/polecats/<name>"
+// into its rig and polecat name. A single trailing slash is tolerated; empty
+// segments, extra segments, and non-polecat addresses (crew, witness, ...)
+// report ok=false.
+func ParseAssignee(s string) (rig, name string, ok bool) {
+	parts := strings.Split(strings.TrimSuffix(s, "/"), "/")
+	if len(parts) != 3 || parts[1] != polecatsSegment || parts[0] == "" || parts[2] == "" {
+		return "", "", false
+	}
+	return parts[0], parts[2], true
+}
+
+// IsPolecat reports whether an assignee or actor string names a polecat.
+func IsPolecat(s string) bool {
+	_, _, ok := ParseAssignee(s)
+	return ok
+}
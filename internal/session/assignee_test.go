@@ -0,0 +1,45 @@
+package session
+
+import "testing"
+
+func TestParseAssignee(t *testing.T) {
+	tests := []struct {
+		in     string
+		rig    string
+		name   string
+		wantOK bool
+	}{
+		{"gastown/polecats/alpha", "gastown", "alpha", true},
+		{"gastown/polecats/alpha/", "gastown", "alpha", true},
+		{"beads/polecats/witness", "beads", "witness", true}, // a polecat named witness
+		{"gastown/polecats/", "", "", false},
+		{"gastown/polecats", "", "", false},
+		{"/polecats/alpha", "", "", false},
+		{"gastown//alpha", "", "", false},
+		{"gastown/polecats/alpha/extra", "", "", false},
+		{"gastown/polecats/alpha//", "", "", false},
+		{"gastown/crew/max", "", "", false},
+		{"gastown/witness", "", "", false},
+		{"mayor", "", "", false},
+		{"", "", "", false},
+	}
+	for _, tt := range tests {
+		rig, name, ok := ParseAssignee(tt.in)
+		if ok != tt.wantOK || rig != tt.rig || name != tt.name {
+			t.Errorf("ParseAssignee(%q) = (%q, %q, %v), want (%q, %q, %v)", tt.in, rig, name, ok, tt.rig, tt.name, tt.wantOK)
+		}
+		if IsPolecat(tt.in) != tt.wantOK {
+			t.Errorf("IsPolecat(%q) = %v, want %v", tt.in, !tt.wantOK, tt.wantOK)
+		}
+	}
+}
+
+func TestFormatAssigneeRoundTrip(t *testing.T) {
+	s := FormatAssignee("gastown", "Toast")
+	if s != "gastown/polecats/Toast" {
+		t.Fatalf("FormatAssignee() = %q", s)
+	}
+	if rig, name, ok := ParseAssignee(s); !ok || rig != "gastown" || name != "Toast" {
+		t.Errorf("ParseAssignee(FormatAssignee()) = (%q, %q, %v)", rig, name, ok)
+	}
+}
@@ -113,6 +113,10 @@ func ParseSessionNameWithRegistry(session string, registry *PrefixRegistry) (*Ag
 	// Known town-level roles are matched first; unknown suffixes fall through
 	// to rig-level parsing so that hq-witness, hq-refinery, hq-<polecat> etc.
 	// resolve correctly when "hq" is a rig prefix.
+	// A town's configured Mayor session may not be plain hq-mayor.
+	if session == MayorSessionName() {
+		return &AgentIdentity{Role: RoleMayor}, nil
+	}
 	if strings.HasPrefix(session, HQPrefix) {
 		suffix := strings.TrimPrefix(session, HQPrefix)
 		switch suffix {
@@ -266,7 +270,7 @@ func (a *AgentIdentity) Address() string {
 	case RoleCrew:
 		return fmt.Sprintf("%s/crew/%s", a.Rig, a.Name)
 	case RolePolecat:
-		return fmt.Sprintf("%s/polecats/%s", a.Rig, a.Name)
+		return FormatAssignee(a.Rig, a.Name)
 	case RoleDog:
 		return fmt.Sprintf("deacon/dogs/%s", a.Name)
 	default:
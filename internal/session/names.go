@@ -3,6 +3,8 @@ package session
 
 import (
 	"fmt"
+	"strings"
+	"sync"
 )
 
 // DefaultPrefix is the default beads prefix used when no rig-specific prefix is known.
@@ -11,12 +13,48 @@ const DefaultPrefix = "gt"
 // HQPrefix is the prefix for town-level services (Mayor, Deacon).
 const HQPrefix = "hq-"
 
-// MayorSessionName returns the session name for the Mayor agent.
-// One mayor per machine - multi-town requires containers/VMs for isolation.
+// mayorSessionName overrides the Mayor's session name when non-empty.
+// Set by InitRegistry from the town's mayor.session_name setting.
+var (
+	mayorSessionName   string
+	mayorSessionNameMu sync.RWMutex
+)
+
+// MayorSessionName returns the session name for the Mayor agent:
+// "hq-mayor" unless the town's mayor.session_name setting chose another.
 func MayorSessionName() string {
+	mayorSessionNameMu.RLock()
+	defer mayorSessionNameMu.RUnlock()
+	if mayorSessionName != "" {
+		return mayorSessionName
+	}
 	return HQPrefix + "mayor"
 }
 
+// SetMayorSessionName sets the package-level Mayor session name.
+// Empty restores the default.
+func SetMayorSessionName(name string) {
+	mayorSessionNameMu.Lock()
+	mayorSessionName = name
+	mayorSessionNameMu.Unlock()
+}
+
+// MayorSessionNameFor resolves a mayor.session_name setting for a town.
+// Empty gives "hq-mayor"; "auto" gives "hq-mayor-" plus the town's socket
+// name (basename and path hash), distinct for every town root; any other
+// value is sanitized and kept under the hq- prefix so it is still
+// recognized as a Gas Town session.
+func MayorSessionNameFor(townRoot, setting string) string {
+	switch setting {
+	case "":
+		return HQPrefix + "mayor"
+	case "auto":
+		return HQPrefix + "mayor-" + townSocketName(townRoot)
+	}
+	name := sanitizeTownName(strings.TrimPrefix(setting, HQPrefix))
+	return HQPrefix + name
+}
+
 // DeaconSessionName returns the session name for the Deacon agent.
 // One deacon per machine - multi-town requires containers/VMs for isolation.
 func DeaconSessionName() string {
@@ -1,11 +1,12 @@
 package session
 
 import (
+	"strings"
 	"testing"
 )
 
 func TestMayorSessionName(t *testing.T) {
-	// Mayor session name is now fixed (one per machine), uses HQ prefix
+	// Default Mayor session name uses the HQ prefix
 	want := "hq-mayor"
 	got := MayorSessionName()
 	if got != want {
@@ -13,6 +14,54 @@ func TestMayorSessionName(t *testing.T) {
 	}
 }
 
+func TestSetMayorSessionName(t *testing.T) {
+	t.Cleanup(func() { SetMayorSessionName("") })
+
+	SetMayorSessionName("hq-mayor-work")
+	if got := MayorSessionName(); got != "hq-mayor-work" {
+		t.Errorf("MayorSessionName() = %q, want hq-mayor-work", got)
+	}
+	id, err := ParseSessionName("hq-mayor-work")
+	if err != nil || id.Role != RoleMayor {
+		t.Errorf("ParseSessionName(hq-mayor-work) = %+v, %v; want mayor", id, err)
+	}
+
+	SetMayorSessionName("")
+	if got := MayorSessionName(); got != "hq-mayor" {
+		t.Errorf("after reset MayorSessionName() = %q, want hq-mayor", got)
+	}
+}
+
+func TestMayorSessionNameFor(t *testing.T) {
+	a := MayorSessionNameFor("/home/alice/gt", "auto")
+	b := MayorSessionNameFor("/home/bob/gt", "auto")
+	if a == b {
+		t.Errorf("towns with different roots share Mayor session %q", a)
+	}
+	for _, name := range []string{a, b} {
+		if !strings.HasPrefix(name, "hq-mayor-gt-") {
+			t.Errorf("auto name %q, want hq-mayor-gt-<hash>", name)
+		}
+	}
+	if again := MayorSessionNameFor("/home/alice/gt", "auto"); again != a {
+		t.Errorf("auto name not stable: %q then %q", a, again)
+	}
+
+	tests := []struct {
+		setting string
+		want    string
+	}{
+		{"", "hq-mayor"},
+		{"mayor-work", "hq-mayor-work"},
+		{"hq-Mayor.Work", "hq-mayor-work"},
+	}
+	for _, tt := range tests {
+		if got := MayorSessionNameFor("/home/alice/gt", tt.setting); got != tt.want {
+			t.Errorf("MayorSessionNameFor(%q) = %q, want %q", tt.setting, got, tt.want)
+		}
+	}
+}
+
 func TestDeaconSessionName(t *testing.T) {
 	// Deacon session name is now fixed (one per machine), uses HQ prefix
 	want := "hq-deacon"
@@ -132,6 +132,15 @@ func InitRegistry(townRoot string) error {
 	}
 	tmux.SetDefaultSocket(socket)
 
+	// Mayor session name from town settings (mayor.session_name).
+	mayorName := ""
+	if settings, err := config.LoadOrCreateTownSettings(config.TownSettingsPath(townRoot)); err != nil {
+		errs = append(errs, fmt.Errorf("town settings: %w", err))
+	} else if settings.Mayor != nil {
+		mayorName = settings.Mayor.SessionName
+	}
+	SetMayorSessionName(MayorSessionNameFor(townRoot, mayorName))
+
 	r, err := BuildPrefixRegistryFromTown(townRoot)
 	if err != nil {
 		errs = append(errs, fmt.Errorf("prefix registry: %w", err))
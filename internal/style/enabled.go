@@ -0,0 +1,59 @@
+package style
+
+import (
+	"os"
+	"sync/atomic"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/muesli/termenv"
+	"github.com/steveyegge/gastown/internal/ui"
+	"golang.org/x/term"
+)
+
+// enabled is the central switch for styled output. Every style in this
+// package renders through lipgloss's global color profile, which SetEnabled
+// keeps in step with this flag.
+var enabled atomic.Bool
+
+func init() {
+	// ui.ShouldUseColor honors NO_COLOR, CLICOLOR and CLICOLOR_FORCE, and
+	// otherwise requires stdout to be a terminal.
+	SetEnabled(ui.ShouldUseColor())
+}
+
+// Enabled reports whether styles emit escape sequences.
+func Enabled() bool {
+	return enabled.Load()
+}
+
+// SetEnabled turns styling on or off for the whole process. It is set at
+// startup from the environment; tests use it to get deterministic output.
+func SetEnabled(on bool) {
+	enabled.Store(on)
+	if on {
+		lipgloss.SetColorProfile(termenv.TrueColor)
+	} else {
+		lipgloss.SetColorProfile(termenv.Ascii)
+	}
+	SuccessPrefix = Success.Render(ui.IconPass)
+	WarningPrefix = Warning.Render(ui.IconWarn)
+	ErrorPrefix = Error.Render(ui.IconFail)
+	ArrowPrefix = Info.Render("→")
+}
+
+// RenderStderr renders s with st for writing to stderr. Styling is applied
+// only when enabled and stderr is itself a terminal, so diagnostics don't
+// leave escape codes in a redirected log while stdout is still a TTY.
+func RenderStderr(st lipgloss.Style, s string) string {
+	if !Enabled() || !stderrIsTerminal() {
+		return s
+	}
+	return st.Render(s)
+}
+
+func stderrIsTerminal() bool {
+	if _, force := os.LookupEnv("CLICOLOR_FORCE"); force {
+		return true
+	}
+	return term.IsTerminal(int(os.Stderr.Fd()))
+}
@@ -0,0 +1,47 @@
+package style
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestSetEnabled(t *testing.T) {
+	prev := Enabled()
+	t.Cleanup(func() { SetEnabled(prev) })
+
+	SetEnabled(true)
+	if got := Bold.Render("x"); !strings.Contains(got, "\x1b[") {
+		t.Errorf("Bold.Render() enabled = %q, want escape sequence", got)
+	}
+	if !strings.Contains(SuccessPrefix, "\x1b[") {
+		t.Errorf("SuccessPrefix enabled = %q, want escape sequence", SuccessPrefix)
+	}
+
+	SetEnabled(false)
+	if got := Bold.Render("x"); got != "x" {
+		t.Errorf("Bold.Render() disabled = %q, want plain", got)
+	}
+	if got := Status("blocked"); got != "blocked" {
+		t.Errorf("Status() disabled = %q, want plain", got)
+	}
+	if strings.Contains(SuccessPrefix, "\x1b[") {
+		t.Errorf("SuccessPrefix disabled = %q, want plain", SuccessPrefix)
+	}
+}
+
+func TestRenderStderr_PlainWhenNotTerminal(t *testing.T) {
+	prev := Enabled()
+	t.Cleanup(func() { SetEnabled(prev) })
+	t.Setenv("CLICOLOR_FORCE", "")
+
+	SetEnabled(true)
+	// CLICOLOR_FORCE is set (even empty), so stderr counts as a terminal.
+	if got := RenderStderr(Bold, "x"); !strings.Contains(got, "\x1b[") {
+		t.Errorf("RenderStderr() with CLICOLOR_FORCE = %q, want escape sequence", got)
+	}
+
+	SetEnabled(false)
+	if got := RenderStderr(Bold, "x"); got != "x" {
+		t.Errorf("RenderStderr() disabled = %q, want plain", got)
+	}
+}
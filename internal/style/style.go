@@ -51,10 +51,49 @@ var (
 	ArrowPrefix = Info.Render("→")
 )
 
+// Issue and session state styles, shared with internal/ui's status palette.
+// Like all styles here they render plain text when NO_COLOR is set or stdout
+// is not a terminal.
+var (
+	// Open style for work that is available but not started
+	Open = ui.StatusOpenStyle
+
+	// InProgress style for active work (in_progress)
+	InProgress = ui.StatusInProgressStyle
+
+	// Hooked style for work attached to an agent's hook
+	Hooked = ui.StatusHookedStyle
+
+	// Blocked style for work waiting on a dependency
+	Blocked = ui.StatusBlockedStyle
+
+	// Closed style for finished work
+	Closed = ui.StatusClosedStyle
+)
+
+// Status renders a status string (open, in_progress, hooked, blocked, closed)
+// in its state style. Unrecognized statuses are returned unstyled.
+func Status(s string) string {
+	switch s {
+	case "open":
+		return Open.Render(s)
+	case "in_progress":
+		return InProgress.Render(s)
+	case "hooked":
+		return Hooked.Render(s)
+	case "blocked":
+		return Blocked.Render(s)
+	case "closed":
+		return Closed.Render(s)
+	default:
+		return s
+	}
+}
+
 // PrintWarning prints a warning message to stderr with consistent formatting.
 // The format and args work like fmt.Printf.
 // Writes to stderr so warnings never contaminate structured (JSON) output on stdout.
 func PrintWarning(format string, args ...interface{}) {
 	msg := fmt.Sprintf(format, args...)
-	fmt.Fprintf(os.Stderr, "%s %s\n", Warning.Render(ui.IconWarn+" Warning:"), msg)
+	fmt.Fprintf(os.Stderr, "%s %s\n", RenderStderr(Warning, ui.IconWarn+" Warning:"), msg)
 }
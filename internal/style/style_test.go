@@ -196,3 +196,12 @@ func ExamplePrintWarning() {
 	PrintWarning("This is a warning message")
 	PrintWarning("Warning with value: %d", 42)
 }
+
+func TestStatus(t *testing.T) {
+	// Tests don't run on a TTY, so styles render as plain text.
+	for _, s := range []string{"open", "in_progress", "hooked", "blocked", "closed", "deferred", ""} {
+		if got := Status(s); got != s {
+			t.Errorf("Status(%q) = %q, want plain %q without a terminal", s, got, s)
+		}
+	}
+}
@@ -2,6 +2,8 @@
 package style
 
 import (
+	"encoding/csv"
+	"io"
 	"regexp"
 	"strings"
 
@@ -9,6 +11,7 @@ import (
 )
 
 // Column defines a table column with name and width.
+// A Width of 0 sizes the column to its widest cell.
 type Column struct {
 	Name  string
 	Width int
@@ -27,19 +30,21 @@ const (
 
 // Table provides styled table rendering.
 type Table struct {
-	columns    []Column
-	rows       [][]string
-	headerSep  bool
-	indent     string
+	columns     []Column
+	rows        [][]string
+	header      bool
+	headerSep   bool
+	indent      string
 	headerStyle lipgloss.Style
 }
 
 // NewTable creates a new table with the given columns.
 func NewTable(columns ...Column) *Table {
 	return &Table{
-		columns:    columns,
-		headerSep:  true,
-		indent:     "  ",
+		columns:     columns,
+		header:      true,
+		headerSep:   true,
+		indent:      "  ",
 		headerStyle: Bold,
 	}
 }
@@ -50,6 +55,13 @@ func (t *Table) SetIndent(indent string) *Table {
 	return t
 }
 
+// SetHeader enables/disables the header row and its separator, e.g. for a
+// command's --no-header flag.
+func (t *Table) SetHeader(enabled bool) *Table {
+	t.header = enabled
+	return t
+}
+
 // SetHeaderSeparator enables/disables the header separator line.
 func (t *Table) SetHeaderSeparator(enabled bool) *Table {
 	t.headerSep = enabled
@@ -72,34 +84,34 @@ func (t *Table) Render() string {
 		return ""
 	}
 
+	widths := t.widths()
 	var sb strings.Builder
 
-	// Render header
-	sb.WriteString(t.indent)
-	for i, col := range t.columns {
-		text := t.headerStyle.Render(col.Name)
-		sb.WriteString(t.pad(text, col.Name, col.Width, col.Align))
-		if i < len(t.columns)-1 {
-			sb.WriteString(" ")
-		}
-	}
-	sb.WriteString("\n")
-
-	// Render separator
-	if t.headerSep {
+	if t.header {
 		sb.WriteString(t.indent)
-		totalWidth := 0
 		for i, col := range t.columns {
-			totalWidth += col.Width
+			text := t.headerStyle.Render(col.Name)
+			sb.WriteString(t.pad(text, visibleWidth(col.Name), widths[i], col.Align))
 			if i < len(t.columns)-1 {
-				totalWidth++ // space between columns
+				sb.WriteString(" ")
 			}
 		}
-		sb.WriteString(Dim.Render(strings.Repeat("─", totalWidth)))
 		sb.WriteString("\n")
+
+		if t.headerSep {
+			sb.WriteString(t.indent)
+			totalWidth := 0
+			for i, w := range widths {
+				totalWidth += w
+				if i < len(widths)-1 {
+					totalWidth++ // space between columns
+				}
+			}
+			sb.WriteString(Dim.Render(strings.Repeat("─", totalWidth)))
+			sb.WriteString("\n")
+		}
 	}
 
-	// Render rows
 	for _, row := range t.rows {
 		sb.WriteString(t.indent)
 		for i, col := range t.columns {
@@ -107,16 +119,16 @@ func (t *Table) Render() string {
 			if i < len(row) {
 				val = row[i]
 			}
-			// Truncate if too long
-			plainVal := stripAnsi(val)
-			if len(plainVal) > col.Width {
-				val = plainVal[:col.Width-3] + "..."
+			width := visibleWidth(val)
+			if width > widths[i] {
+				val = truncate(stripAnsi(val), widths[i])
+				width = visibleWidth(val)
 			}
 			// Apply column style if set
 			if col.Style.Value() != "" {
 				val = col.Style.Render(val)
 			}
-			sb.WriteString(t.pad(val, plainVal, col.Width, col.Align))
+			sb.WriteString(t.pad(val, width, widths[i], col.Align))
 			if i < len(t.columns)-1 {
 				sb.WriteString(" ")
 			}
@@ -127,15 +139,64 @@ func (t *Table) Render() string {
 	return sb.String()
 }
 
-// pad pads text to width, accounting for ANSI escape sequences.
-// styledText is the text with ANSI codes, plainText is without.
-func (t *Table) pad(styledText, plainText string, width int, align Alignment) string {
-	plainLen := len(plainText)
-	if plainLen >= width {
+// RenderCSV writes the table as CSV: a header row of column names (unless
+// disabled with SetHeader), then the rows with styling stripped. Cells are
+// written in full, never truncated to the column width, and fields holding
+// commas, quotes, or newlines are quoted.
+func (t *Table) RenderCSV(w io.Writer) error {
+	cw := csv.NewWriter(w)
+	if t.header {
+		names := make([]string, len(t.columns))
+		for i, col := range t.columns {
+			names[i] = col.Name
+		}
+		if err := cw.Write(names); err != nil {
+			return err
+		}
+	}
+	for _, row := range t.rows {
+		record := make([]string, len(t.columns))
+		for i := range t.columns {
+			if i < len(row) {
+				record[i] = stripAnsi(row[i])
+			}
+		}
+		if err := cw.Write(record); err != nil {
+			return err
+		}
+	}
+	cw.Flush()
+	return cw.Error()
+}
+
+// widths resolves each column's width, sizing Width-0 columns to fit their
+// header and cells.
+func (t *Table) widths() []int {
+	widths := make([]int, len(t.columns))
+	for i, col := range t.columns {
+		if col.Width > 0 {
+			widths[i] = col.Width
+			continue
+		}
+		if t.header {
+			widths[i] = visibleWidth(col.Name)
+		}
+		for _, row := range t.rows {
+			if i < len(row) {
+				widths[i] = max(widths[i], visibleWidth(row[i]))
+			}
+		}
+	}
+	return widths
+}
+
+// pad pads styledText, whose visible width is textWidth, to width.
+func (t *Table) pad(styledText string, textWidth, width int, align Alignment) string {
+	if textWidth >= width {
 		return styledText
 	}
 
-	padding := width - plainLen
+	padding := width - textWidth
 
 	switch align {
 	case AlignRight:
@@ -157,3 +218,33 @@ func stripAnsi(s string) string {
 	return ansiRegex.ReplaceAllString(s, "")
 }
 
+// visibleWidth returns the number of terminal cells s occupies, ignoring
+// ANSI escape sequences and counting wide runes as two.
+func visibleWidth(s string) int {
+	return lipgloss.Width(s)
+}
+
+// truncate shortens plain text to at most width cells, ending in "..."
+// when there is room for it.
+func truncate(s string, width int) string {
+	const ellipsis = "..."
+	if width <= len(ellipsis) {
+		return takeWidth(s, width)
+	}
+	return takeWidth(s, width-len(ellipsis)) + ellipsis
+}
+
+// takeWidth returns the longest prefix of s that fits in width cells.
+func takeWidth(s string, width int) string {
+	var sb strings.Builder
+	used := 0
+	for _, r := range s {
+		w := lipgloss.Width(string(r))
+		if used+w > width {
+			break
+		}
+		sb.WriteRune(r)
+		used += w
+	}
+	return sb.String()
+}
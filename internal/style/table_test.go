@@ -0,0 +1,70 @@
+package style
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestTable_AlignsStyledAndWideCells(t *testing.T) {
+	prev := Enabled()
+	t.Cleanup(func() { SetEnabled(prev) })
+	SetEnabled(true)
+
+	tbl := NewTable(
+		Column{Name: "ID", Width: 6},
+		Column{Name: "STATUS"},
+		Column{Name: "N", Align: AlignRight},
+	).SetIndent("").SetHeaderSeparator(false)
+	tbl.AddRow("gt-1", Status("closed"), "7")
+	tbl.AddRow("gt-22", "✓ open", "12")
+
+	lines := strings.Split(strings.TrimSuffix(tbl.Render(), "\n"), "\n")
+	if len(lines) != 3 {
+		t.Fatalf("got %d lines, want 3:\n%s", len(lines), tbl.Render())
+	}
+	// STATUS auto-sizes to "✓ open" (6 cells) despite escape codes in "closed".
+	want := []string{
+		"ID     STATUS  N",
+		"gt-1   closed  7",
+		"gt-22  ✓ open 12",
+	}
+	for i, line := range lines {
+		if got := stripAnsi(line); got != want[i] {
+			t.Errorf("line %d = %q, want %q", i, got, want[i])
+		}
+	}
+}
+
+func TestTable_NoHeaderAndTruncate(t *testing.T) {
+	tbl := NewTable(Column{Name: "TITLE", Width: 8}).SetIndent("").SetHeader(false)
+	tbl.AddRow("a very long title")
+	if got := tbl.Render(); got != "a ver...\n" {
+		t.Errorf("Render() = %q, want truncated row without header", got)
+	}
+}
+
+func TestTable_RenderCSV(t *testing.T) {
+	prev := Enabled()
+	t.Cleanup(func() { SetEnabled(prev) })
+	SetEnabled(true)
+
+	tbl := NewTable(Column{Name: "ID"}, Column{Name: "STATUS"}, Column{Name: "TITLE", Width: 8})
+	tbl.AddRow("gt-1", Status("closed"), `Fix "quoted", comma title`)
+	tbl.AddRow("gt-2")
+
+	var buf strings.Builder
+	if err := tbl.RenderCSV(&buf); err != nil {
+		t.Fatalf("RenderCSV: %v", err)
+	}
+	want := "ID,STATUS,TITLE\n" +
+		"gt-1,closed,\"Fix \"\"quoted\"\", comma title\"\n" +
+		"gt-2,,\n"
+	if buf.String() != want {
+		t.Errorf("RenderCSV() =\n%s\nwant\n%s", buf.String(), want)
+	}
+
+	buf.Reset()
+	if err := tbl.SetHeader(false).RenderCSV(&buf); err != nil || strings.HasPrefix(buf.String(), "ID,") {
+		t.Errorf("RenderCSV() without header = %q, %v", buf.String(), err)
+	}
+}
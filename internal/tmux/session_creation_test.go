@@ -1,6 +1,10 @@
 package tmux
 
 import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
 	"strings"
 	"testing"
 	"time"
@@ -248,3 +252,110 @@ func TestSendMessageToTarget_Chunking(t *testing.T) {
 		t.Errorf("expected ~600 A's in output, got %d (message may have been truncated)", count)
 	}
 }
+
+// TestSendMessageToTarget_SpecialCharacters verifies shell metacharacters and
+// embedded newlines arrive literally rather than being interpreted.
+func TestSendMessageToTarget_SpecialCharacters(t *testing.T) {
+	tm := newTestTmux(t)
+	session := "gt-test-special-" + t.Name()
+	_ = tm.KillSession(session)
+	defer func() { _ = tm.KillSession(session) }()
+
+	if err := tm.NewSessionWithCommand(session, "", "cat"); err != nil {
+		t.Fatalf("session creation: %v", err)
+	}
+
+	msg := "echo $(whoami) \"double\" 'single' `tick`; rm x\nsecond line $HOME"
+	if err := tm.sendMessageToTarget(session, msg); err != nil {
+		t.Fatalf("sendMessageToTarget: %v", err)
+	}
+	if _, err := tm.run("send-keys", "-t", session, "Enter"); err != nil {
+		t.Fatalf("send Enter: %v", err)
+	}
+
+	var content string
+	deadline := time.Now().Add(3 * time.Second)
+	for time.Now().Before(deadline) {
+		content, _ = tm.CapturePane(session, 20)
+		if strings.Contains(content, "second line") {
+			break
+		}
+		time.Sleep(100 * time.Millisecond)
+	}
+	for _, want := range []string{"echo $(whoami) \"double\" 'single' `tick`; rm x", "second line $HOME"} {
+		if !strings.Contains(content, want) {
+			t.Errorf("pane missing %q; got:\n%s", want, content)
+		}
+	}
+}
+
+// TestNewSessionWithOpts_FixedSize verifies a session created with a fixed
+// window size runs the command, keeps its size, and rejects duplicates.
+func TestNewSessionWithOpts_FixedSize(t *testing.T) {
+	tm := newTestTmux(t)
+	session := "gt-test-opts-" + t.Name()
+	_ = tm.KillSession(session)
+	defer func() { _ = tm.KillSession(session) }()
+
+	opts := SessionOpts{Width: 120, Height: 40}
+	if err := tm.NewSessionWithOpts(session, "", "cat", opts); err != nil {
+		t.Fatalf("NewSessionWithOpts: %v", err)
+	}
+
+	exists, err := tm.HasSession(session)
+	if err != nil || !exists {
+		t.Fatalf("HasSession = %v, %v; want true, nil", exists, err)
+	}
+
+	cols, rows, err := tm.PaneSize(session)
+	if err != nil {
+		t.Fatalf("PaneSize: %v", err)
+	}
+	if cols != 120 || rows != 40 {
+		t.Errorf("PaneSize = %dx%d, want 120x40", cols, rows)
+	}
+
+	if err := tm.NewSessionWithOpts(session, "", "cat", opts); !errors.Is(err, ErrSessionExists) {
+		t.Errorf("duplicate NewSessionWithOpts = %v, want ErrSessionExists", err)
+	}
+}
+
+// TestSendMessageToTarget_BracketedPaste verifies multi-line text reaches an
+// application that enabled bracketed paste wrapped in the paste markers, so
+// the embedded newline isn't taken as Enter.
+func TestSendMessageToTarget_BracketedPaste(t *testing.T) {
+	tm := newTestTmux(t)
+	session := "gt-test-bracketed-" + t.Name()
+	_ = tm.KillSession(session)
+	defer func() { _ = tm.KillSession(session) }()
+
+	out := filepath.Join(t.TempDir(), "stdin")
+	cmd := fmt.Sprintf(`sh -c 'printf "\033[?2004h"; cat > %s'`, out)
+	if err := tm.NewSessionWithCommand(session, "", cmd); err != nil {
+		t.Fatalf("session creation: %v", err)
+	}
+	time.Sleep(200 * time.Millisecond) // let the pane see the mode switch
+
+	if err := tm.sendMessageToTarget(session, "first line\nsecond line"); err != nil {
+		t.Fatalf("sendMessageToTarget: %v", err)
+	}
+	if _, err := tm.run("send-keys", "-t", session, "Enter"); err != nil {
+		t.Fatalf("send Enter: %v", err)
+	}
+
+	var got string
+	deadline := time.Now().Add(3 * time.Second)
+	for time.Now().Before(deadline) {
+		data, _ := os.ReadFile(out)
+		if got = string(data); strings.Contains(got, "\x1b[201~") {
+			break
+		}
+		time.Sleep(100 * time.Millisecond)
+	}
+	if want := "\x1b[200~first line"; !strings.Contains(got, want) {
+		t.Errorf("stdin %q does not open with the paste start marker", got)
+	}
+	if want := "second line\x1b[201~"; !strings.Contains(got, want) {
+		t.Errorf("stdin %q does not close with the paste end marker", got)
+	}
+}
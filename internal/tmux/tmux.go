@@ -6,6 +6,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"io/fs"
 	"os"
 	"os/exec"
 	"path/filepath"
@@ -15,10 +16,12 @@ import (
 	"strconv"
 	"strings"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/steveyegge/gastown/internal/config"
 	"github.com/steveyegge/gastown/internal/constants"
+	"github.com/steveyegge/gastown/internal/gtlog"
 	"github.com/steveyegge/gastown/internal/telemetry"
 )
 
@@ -35,6 +38,21 @@ var sessionNudgeLocks sync.Map // map[string]chan struct{}
 // blocking all future nudges to that session.
 const nudgeLockTimeout = 30 * time.Second
 
+// DefaultNudgeGap is the minimum time between the end of one nudge to a
+// session and the start of the next. Deliveries that land back to back can
+// reach the agent while it is still redrawing from the previous one, and its
+// input line then sees the two merged.
+const DefaultNudgeGap = 500 * time.Millisecond
+
+// ErrSessionBusy is returned when a nudge can't get its turn at a session
+// before the lock timeout because earlier nudges are still being delivered.
+var ErrSessionBusy = errors.New("session busy")
+
+// lastNudges records when each session's latest nudge in this process
+// finished. Across processes the same time is kept in a stamp file next to
+// the nudge flock (see nudgeStampPath).
+var lastNudges sync.Map // map[string]time.Time
+
 // validSessionNameRe validates session names to prevent shell injection
 var validSessionNameRe = regexp.MustCompile(`^[a-zA-Z0-9_-]+$`)
 
@@ -46,8 +64,54 @@ var (
 	ErrSessionRunning     = errors.New("session already running with healthy agent")
 	ErrInvalidSessionName = errors.New("invalid session name")
 	ErrIdleTimeout        = errors.New("agent not idle before timeout")
+	ErrTmuxNotFound       = errors.New("tmux not found")
 )
 
+// tmuxBinary is the tmux executable used when neither GT_TMUX nor TMUX_BIN
+// is set.
+var tmuxBinary = "tmux"
+
+// Binary returns the tmux executable to run: $GT_TMUX, else $TMUX_BIN,
+// else "tmux" from PATH. Lets a town use a tmux outside PATH or a wrapper.
+func Binary() string {
+	if bin := os.Getenv("GT_TMUX"); bin != "" {
+		return bin
+	}
+	if bin := os.Getenv("TMUX_BIN"); bin != "" {
+		return bin
+	}
+	return tmuxBinary
+}
+
+// notFoundError wraps ErrTmuxNotFound with install guidance.
+func notFoundError(bin string) error {
+	if bin != "tmux" {
+		return fmt.Errorf("%w: %q does not exist or is not executable (check GT_TMUX/TMUX_BIN)", ErrTmuxNotFound, bin)
+	}
+	return fmt.Errorf("%w: install tmux (https://github.com/tmux/tmux/wiki/Installing) and make sure it is on PATH", ErrTmuxNotFound)
+}
+
+// isNotFound reports whether err is a failure to start the tmux binary
+// because it doesn't exist, as opposed to tmux running and failing.
+func isNotFound(err error) bool {
+	var exitErr *exec.ExitError
+	if errors.As(err, &exitErr) {
+		return false
+	}
+	return errors.Is(err, exec.ErrNotFound) || errors.Is(err, fs.ErrNotExist)
+}
+
+// Available checks that the tmux binary can be found. It returns an error
+// wrapping ErrTmuxNotFound, with install guidance, when it can't. Commands
+// that need tmux call this first to fail with a clear message.
+func Available() error {
+	bin := Binary()
+	if _, err := exec.LookPath(bin); err != nil {
+		return notFoundError(bin)
+	}
+	return nil
+}
+
 // validateSessionName checks that a session name contains only safe characters.
 // Returns ErrInvalidSessionName if the name contains dots, colons, or other
 // characters that cause tmux to silently fail or produce cryptic errors.
@@ -163,6 +227,7 @@ func IsInSameSocket() bool {
 
 // BuildCommand creates an exec.Cmd for tmux with the default socket applied.
 // Use this instead of exec.Command("tmux", ...) for code outside the Tmux struct.
+// The binary is Binary().
 func BuildCommand(args ...string) *exec.Cmd {
 	return BuildCommandContext(context.Background(), args...)
 }
@@ -174,7 +239,7 @@ func BuildCommandContext(ctx context.Context, args ...string) *exec.Cmd {
 		allArgs = append(allArgs, "-L", sock)
 	}
 	allArgs = append(allArgs, args...)
-	cmd := exec.CommandContext(ctx, "tmux", allArgs...)
+	cmd := exec.CommandContext(ctx, Binary(), allArgs...)
 	hideConsoleWindow(cmd)
 	return cmd
 }
@@ -182,6 +247,7 @@ func BuildCommandContext(ctx context.Context, args ...string) *exec.Cmd {
 // Tmux wraps tmux operations.
 type Tmux struct {
 	socketName string // tmux socket name (-L flag), empty = default socket
+	binary     string // tmux executable, empty = Binary()
 }
 
 // noTownSocket is a sentinel socket name used when no town socket is configured.
@@ -206,7 +272,7 @@ func NewTmux() *Tmux {
 		// target the correct town server even when InitRegistry was not called.
 		sock = os.Getenv("GT_TOWN_SOCKET")
 	}
-	return &Tmux{socketName: sock}
+	return &Tmux{socketName: sock, binary: Binary()}
 }
 
 // NewTmuxWithSocket creates a Tmux wrapper that targets a named socket.
@@ -214,13 +280,27 @@ func NewTmux() *Tmux {
 // default server. Primarily used in tests to prevent session name collisions
 // and keystroke leaks (e.g. Escape from NudgeSession hitting the user's prefix table).
 func NewTmuxWithSocket(socket string) *Tmux {
-	return &Tmux{socketName: socket}
+	return &Tmux{socketName: socket, binary: Binary()}
+}
+
+// bin returns the tmux executable this wrapper runs.
+func (t *Tmux) bin() string {
+	if t.binary != "" {
+		return t.binary
+	}
+	return Binary()
 }
 
 // run executes a tmux command and returns stdout.
 // All commands include -u flag for UTF-8 support regardless of locale settings.
 // See: https://github.com/steveyegge/gastown/issues/1219
 func (t *Tmux) run(args ...string) (string, error) {
+	return t.runContext(context.Background(), args...)
+}
+
+// runContext is run with the tmux subprocess bound to ctx: cancellation or
+// a deadline kills it, and the error then wraps ctx.Err().
+func (t *Tmux) runContext(ctx context.Context, args ...string) (string, error) {
 	// Prepend global flags: -u (UTF-8 mode, PATCH-004) and optionally -L (socket).
 	// The -L flag must come before the subcommand, so it goes in the prefix.
 	allArgs := []string{"-u"}
@@ -228,15 +308,38 @@ func (t *Tmux) run(args ...string) (string, error) {
 		allArgs = append(allArgs, "-L", t.socketName)
 	}
 	allArgs = append(allArgs, args...)
-	cmd := exec.Command("tmux", allArgs...)
+	cmd := exec.CommandContext(ctx, t.bin(), allArgs...)
 	hideConsoleWindow(cmd)
 	var stdout, stderr bytes.Buffer
 	cmd.Stdout = &stdout
 	cmd.Stderr = &stderr
 
+	var start time.Time
+	tr := activeTracer.Load()
+	if tr != nil {
+		start = time.Now()
+	}
+
 	err := cmd.Run()
 	if err != nil {
-		return "", t.wrapError(err, stderr.String(), args)
+		if ctxErr := ctx.Err(); ctxErr != nil {
+			err = fmt.Errorf("tmux %s: %w", args[0], ctxErr)
+		} else {
+			err = t.wrapError(err, stderr.String(), args)
+		}
+	}
+	if tr != nil {
+		tr.trace(start, args, stdout.Len(), err)
+	}
+	if gtlog.Enabled(gtlog.Debug) {
+		if err != nil {
+			gtlog.Debugf("tmux %s: %v", strings.Join(args, " "), err)
+		} else {
+			gtlog.Debugf("tmux %s", strings.Join(args, " "))
+		}
+	}
+	if err != nil {
+		return "", err
 	}
 
 	return strings.TrimSpace(stdout.String()), nil
@@ -244,6 +347,9 @@ func (t *Tmux) run(args ...string) (string, error) {
 
 // wrapError wraps tmux errors with context.
 func (t *Tmux) wrapError(err error, stderr string, args []string) error {
+	if isNotFound(err) {
+		return notFoundError(t.bin())
+	}
 	stderr = strings.TrimSpace(stderr)
 
 	// Detect specific error types
@@ -269,6 +375,38 @@ func (t *Tmux) wrapError(err error, stderr string, args []string) error {
 
 // NewSession creates a new detached tmux session.
 func (t *Tmux) NewSession(name, workDir string) error {
+	return t.newSession(name, workDir, SessionOpts{})
+}
+
+// SessionOpts controls optional settings for sessions created with
+// NewSessionWithOpts.
+type SessionOpts struct {
+	// Width and Height fix the window size in cells. When both are set the
+	// window stays at that size even after a client attaches, so captured
+	// output wraps identically on every run. When either is zero the window
+	// follows the attaching client's terminal.
+	Width  int
+	Height int
+}
+
+// fixedSize reports whether the options pin the window size.
+func (o SessionOpts) fixedSize() bool {
+	return o.Width > 0 && o.Height > 0
+}
+
+// NewSessionWithOpts creates a new detached session. An empty command starts
+// the default shell (like NewSession); otherwise the command becomes the
+// pane's initial process with the same checks as NewSessionWithCommand.
+// Returns ErrSessionExists if a session with this name is already running.
+func (t *Tmux) NewSessionWithOpts(name, workDir, command string, opts SessionOpts) error {
+	if command == "" {
+		return t.newSession(name, workDir, opts)
+	}
+	return t.newSessionWithCommand(name, workDir, command, opts)
+}
+
+// newSession runs new-session for a detached session with the default shell.
+func (t *Tmux) newSession(name, workDir string, opts SessionOpts) error {
 	if err := validateSessionName(name); err != nil {
 		return err
 	}
@@ -276,14 +414,26 @@ func (t *Tmux) NewSession(name, workDir string) error {
 	if workDir != "" {
 		args = append(args, "-c", workDir)
 	}
+	if opts.fixedSize() {
+		args = append(args, "-x", strconv.Itoa(opts.Width), "-y", strconv.Itoa(opts.Height))
+	}
 	if _, err := t.run(args...); err != nil {
 		return err
 	}
+	t.setWindowSize(name, opts)
+	return nil
+}
+
+// setWindowSize applies the window-size policy for a new session.
+func (t *Tmux) setWindowSize(name string, opts SessionOpts) {
+	if opts.fixedSize() {
+		_, _ = t.run("set-option", "-wt", name, "window-size", "manual")
+		return
+	}
 	// tmux 3.3+ sets window-size=manual on detached sessions (no client present),
 	// which locks the window at 80x24 even after a client attaches. Override to
 	// "latest" so the window auto-resizes to the attaching client's terminal size.
 	_, _ = t.run("set-option", "-wt", name, "window-size", "latest")
-	return nil
 }
 
 // NewSessionWithCommand creates a new detached tmux session that immediately runs a command.
@@ -296,6 +446,11 @@ func (t *Tmux) NewSession(name, workDir string) error {
 // errors, etc.) so callers get an error instead of a silently dead session.
 // See: https://github.com/anthropics/gastown/issues/280
 func (t *Tmux) NewSessionWithCommand(name, workDir, command string) error {
+	return t.newSessionWithCommand(name, workDir, command, SessionOpts{})
+}
+
+// newSessionWithCommand implements NewSessionWithCommand and NewSessionWithOpts.
+func (t *Tmux) newSessionWithCommand(name, workDir, command string, opts SessionOpts) error {
 	if err := validateSessionName(name); err != nil {
 		return err
 	}
@@ -326,13 +481,13 @@ func (t *Tmux) NewSessionWithCommand(name, workDir, command string) error {
 	if workDir != "" {
 		args = append(args, "-c", workDir)
 	}
+	if opts.fixedSize() {
+		args = append(args, "-x", strconv.Itoa(opts.Width), "-y", strconv.Itoa(opts.Height))
+	}
 	if _, err := t.run(args...); err != nil {
 		return err
 	}
-	// tmux 3.3+ sets window-size=manual on detached sessions (no client present),
-	// which locks the window at 80x24 even after a client attaches. Override to
-	// "latest" so the window auto-resizes to the attaching client's terminal size.
-	_, _ = t.run("set-option", "-wt", name, "window-size", "latest")
+	t.setWindowSize(name, opts)
 
 	// Enable remain-on-exit BEFORE command runs so we can inspect exit status
 	_, _ = t.run("set-option", "-t", name, "remain-on-exit", "on")
@@ -572,6 +727,32 @@ func (t *Tmux) KillSession(name string) (retErr error) {
 	return retErr
 }
 
+// KillSessionGraceful asks the session's foreground process to exit before
+// tearing the session down. It sends Ctrl-C twice (agents like Claude Code
+// exit on a double interrupt), waits up to timeout for the pane to return to
+// a shell, then kills the session and its processes regardless.
+//
+// Unlike KillSession, a missing session is reported as ErrSessionNotFound so
+// callers can decide whether "already gone" counts as success.
+func (t *Tmux) KillSessionGraceful(name string, timeout time.Duration) error {
+	running, err := t.HasSession(name)
+	if err != nil {
+		return err
+	}
+	if !running {
+		return ErrSessionNotFound
+	}
+
+	_ = t.SendKeysRaw(name, "C-c")
+	time.Sleep(100 * time.Millisecond)
+	_ = t.SendKeysRaw(name, "C-c")
+
+	// Best-effort: a process that ignores interrupts is killed below anyway.
+	_ = t.WaitForShellReady(name, timeout)
+
+	return t.KillSessionWithProcesses(name)
+}
+
 // processKillGracePeriod is how long to wait after SIGTERM before sending SIGKILL.
 // 2 seconds gives processes time to clean up gracefully. The previous 100ms was too short
 // and caused Claude processes to become orphans when they couldn't shut down in time.
@@ -990,7 +1171,7 @@ func (t *Tmux) SetExitEmpty(on bool) error {
 
 // IsAvailable checks if tmux is installed and can be invoked.
 func (t *Tmux) IsAvailable() bool {
-	cmd := exec.Command("tmux", "-V")
+	cmd := exec.Command(t.bin(), "-V")
 	hideConsoleWindow(cmd)
 	return cmd.Run() == nil
 }
@@ -1196,6 +1377,52 @@ func (t *Tmux) SendKeysRaw(session, keys string) error {
 	return err
 }
 
+// SendKeySequence sends a mix of tmux key names and literal text, in order,
+// without pressing Enter. Arguments that IsKeyName recognizes (C-c, Escape,
+// Up, ...) are sent as keys; anything else is typed literally via
+// send-keys -l, so text like "Enter the code" is never misread as a key.
+func (t *Tmux) SendKeySequence(session string, keys ...string) error {
+	for _, k := range keys {
+		args := []string{"send-keys", "-t", session, k}
+		if !IsKeyName(k) {
+			args = []string{"send-keys", "-t", session, "-l", k}
+		}
+		if _, err := t.run(args...); err != nil {
+			return fmt.Errorf("sending %q: %w", k, err)
+		}
+	}
+	return nil
+}
+
+// tmuxKeyNames are the tmux key names SendKeySequence sends as keys.
+// Function keys (F1-F12) and modifier prefixes are handled in IsKeyName.
+var tmuxKeyNames = map[string]bool{
+	"Enter": true, "Escape": true, "Tab": true, "BTab": true, "Space": true, "BSpace": true,
+	"Up": true, "Down": true, "Left": true, "Right": true,
+	"Home": true, "End": true, "IC": true, "DC": true,
+	"PageUp": true, "PgUp": true, "PPage": true, "PageDown": true, "PgDn": true, "NPage": true,
+}
+
+// IsKeyName reports whether s is a tmux key name: a named key such as
+// Escape or Up, a function key F1-F12, or a key with C-, M-, or S-
+// modifiers (C-c, M-Left, C-M-x).
+func IsKeyName(s string) bool {
+	for len(s) > 2 && (s[:2] == "C-" || s[:2] == "M-" || s[:2] == "S-") {
+		s = s[2:]
+		if len([]rune(s)) == 1 {
+			return true
+		}
+	}
+	if tmuxKeyNames[s] {
+		return true
+	}
+	if len(s) >= 2 && len(s) <= 3 && s[0] == 'F' {
+		n, err := strconv.Atoi(s[1:])
+		return err == nil && n >= 1 && n <= 12 && s[1] != '0'
+	}
+	return false
+}
+
 // SendKeysReplace sends keystrokes, clearing any pending input first.
 // This is useful for "replaceable" notifications where only the latest matters.
 // Uses Ctrl-U to clear the input line before sending the new message.
@@ -1265,6 +1492,62 @@ func releaseNudgeLock(session string) {
 	}
 }
 
+// acquireNudgeTurn waits for a session's turn to be nudged: it takes the
+// cross-process flock at lockPath (when set) and the in-process lock, then
+// sleeps out whatever remains of gap since the previous nudge finished.
+// The returned release records the finish time and frees both locks.
+// Fails with ErrSessionBusy if either lock isn't free within timeout.
+func acquireNudgeTurn(session, lockPath string, timeout, gap time.Duration) (func(), error) {
+	unlockFlock := func() {}
+	if lockPath != "" {
+		unlock, err := acquireFlockLock(lockPath, timeout)
+		if err != nil {
+			return nil, fmt.Errorf("%w: cross-process nudge lock for session %q: %v", ErrSessionBusy, session, err)
+		}
+		unlockFlock = unlock
+	}
+	if !acquireNudgeLock(session, timeout) {
+		unlockFlock()
+		return nil, fmt.Errorf("%w: nudge lock timeout for %q after %s: previous nudge may be hung", ErrSessionBusy, session, timeout)
+	}
+
+	if wait := time.Until(lastNudgeAt(session, lockPath).Add(gap)); wait > 0 {
+		gtlog.Debugf("tmux: waiting %s before nudging %s", wait.Round(time.Millisecond), session)
+		time.Sleep(wait)
+	}
+
+	return func() {
+		lastNudges.Store(session, time.Now())
+		if lockPath != "" {
+			_ = os.WriteFile(nudgeStampPath(lockPath), nil, 0644)
+		}
+		releaseNudgeLock(session)
+		unlockFlock()
+	}, nil
+}
+
+// lastNudgeAt returns when the latest nudge to session finished, in this
+// process or (per the stamp file beside lockPath) any other.
+func lastNudgeAt(session, lockPath string) time.Time {
+	var last time.Time
+	if v, ok := lastNudges.Load(session); ok {
+		last = v.(time.Time)
+	}
+	if lockPath != "" {
+		if info, err := os.Stat(nudgeStampPath(lockPath)); err == nil && info.ModTime().After(last) {
+			last = info.ModTime()
+		}
+	}
+	return last
+}
+
+// nudgeStampPath returns the file whose mtime marks the end of the latest
+// nudge under the flock at lockPath. It is separate from the lock file,
+// which is created on first use and would otherwise look like a recent nudge.
+func nudgeStampPath(lockPath string) string {
+	return filepath.Join(filepath.Dir(lockPath), ".last")
+}
+
 // nudgeFlockPath returns the filesystem lock path for cross-process nudge serialization.
 // Lock files live alongside the nudge queue directory for self-documentation and cleanup.
 func nudgeFlockPath(townRoot, session string) string {
@@ -1353,7 +1636,7 @@ func sanitizeNudgeMessage(msg string) string {
 		switch {
 		case r == '\t': // TAB → space (avoid triggering completion)
 			b.WriteRune(' ')
-		case r == '\n': // preserve newlines (send-keys -l treats as Enter, known limitation)
+		case r == '\n': // preserve newlines (delivered via bracketed paste, see sendMessageToTarget)
 			b.WriteRune(r)
 		case r < 0x20: // strip all other control chars (ESC, CR, BS, etc.)
 			continue
@@ -1466,6 +1749,7 @@ func (t *Tmux) sendEnterVerified(target string) error {
 		}
 
 		// Content unchanged — Enter may not have been processed. Retry.
+		gtlog.Debugf("tmux: pane %s unchanged after Enter, resending (retry %d/%d)", target, retry+1, maxRetries)
 		if _, err := t.run("send-keys", "-t", target, "Enter"); err != nil {
 			return fmt.Errorf("send Enter (retry %d): %w", retry+1, err)
 		}
@@ -1508,6 +1792,11 @@ func adaptiveTextDelay(messageLen int) time.Duration {
 const sendKeysChunkSize = 512
 
 func (t *Tmux) sendMessageToTarget(target, text string) error {
+	// Multi-line text can't go through send-keys -l: each newline is typed
+	// as Enter and submits the prompt early. Paste it instead.
+	if strings.Contains(text, "\n") {
+		return t.pasteLiteral(target, text)
+	}
 	if len(text) <= sendKeysChunkSize {
 		return t.sendKeysLiteralWithRetry(target, text, constants.NudgeReadyTimeout)
 	}
@@ -1537,6 +1826,28 @@ func (t *Tmux) sendMessageToTarget(target, text string) error {
 	return nil
 }
 
+// pasteBufferSeq makes paste buffer names unique within the process.
+var pasteBufferSeq atomic.Uint64
+
+// pasteLiteral delivers text to a target as a single bracketed paste. Agents
+// that enable bracketed paste (Claude Code, readline) insert embedded newlines
+// literally instead of treating them as Enter. The text is passed as a tmux
+// argument, never through a shell, so $(), backticks, quotes, and semicolons
+// arrive unchanged.
+func (t *Tmux) pasteLiteral(target, text string) error {
+	buffer := fmt.Sprintf("gt-nudge-%d-%d", os.Getpid(), pasteBufferSeq.Add(1))
+	if _, err := t.run("set-buffer", "-b", buffer, "--", text); err != nil {
+		return err
+	}
+	// -d deletes the buffer after pasting, -p uses bracketed paste when the
+	// application has requested it.
+	if _, err := t.run("paste-buffer", "-d", "-p", "-b", buffer, "-t", target); err != nil {
+		_, _ = t.run("delete-buffer", "-b", buffer)
+		return err
+	}
+	return nil
+}
+
 // sendKeysLiteralWithRetry sends literal text to a tmux target, retrying on
 // transient errors (e.g., "not in a mode" during agent TUI startup).
 // This is the core retry loop used by both NudgeSession and NudgePane.
@@ -1564,6 +1875,7 @@ func (t *Tmux) sendKeysLiteralWithRetry(target, text string, timeout time.Durati
 			return err // non-transient (session gone, no server) — fail fast
 		}
 		lastErr = err
+		gtlog.Debugf("tmux: %s not ready for input, retrying in %s: %v", target, interval, err)
 		// Clamp sleep to remaining time so we don't overshoot the deadline.
 		remaining := time.Until(deadline)
 		if remaining <= 0 {
@@ -1593,6 +1905,9 @@ func (t *Tmux) sendKeysLiteralWithRetry(target, text string, timeout time.Durati
 // If the agent TUI hasn't initialized yet (cold startup), retries with backoff
 // up to NudgeReadyTimeout before giving up. See sendKeysLiteralWithRetry.
 //
+// session may also be a full "session:window.pane" target to deliver to a
+// specific pane instead of the detected agent pane.
+//
 // IMPORTANT: Nudges to the same session are serialized to prevent interleaving.
 // If multiple goroutines try to nudge the same session concurrently, they will
 // queue up and execute one at a time. This prevents garbled input when
@@ -1616,6 +1931,16 @@ type NudgeOpts struct {
 	// <townRoot>/.runtime/nudge_queue/<session>/.lock before delivery.
 	// When empty, only in-process locking is used (backward-compatible).
 	TownRoot string
+
+	// LockTimeout bounds the wait for the session's turn behind other
+	// nudges; 0 means 30s. A nudge that doesn't get its turn in time fails
+	// with ErrSessionBusy.
+	LockTimeout time.Duration
+
+	// MinGap is the minimum time since the previous nudge to the session
+	// finished (across processes when TownRoot is set); 0 means
+	// DefaultNudgeGap.
+	MinGap time.Duration
 }
 
 // canonicalPaneTarget converts a pane identifier like "%23" into a tmux target
@@ -1637,34 +1962,76 @@ func (t *Tmux) canonicalPaneTarget(session, pane string) string {
 	return pane
 }
 
+// splitPaneTarget splits a tmux target like "session:window.pane" into its
+// session name. explicit is false for a bare session name. Session names
+// can't contain ':' (see validSessionNameRe), so the first colon is the
+// separator.
+func splitPaneTarget(target string) (session string, explicit bool) {
+	if i := strings.IndexByte(target, ':'); i >= 0 {
+		return target[:i], true
+	}
+	return target, false
+}
+
 // NudgeSessionWithOpts is like NudgeSession but accepts delivery options.
 // See NudgeOpts for available options.
 func (t *Tmux) NudgeSessionWithOpts(session, message string, opts NudgeOpts) error {
+	return t.NudgeSessionContext(context.Background(), session, message, opts)
+}
+
+// NudgeSessionContext is NudgeSessionWithOpts bound to ctx. Cancellation
+// is honored until delivery starts: the wait for the session's nudge turn
+// ends at ctx's deadline if that is sooner than opts.LockTimeout, and a
+// cancelled ctx returns its error without typing anything. Once text is
+// being sent the nudge runs to completion, so no half-typed message is left
+// in the agent's input.
+func (t *Tmux) NudgeSessionContext(ctx context.Context, session, message string, opts NudgeOpts) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	// An explicit session:window.pane target skips agent-pane discovery.
+	// Locking and session-level lookups use the session part.
+	target := session
+	session, explicitPane := splitPaneTarget(session)
+
 	// Cross-process lock: serialize nudges across OS processes via flock(2).
 	// Each `gt nudge` CLI invocation is a separate process, so the in-process
-	// channel semaphore below provides no cross-process protection. Without
+	// channel semaphore alone provides no cross-process protection. Without
 	// this, concurrent nudges interleave send-keys/Enter and produce garbled
-	// or empty input. (GH#gt-ukl8)
+	// or empty input. (GH#gt-ukl8) Successive nudges are also spaced by
+	// MinGap so one doesn't land while the agent is absorbing the last.
+	var lockPath string
 	if opts.TownRoot != "" {
-		lockPath := nudgeFlockPath(opts.TownRoot, session)
-		unlock, err := acquireFlockLock(lockPath, nudgeLockTimeout)
-		if err != nil {
-			return fmt.Errorf("cross-process nudge lock for session %q: %w", session, err)
+		lockPath = nudgeFlockPath(opts.TownRoot, session)
+	}
+	lockTimeout, gap := opts.LockTimeout, opts.MinGap
+	if lockTimeout <= 0 {
+		lockTimeout = nudgeLockTimeout
+	}
+	if gap <= 0 {
+		gap = DefaultNudgeGap
+	}
+	if deadline, ok := ctx.Deadline(); ok && time.Until(deadline) < lockTimeout {
+		lockTimeout = max(time.Until(deadline), time.Millisecond)
+	}
+	release, err := acquireNudgeTurn(session, lockPath, lockTimeout, gap)
+	if err != nil {
+		if ctxErr := ctx.Err(); ctxErr != nil {
+			return ctxErr
 		}
-		defer unlock()
+		return err
 	}
-
-	// In-process lock: serialize nudges within a single process (goroutine fast path).
-	if !acquireNudgeLock(session, nudgeLockTimeout) {
-		return fmt.Errorf("nudge lock timeout for session %q: previous nudge may be hung", session)
+	defer release()
+	if err := ctx.Err(); err != nil {
+		return err
 	}
-	defer releaseNudgeLock(session)
 
 	// Resolve the correct target: in multi-pane sessions, find the pane
 	// running the agent rather than sending to the focused pane.
-	target := session
-	if agentPane, err := t.FindAgentPane(session); err == nil && agentPane != "" {
-		target = t.canonicalPaneTarget(session, agentPane)
+	if !explicitPane {
+		if agentPane, err := t.FindAgentPane(session); err == nil && agentPane != "" {
+			target = t.canonicalPaneTarget(session, agentPane)
+		}
 	}
 
 	// 0. Pre-delivery: dismiss Rewind menu if the session is stuck in it.
@@ -1746,12 +2113,13 @@ func (t *Tmux) NudgeSessionWithOpts(session, message string, opts NudgeOpts) err
 // After sending, triggers SIGWINCH to wake Claude in detached sessions.
 // Nudges to the same pane are serialized to prevent interleaving.
 func (t *Tmux) NudgePane(pane, message string) error {
-	// Serialize nudges to this pane to prevent interleaving.
+	// Serialize and space nudges to this pane to prevent interleaving.
 	// Use a timed lock to avoid permanent blocking if a previous nudge hung.
-	if !acquireNudgeLock(pane, nudgeLockTimeout) {
-		return fmt.Errorf("nudge lock timeout for pane %q: previous nudge may be hung", pane)
+	release, err := acquireNudgeTurn(pane, "", nudgeLockTimeout, DefaultNudgeGap)
+	if err != nil {
+		return err
 	}
-	defer releaseNudgeLock(pane)
+	defer release()
 
 	// 0. Pre-delivery: dismiss Rewind menu if active. (GH#gt-8el)
 	if t.isInRewindMode(pane) {
@@ -2311,7 +2679,13 @@ func (t *Tmux) FindSessionByWorkDir(targetDir string, processNames []string) ([]
 
 // CapturePane captures the visible content of a pane.
 func (t *Tmux) CapturePane(session string, lines int) (string, error) {
-	return t.run("capture-pane", "-p", "-t", session, "-S", fmt.Sprintf("-%d", lines))
+	return t.CapturePaneContext(context.Background(), session, lines)
+}
+
+// CapturePaneContext is CapturePane with the tmux call bound to ctx, so a
+// cancelled caller isn't left blocked on a hung tmux.
+func (t *Tmux) CapturePaneContext(ctx context.Context, session string, lines int) (string, error) {
+	return t.runContext(ctx, "capture-pane", "-p", "-t", session, "-S", fmt.Sprintf("-%d", lines))
 }
 
 // CapturePaneAll captures all scrollback history.
@@ -2320,8 +2694,104 @@ func (t *Tmux) CapturePaneAll(session string) (string, error) {
 }
 
 // CapturePaneLines captures the last N lines of a pane as a slice.
+// session may be a bare session name (the active pane) or a full
+// "session:window.pane" target.
 func (t *Tmux) CapturePaneLines(session string, lines int) ([]string, error) {
-	out, err := t.CapturePane(session, lines)
+	return t.CapturePaneLinesContext(context.Background(), session, lines)
+}
+
+// CapturePaneLinesContext is CapturePaneLines with the tmux call bound to ctx.
+func (t *Tmux) CapturePaneLinesContext(ctx context.Context, session string, lines int) ([]string, error) {
+	out, err := t.CapturePaneContext(ctx, session, lines)
+	if err != nil {
+		return nil, err
+	}
+	if out == "" {
+		return nil, nil
+	}
+	return strings.Split(out, "\n"), nil
+}
+
+// PaneSize returns the width and height of a pane in cells. Captured output
+// wraps at the width, so callers use it to tell wrapped rows from real lines.
+func (t *Tmux) PaneSize(session string) (cols, rows int, err error) {
+	out, err := t.run("display-message", "-p", "-t", session, "#{pane_width} #{pane_height}")
+	if err != nil {
+		return 0, 0, err
+	}
+	if _, err := fmt.Sscanf(out, "%d %d", &cols, &rows); err != nil {
+		return 0, 0, fmt.Errorf("parsing pane size %q: %w", out, err)
+	}
+	return cols, rows, nil
+}
+
+// PaneMark is a position in a pane's output, taken with MarkPane and read
+// back with CapturePaneSince.
+type PaneMark struct {
+	History int // history_size when marked: lines scrolled above the screen
+	Cursor  int // cursor_y when marked: the cursor's row on the screen
+}
+
+// MarkPane records the pane's current cursor row as a PaneMark.
+func (t *Tmux) MarkPane(session string) (PaneMark, error) {
+	out, err := t.run("display-message", "-p", "-t", session, "#{history_size} #{cursor_y}")
+	if err != nil {
+		return PaneMark{}, err
+	}
+	var m PaneMark
+	if _, err := fmt.Sscanf(out, "%d %d", &m.History, &m.Cursor); err != nil {
+		return PaneMark{}, fmt.Errorf("parsing pane position %q: %w", out, err)
+	}
+	return m, nil
+}
+
+// CapturePaneSince captures the pane from mark's row to the bottom of the
+// screen, so only output written after the mark is returned.
+//
+// The row is found by offset: it has moved up by however much the history
+// grew (capture-pane -S <mark - history>). That breaks once tmux drops old
+// history. When the history is full it drops the oldest tenth of
+// history-limit at once, so afterwards it never falls back below nine
+// tenths of the limit until cleared. truncated is therefore set, and all
+// remaining history is returned instead, when the history is in that top
+// tenth or smaller than at the mark; callers must then locate the new
+// output themselves.
+func (t *Tmux) CapturePaneSince(session string, mark PaneMark) (lines []string, truncated bool, err error) {
+	return t.CapturePaneSinceContext(context.Background(), session, mark)
+}
+
+// CapturePaneSinceContext is CapturePaneSince with its tmux calls bound to ctx.
+func (t *Tmux) CapturePaneSinceContext(ctx context.Context, session string, mark PaneMark) (lines []string, truncated bool, err error) {
+	out, err := t.runContext(ctx, "display-message", "-p", "-t", session, "#{history_size} #{history_limit}")
+	if err != nil {
+		return nil, false, err
+	}
+	var history, limit int
+	if _, err := fmt.Sscanf(out, "%d %d", &history, &limit); err != nil {
+		return nil, false, fmt.Errorf("parsing pane history %q: %w", out, err)
+	}
+
+	start := "-"
+	truncated = history < mark.History || (limit > 0 && history >= limit-limit/10)
+	if !truncated {
+		start = fmt.Sprintf("%d", mark.History+mark.Cursor-history)
+	}
+	out, err = t.runContext(ctx, "capture-pane", "-p", "-t", session, "-S", start)
+	if err != nil {
+		return nil, false, err
+	}
+	if out == "" {
+		return nil, truncated, nil
+	}
+	return strings.Split(out, "\n"), truncated, nil
+}
+
+// CapturePaneLinesANSI is like CapturePaneLines but keeps color and attribute
+// escape sequences (capture-pane -e), so output can be replayed to a terminal.
+// Never feed these lines to response extraction: UI artifact and anchor
+// matching work on plain text, and escapes break them.
+func (t *Tmux) CapturePaneLinesANSI(session string, lines int) ([]string, error) {
+	out, err := t.run("capture-pane", "-p", "-e", "-t", session, "-S", fmt.Sprintf("-%d", lines))
 	if err != nil {
 		return nil, err
 	}
@@ -2331,6 +2801,12 @@ func (t *Tmux) CapturePaneLines(session string, lines int) ([]string, error) {
 	return strings.Split(out, "\n"), nil
 }
 
+// CapturePaneAllANSI captures all scrollback history with escape sequences kept.
+// See CapturePaneLinesANSI for caveats.
+func (t *Tmux) CapturePaneAllANSI(session string) (string, error) {
+	return t.run("capture-pane", "-p", "-e", "-t", session, "-S", "-")
+}
+
 // AttachSession attaches to an existing session.
 // Note: This replaces the current process with tmux attach.
 func (t *Tmux) AttachSession(session string) error {
@@ -3021,20 +3497,65 @@ func (t *Tmux) IsIdle(session string) bool {
 	return false
 }
 
+// sessionInfoFormat is the list-sessions format parsed by parseSessionInfo.
+// The name comes first; any "|" inside it is recovered by counting the fixed
+// fields from the right.
+const sessionInfoFormat = "#{session_name}|#{session_windows}|#{session_created}|#{session_attached}|#{session_activity}|#{session_last_attached}"
+
+// sessionInfoFields is the number of "|"-separated fields in sessionInfoFormat.
+const sessionInfoFields = 6
+
 // GetSessionInfo returns detailed information about a session.
 func (t *Tmux) GetSessionInfo(name string) (*SessionInfo, error) {
-	format := "#{session_name}|#{session_windows}|#{session_created}|#{session_attached}|#{session_activity}|#{session_last_attached}"
-	out, err := t.run("list-sessions", "-F", format, "-f", fmt.Sprintf("#{==:#{session_name},%s}", name))
+	out, err := t.run("list-sessions", "-F", sessionInfoFormat, "-f", fmt.Sprintf("#{==:#{session_name},%s}", name))
 	if err != nil {
 		return nil, err
 	}
 	if out == "" {
 		return nil, ErrSessionNotFound
 	}
+	return parseSessionInfo(out)
+}
 
-	parts := strings.Split(out, "|")
+// ListSessionInfos returns metadata for every session on the server.
+// Returns an empty slice (not an error) when no server is running.
+// Use this instead of ListSessions when callers need more than names, e.g.
+// to discover sessions by prefix and report their age or attach state.
+func (t *Tmux) ListSessionInfos() ([]SessionInfo, error) {
+	out, err := t.run("list-sessions", "-F", sessionInfoFormat)
+	if err != nil {
+		if errors.Is(err, ErrNoServer) {
+			return []SessionInfo{}, nil
+		}
+		return nil, err
+	}
+
+	infos := []SessionInfo{}
+	for _, line := range strings.Split(out, "\n") {
+		if strings.TrimSpace(line) == "" {
+			continue
+		}
+		info, err := parseSessionInfo(line)
+		if err != nil {
+			continue // skip lines from servers that ignore -F (e.g. psmux)
+		}
+		infos = append(infos, *info)
+	}
+	return infos, nil
+}
+
+// parseSessionInfo parses one line of sessionInfoFormat output.
+func parseSessionInfo(line string) (*SessionInfo, error) {
+	parts := strings.Split(line, "|")
 	if len(parts) < 4 {
-		return nil, fmt.Errorf("unexpected session info format: %s", out)
+		return nil, fmt.Errorf("unexpected session info format: %s", line)
+	}
+
+	// Session names may themselves contain "|". When all fields are present,
+	// everything before the trailing fixed fields is the name.
+	if len(parts) > sessionInfoFields {
+		name := strings.Join(parts[:len(parts)-sessionInfoFields+1], "|")
+		parts = append([]string{name}, parts[len(parts)-sessionInfoFields+1:]...)
 	}
 
 	windows := 0
@@ -3866,9 +4387,9 @@ func (t *Tmux) SetAutoRespawnHook(session string) error {
 	// When a socket is configured, the embedded tmux commands MUST include
 	// the -L flag. run-shell spawns a subprocess that runs bare `tmux` which
 	// would otherwise connect to the default server instead of the town socket.
-	tmuxCmd := "tmux"
+	tmuxCmd := t.bin()
 	if t.socketName != "" {
-		tmuxCmd = fmt.Sprintf("tmux -L %s", t.socketName)
+		tmuxCmd = fmt.Sprintf("%s -L %s", t.bin(), t.socketName)
 	}
 
 	hookCmd := buildAutoRespawnHookCmd(tmuxCmd, safeSession)
@@ -1,10 +1,12 @@
 package tmux
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"os"
 	"os/exec"
+	"path/filepath"
 	"runtime"
 	"strings"
 	"testing"
@@ -1480,6 +1482,111 @@ func TestNudgeLockDifferentSessions(t *testing.T) {
 	}
 }
 
+func TestAcquireNudgeTurn_OrderedAndSpaced(t *testing.T) {
+	// Concurrent nudges to one session take turns in arrival order, each
+	// starting at least gap after the previous one finished.
+	session := "test-nudge-turn-ordered"
+	sessionNudgeLocks.Delete(session)
+	lastNudges.Delete(session)
+	t.Cleanup(func() { lastNudges.Delete(session) })
+
+	const nudges = 4
+	const gap = 100 * time.Millisecond
+	type turn struct {
+		id              int
+		start, finished time.Time
+	}
+	turns := make(chan turn, nudges)
+	errs := make(chan error, nudges)
+	for i := 0; i < nudges; i++ {
+		go func(id int) {
+			release, err := acquireNudgeTurn(session, "", 5*time.Second, gap)
+			if err != nil {
+				errs <- err
+				return
+			}
+			tr := turn{id: id, start: time.Now()}
+			time.Sleep(20 * time.Millisecond) // deliver
+			tr.finished = time.Now()
+			turns <- tr
+			release()
+			errs <- nil
+		}(i)
+		time.Sleep(10 * time.Millisecond) // arrive in order
+	}
+	for i := 0; i < nudges; i++ {
+		if err := <-errs; err != nil {
+			t.Fatalf("acquireNudgeTurn: %v", err)
+		}
+	}
+	close(turns)
+
+	var prev *turn
+	for tr := range turns {
+		if prev != nil {
+			if tr.id != prev.id+1 {
+				t.Errorf("nudge %d ran after %d, want arrival order", tr.id, prev.id)
+			}
+			if spacing := tr.start.Sub(prev.finished); spacing < gap {
+				t.Errorf("nudge %d started %s after nudge %d finished, want at least %s", tr.id, spacing, prev.id, gap)
+			}
+		}
+		tr := tr
+		prev = &tr
+	}
+}
+
+func TestAcquireNudgeTurn_Busy(t *testing.T) {
+	session := "test-nudge-turn-busy"
+	sessionNudgeLocks.Delete(session)
+	t.Cleanup(func() { lastNudges.Delete(session) })
+
+	release, err := acquireNudgeTurn(session, "", time.Second, time.Millisecond)
+	if err != nil {
+		t.Fatalf("first acquireNudgeTurn: %v", err)
+	}
+	defer release()
+
+	_, err = acquireNudgeTurn(session, "", 50*time.Millisecond, time.Millisecond)
+	if !errors.Is(err, ErrSessionBusy) {
+		t.Fatalf("second acquireNudgeTurn error = %v, want ErrSessionBusy", err)
+	}
+}
+
+func TestAcquireNudgeTurn_GapAcrossProcesses(t *testing.T) {
+	// The stamp beside the flock spaces nudges even when this process has
+	// no record of the previous one (as when another gt process sent it).
+	if runtime.GOOS == "windows" {
+		t.Skip("flock is in-process only on Windows")
+	}
+	session := "test-nudge-turn-stamp"
+	sessionNudgeLocks.Delete(session)
+	lockPath := nudgeFlockPath(t.TempDir(), session)
+	const gap = 150 * time.Millisecond
+
+	start := time.Now()
+	release, err := acquireNudgeTurn(session, lockPath, time.Second, gap)
+	if err != nil {
+		t.Fatalf("first acquireNudgeTurn: %v", err)
+	}
+	if waited := time.Since(start); waited >= gap {
+		t.Errorf("first nudge waited %s, want no gap before the first nudge", waited)
+	}
+	release()
+	lastNudges.Delete(session) // forget it in-process; only the stamp remains
+
+	start = time.Now()
+	release, err = acquireNudgeTurn(session, lockPath, time.Second, gap)
+	if err != nil {
+		t.Fatalf("second acquireNudgeTurn: %v", err)
+	}
+	release()
+	lastNudges.Delete(session)
+	if waited := time.Since(start); waited < gap-20*time.Millisecond {
+		t.Errorf("second nudge waited %s, want about %s", waited, gap)
+	}
+}
+
 func TestFindAgentPane_NonexistentSession(t *testing.T) {
 	tm := newTestTmux(t)
 	_, err := tm.FindAgentPane("nonexistent-session-findagent-xyz")
@@ -2340,3 +2447,487 @@ func TestValidateCommandBinary(t *testing.T) {
 		})
 	}
 }
+
+func TestParseSessionInfo(t *testing.T) {
+	created := time.Unix(1700000000, 0).Format("2006-01-02 15:04:05")
+	tests := []struct {
+		name string
+		line string
+		want SessionInfo
+	}{
+		{
+			name: "simple",
+			line: "gt-mayor|2|1700000000|1|1700000100|1700000050",
+			want: SessionInfo{Name: "gt-mayor", Windows: 2, Created: created, Attached: true, Activity: "1700000100", LastAttached: "1700000050"},
+		},
+		{
+			name: "colons and spaces",
+			line: "my:session with spaces|1|1700000000|0|1700000100|",
+			want: SessionInfo{Name: "my:session with spaces", Windows: 1, Created: created, Activity: "1700000100"},
+		},
+		{
+			name: "pipe in name",
+			line: "a|b|1|1700000000|0|1700000100|",
+			want: SessionInfo{Name: "a|b", Windows: 1, Created: created, Activity: "1700000100"},
+		},
+		{
+			name: "older tmux without optional fields",
+			line: "legacy|3|1700000000|0",
+			want: SessionInfo{Name: "legacy", Windows: 3, Created: created},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := parseSessionInfo(tt.line)
+			if err != nil {
+				t.Fatalf("parseSessionInfo: %v", err)
+			}
+			if *got != tt.want {
+				t.Errorf("parseSessionInfo(%q) = %+v, want %+v", tt.line, *got, tt.want)
+			}
+		})
+	}
+
+	if _, err := parseSessionInfo("gt-mayor: 1 windows"); err == nil {
+		t.Error("expected error for unparseable line")
+	}
+}
+
+func TestListSessionInfos(t *testing.T) {
+	tm := newTestTmux(t)
+	sessionName := "gt-test-infos-" + t.Name()
+
+	_ = tm.KillSession(sessionName)
+	if err := tm.NewSession(sessionName, ""); err != nil {
+		t.Fatalf("NewSession: %v", err)
+	}
+	defer func() { _ = tm.KillSession(sessionName) }()
+
+	infos, err := tm.ListSessionInfos()
+	if err != nil {
+		t.Fatalf("ListSessionInfos: %v", err)
+	}
+	for _, info := range infos {
+		if info.Name == sessionName {
+			if info.Windows < 1 {
+				t.Errorf("Windows = %d, want >= 1", info.Windows)
+			}
+			return
+		}
+	}
+	t.Errorf("session %q not found in %+v", sessionName, infos)
+}
+
+func TestKillSessionGraceful(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("posix shell required")
+	}
+	tm := newTestTmux(t)
+	sessionName := "gt-test-graceful-" + t.Name()
+
+	_ = tm.KillSession(sessionName)
+	if err := tm.NewSession(sessionName, ""); err != nil {
+		t.Fatalf("NewSession: %v", err)
+	}
+	defer func() { _ = tm.KillSession(sessionName) }()
+
+	if err := tm.SendKeys(sessionName, "sleep 300"); err != nil {
+		t.Fatalf("SendKeys: %v", err)
+	}
+	time.Sleep(300 * time.Millisecond)
+
+	if err := tm.KillSessionGraceful(sessionName, 2*time.Second); err != nil {
+		t.Fatalf("KillSessionGraceful: %v", err)
+	}
+	if has, _ := tm.HasSession(sessionName); has {
+		t.Error("session still exists after KillSessionGraceful")
+	}
+}
+
+func TestKillSessionGraceful_NonexistentSession(t *testing.T) {
+	tm := newTestTmux(t)
+	err := tm.KillSessionGraceful("gt-test-graceful-missing-xyz", time.Second)
+	if !errors.Is(err, ErrSessionNotFound) {
+		t.Errorf("KillSessionGraceful(missing) = %v, want ErrSessionNotFound", err)
+	}
+}
+
+func TestCapturePaneLinesANSI(t *testing.T) {
+	tm := newTestTmux(t)
+	session := "gt-test-ansi-" + t.Name()
+	_ = tm.KillSession(session)
+	defer func() { _ = tm.KillSession(session) }()
+
+	if err := tm.NewSessionWithCommand(session, "", `printf '\033[31mred text\033[0m\n'; sleep 30`); err != nil {
+		t.Fatalf("NewSessionWithCommand: %v", err)
+	}
+
+	var colored string
+	deadline := time.Now().Add(3 * time.Second)
+	for time.Now().Before(deadline) {
+		lines, err := tm.CapturePaneLinesANSI(session, 10)
+		if err != nil {
+			t.Fatalf("CapturePaneLinesANSI: %v", err)
+		}
+		colored = strings.Join(lines, "\n")
+		if strings.Contains(colored, "red text") {
+			break
+		}
+		time.Sleep(100 * time.Millisecond)
+	}
+	if !strings.Contains(colored, "\x1b[31m") {
+		t.Errorf("ANSI capture missing color escape; got %q", colored)
+	}
+
+	plain, err := tm.CapturePaneLines(session, 10)
+	if err != nil {
+		t.Fatalf("CapturePaneLines: %v", err)
+	}
+	if strings.Contains(strings.Join(plain, "\n"), "\x1b[") {
+		t.Errorf("plain capture contains escapes: %q", plain)
+	}
+}
+
+func TestSplitPaneTarget(t *testing.T) {
+	tests := []struct {
+		target       string
+		wantSession  string
+		wantExplicit bool
+	}{
+		{"gt-mayor", "gt-mayor", false},
+		{"gt-mayor:0.1", "gt-mayor", true},
+		{"gt-mayor:logs", "gt-mayor", true},
+	}
+	for _, tt := range tests {
+		session, explicit := splitPaneTarget(tt.target)
+		if session != tt.wantSession || explicit != tt.wantExplicit {
+			t.Errorf("splitPaneTarget(%q) = %q, %v; want %q, %v",
+				tt.target, session, explicit, tt.wantSession, tt.wantExplicit)
+		}
+	}
+}
+
+func TestPaneTargets_NonActivePane(t *testing.T) {
+	tm := newTestTmux(t)
+	session := "gt-test-panes-" + t.Name()
+	_ = tm.KillSession(session)
+	defer func() { _ = tm.KillSession(session) }()
+
+	if err := tm.NewSessionWithCommand(session, "", "sleep 60"); err != nil {
+		t.Fatalf("NewSessionWithCommand: %v", err)
+	}
+	if _, err := tm.run("split-window", "-d", "-t", session, "cat"); err != nil {
+		t.Fatalf("split-window: %v", err)
+	}
+	// -d keeps pane 0 active, so a bare session target resolves to it.
+	logsPane := session + ":0.1"
+
+	if err := tm.NudgeSessionWithOpts(logsPane, "hello from pane one", NudgeOpts{SkipEscape: true}); err != nil {
+		t.Fatalf("NudgeSessionWithOpts(%q): %v", logsPane, err)
+	}
+
+	var lines []string
+	deadline := time.Now().Add(3 * time.Second)
+	for time.Now().Before(deadline) {
+		lines, _ = tm.CapturePaneLines(logsPane, 20)
+		if strings.Contains(strings.Join(lines, "\n"), "hello from pane one") {
+			break
+		}
+		time.Sleep(100 * time.Millisecond)
+	}
+	if !strings.Contains(strings.Join(lines, "\n"), "hello from pane one") {
+		t.Errorf("non-active pane missing nudge; got %q", lines)
+	}
+
+	active, err := tm.CapturePaneLines(session, 20)
+	if err != nil {
+		t.Fatalf("CapturePaneLines(%q): %v", session, err)
+	}
+	if strings.Contains(strings.Join(active, "\n"), "hello from pane one") {
+		t.Errorf("active pane unexpectedly received nudge: %q", active)
+	}
+}
+
+func TestIsKeyName(t *testing.T) {
+	tests := []struct {
+		in   string
+		want bool
+	}{
+		{"C-c", true},
+		{"Escape", true},
+		{"Up", true},
+		{"Enter", true},
+		{"M-Left", true},
+		{"C-M-x", true},
+		{"F1", true},
+		{"F12", true},
+		{"F13", false},
+		{"F0", false},
+		{"C-", false},
+		{"enter", false},
+		{"Enter the code", false},
+		{"sleep 300", false},
+		{"x", false},
+		{"", false},
+	}
+	for _, tt := range tests {
+		if got := IsKeyName(tt.in); got != tt.want {
+			t.Errorf("IsKeyName(%q) = %v, want %v", tt.in, got, tt.want)
+		}
+	}
+}
+
+func TestSendKeySequence_InterruptsSleep(t *testing.T) {
+	tm := newTestTmux(t)
+	sessionName := "gt-test-sendkeys-" + t.Name()
+
+	_ = tm.KillSession(sessionName)
+	if err := tm.NewSessionWithCommand(sessionName, "", "sh"); err != nil {
+		t.Fatalf("NewSessionWithCommand: %v", err)
+	}
+	defer func() { _ = tm.KillSession(sessionName) }()
+
+	waitForCommand := func(match func(string) bool) string {
+		t.Helper()
+		var cmd string
+		for deadline := time.Now().Add(5 * time.Second); time.Now().Before(deadline); time.Sleep(50 * time.Millisecond) {
+			cmd, _ = tm.GetPaneCommand(sessionName)
+			if match(cmd) {
+				return cmd
+			}
+		}
+		return cmd
+	}
+
+	// "sleep 300" is literal text; Enter is a key name.
+	if err := tm.SendKeySequence(sessionName, "sleep 300", "Enter"); err != nil {
+		t.Fatalf("SendKeySequence(start): %v", err)
+	}
+	if cmd := waitForCommand(func(c string) bool { return c == "sleep" }); cmd != "sleep" {
+		t.Fatalf("pane command = %q, want sleep to be running", cmd)
+	}
+
+	if err := tm.SendKeySequence(sessionName, "C-c"); err != nil {
+		t.Fatalf("SendKeySequence(C-c): %v", err)
+	}
+	if cmd := waitForCommand(func(c string) bool { return c != "sleep" }); cmd == "sleep" {
+		t.Error("sleep still running after C-c")
+	}
+}
+
+func TestCapturePaneSince(t *testing.T) {
+	tm := newTestTmux(t)
+	session := "gt-test-since-" + t.Name()
+	_ = tm.KillSession(session)
+	defer func() { _ = tm.KillSession(session) }()
+
+	if err := tm.NewSessionWithCommand(session, "", "sleep 30"); err != nil {
+		t.Fatalf("NewSessionWithCommand: %v", err)
+	}
+	// history-limit only applies to panes created after it is set, so run
+	// cat in a new (now active) window.
+	if _, err := tm.run("set-option", "-t", session, "history-limit", "100"); err != nil {
+		t.Fatalf("set history-limit: %v", err)
+	}
+	if _, err := tm.run("new-window", "-t", session, "cat"); err != nil {
+		t.Fatalf("new-window: %v", err)
+	}
+
+	// waitFor polls until the pane shows want, so marks are taken after
+	// cat has echoed everything sent so far.
+	waitFor := func(want string) {
+		t.Helper()
+		deadline := time.Now().Add(3 * time.Second)
+		for time.Now().Before(deadline) {
+			if out, _ := tm.CapturePaneAll(session); strings.Contains(out, want) {
+				return
+			}
+			time.Sleep(50 * time.Millisecond)
+		}
+		t.Fatalf("pane never showed %q", want)
+	}
+	send := func(lines ...string) {
+		t.Helper()
+		for _, l := range lines {
+			if err := tm.SendKeySequence(session, l, "Enter"); err != nil {
+				t.Fatalf("send %q: %v", l, err)
+			}
+		}
+		waitFor(lines[len(lines)-1])
+	}
+
+	// Fill past the screen height so the mark sits in scrolled history.
+	var before []string
+	for i := 0; i < 20; i++ {
+		before = append(before, fmt.Sprintf("old-%02d", i))
+	}
+	send(before...)
+
+	mark, err := tm.MarkPane(session)
+	if err != nil {
+		t.Fatalf("MarkPane: %v", err)
+	}
+	send("new-a", "new-b")
+
+	lines, truncated, err := tm.CapturePaneSince(session, mark)
+	if err != nil {
+		t.Fatalf("CapturePaneSince: %v", err)
+	}
+	if truncated {
+		t.Fatal("truncated with history well under its limit")
+	}
+	// cat echoes each line twice: once as typed, once as output.
+	got := strings.Join(lines, ",")
+	if got != "new-a,new-a,new-b,new-b" {
+		t.Errorf("lines since mark = %q, want only the new output", got)
+	}
+
+	// Overflow history-limit: old rows are dropped, so the offset is stale.
+	var flood []string
+	for i := 0; i < 150; i++ {
+		flood = append(flood, fmt.Sprintf("flood-%03d", i))
+	}
+	send(flood...)
+	lines, truncated, err = tm.CapturePaneSince(session, mark)
+	if err != nil {
+		t.Fatalf("CapturePaneSince after overflow: %v", err)
+	}
+	if !truncated {
+		t.Error("not truncated after history overflowed its limit")
+	}
+	if !strings.Contains(strings.Join(lines, "\n"), "flood-149") {
+		t.Errorf("truncated capture should return the remaining history, got %d lines", len(lines))
+	}
+
+	// Clearing history also invalidates the mark.
+	if err := tm.ClearHistory(session); err != nil {
+		t.Fatalf("ClearHistory: %v", err)
+	}
+	if _, truncated, err = tm.CapturePaneSince(session, mark); err != nil || !truncated {
+		t.Errorf("after clear-history: truncated = %v, err = %v; want truncated", truncated, err)
+	}
+}
+
+func TestTmuxNotFound(t *testing.T) {
+	for _, bin := range []string{"gt-no-such-tmux", "/nonexistent/bin/tmux"} {
+		t.Run(bin, func(t *testing.T) {
+			orig := tmuxBinary
+			tmuxBinary = bin
+			t.Cleanup(func() { tmuxBinary = orig })
+
+			if err := Available(); !errors.Is(err, ErrTmuxNotFound) {
+				t.Errorf("Available() = %v, want ErrTmuxNotFound", err)
+			}
+			_, err := NewTmuxWithSocket("gt-test-missing").ListSessions()
+			if !errors.Is(err, ErrTmuxNotFound) {
+				t.Fatalf("ListSessions() = %v, want ErrTmuxNotFound", err)
+			}
+			if !strings.Contains(err.Error(), bin) {
+				t.Errorf("error %q does not name the missing binary", err)
+			}
+			if NewTmuxWithSocket("gt-test-missing").IsAvailable() {
+				t.Error("IsAvailable() = true for a missing binary")
+			}
+		})
+	}
+
+	t.Run("not on PATH", func(t *testing.T) {
+		t.Setenv("PATH", t.TempDir())
+		err := Available()
+		if !errors.Is(err, ErrTmuxNotFound) || !strings.Contains(err.Error(), "install tmux") {
+			t.Errorf("Available() = %v, want ErrTmuxNotFound with install guidance", err)
+		}
+	})
+}
+
+// TestBinaryOverride points GT_TMUX at a stub script, which logs its
+// arguments, and checks that Tmux methods run it.
+func TestBinaryOverride(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("stub tmux is a shell script")
+	}
+	dir := t.TempDir()
+	logPath := filepath.Join(dir, "args.log")
+	stub := filepath.Join(dir, "tmux-stub")
+	script := fmt.Sprintf(`#!/bin/sh
+echo "$*" >> %q
+case " $* " in
+*" capture-pane "*) echo "captured $(wc -l < %q | tr -d ' ')" ;;
+esac
+`, logPath, logPath)
+	if err := os.WriteFile(stub, []byte(script), 0755); err != nil {
+		t.Fatal(err)
+	}
+	t.Setenv("GT_TMUX", stub)
+
+	if got := Binary(); got != stub {
+		t.Fatalf("Binary() = %q, want %q", got, stub)
+	}
+	tm := NewTmuxWithSocket("gt-test-stub")
+
+	lines, err := tm.CapturePaneLines("gt-test-stub-sess", 20)
+	if err != nil {
+		t.Fatalf("CapturePaneLines: %v", err)
+	}
+	if len(lines) != 1 || !strings.HasPrefix(lines[0], "captured ") {
+		t.Errorf("CapturePaneLines = %q, want the stub's output", lines)
+	}
+	if err := tm.NudgeSession("gt-test-stub-sess", "hello there"); err != nil {
+		t.Fatalf("NudgeSession: %v", err)
+	}
+
+	data, err := os.ReadFile(logPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	calls := strings.Split(strings.TrimSpace(string(data)), "\n")
+	if want := "-u -L gt-test-stub capture-pane -p -t gt-test-stub-sess -S -20"; calls[0] != want {
+		t.Errorf("first call = %q, want %q", calls[0], want)
+	}
+	var sentText, sentEnter bool
+	for _, c := range calls {
+		if !strings.HasPrefix(c, "-u -L gt-test-stub ") {
+			t.Errorf("call %q is missing the socket flags", c)
+		}
+		if strings.Contains(c, "send-keys -t gt-test-stub-sess -l hello there") {
+			sentText = true
+		}
+		if strings.HasSuffix(c, "send-keys -t gt-test-stub-sess Enter") {
+			sentEnter = true
+		}
+	}
+	if !sentText || !sentEnter {
+		t.Errorf("NudgeSession did not send text and Enter through the stub; calls:\n%s", data)
+	}
+}
+
+// TestCapturePaneContext_CancelKillsTmux points GT_TMUX at a stub that never
+// returns and checks that a cancelled capture gives up instead of blocking.
+func TestCapturePaneContext_CancelKillsTmux(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("stub tmux is a shell script")
+	}
+	stub := filepath.Join(t.TempDir(), "tmux-stub")
+	if err := os.WriteFile(stub, []byte("#!/bin/sh\nexec sleep 30\n"), 0755); err != nil {
+		t.Fatal(err)
+	}
+	t.Setenv("GT_TMUX", stub)
+	tm := NewTmuxWithSocket("gt-test-stub")
+
+	ctx, cancel := context.WithTimeout(context.Background(), 200*time.Millisecond)
+	defer cancel()
+	start := time.Now()
+	_, err := tm.CapturePaneLinesContext(ctx, "gt-test", 10)
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("CapturePaneLinesContext() error = %v, want context.DeadlineExceeded", err)
+	}
+	if elapsed := time.Since(start); elapsed > 5*time.Second {
+		t.Errorf("capture returned after %s, want it killed at the deadline", elapsed)
+	}
+
+	nudgeCtx, nudgeCancel := context.WithCancel(context.Background())
+	nudgeCancel()
+	if err := tm.NudgeSessionContext(nudgeCtx, "gt-test", "hello", NudgeOpts{}); !errors.Is(err, context.Canceled) {
+		t.Errorf("NudgeSessionContext() on a cancelled context = %v, want context.Canceled", err)
+	}
+}
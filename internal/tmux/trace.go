@@ -0,0 +1,71 @@
+package tmux
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// traceArgMax is how much of a long argument (typically nudge text) a trace
+// line keeps; the full size is still reported.
+const traceArgMax = 60
+
+// tracer receives one line per tmux command when tracing is enabled.
+// Tracing is off by default and costs a single atomic load per command.
+type tracer struct {
+	mu sync.Mutex
+	w  io.Writer
+}
+
+var activeTracer atomic.Pointer[tracer]
+
+// SetTraceWriter logs every tmux command the package runs to w, with a
+// timestamp, duration, and output size, for diagnosing flaky captures and
+// nudges. Pass nil to turn tracing off. Safe for concurrent use.
+func SetTraceWriter(w io.Writer) {
+	if w == nil {
+		activeTracer.Store(nil)
+		return
+	}
+	activeTracer.Store(&tracer{w: w})
+}
+
+// trace writes a line such as
+//
+//	2026-01-02T15:04:05.000Z tmux capture-pane -p -t hq-mayor -S -100 (12ms, out=4096B)
+//
+// ending in the error, if any, in place of the output size.
+func (tr *tracer) trace(start time.Time, args []string, out int, err error) {
+	result := fmt.Sprintf("out=%dB", out)
+	if err != nil {
+		result = "err=" + err.Error()
+	}
+	line := fmt.Sprintf("%s tmux %s (%s, %s)\n",
+		start.UTC().Format("2006-01-02T15:04:05.000Z07:00"),
+		traceArgs(args),
+		time.Since(start).Round(time.Millisecond),
+		result)
+
+	tr.mu.Lock()
+	defer tr.mu.Unlock()
+	_, _ = io.WriteString(tr.w, line)
+}
+
+// traceArgs joins args for a trace line, quoting those with spaces and
+// shortening long ones to traceArgMax runes plus their byte count.
+func traceArgs(args []string) string {
+	parts := make([]string, len(args))
+	for i, a := range args {
+		if r := []rune(a); len(r) > traceArgMax {
+			a = fmt.Sprintf("%s…[%dB]", string(r[:traceArgMax]), len(a))
+		}
+		if a == "" || strings.ContainsAny(a, " \t\n\"") {
+			a = fmt.Sprintf("%q", a)
+		}
+		parts[i] = a
+	}
+	return strings.Join(parts, " ")
+}
@@ -0,0 +1,56 @@
+package tmux
+
+import (
+	"bytes"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestTracerLine(t *testing.T) {
+	var buf bytes.Buffer
+	tr := &tracer{w: &buf}
+
+	tr.trace(time.Now(), []string{"capture-pane", "-p", "-t", "hq-mayor"}, 4096, nil)
+	tr.trace(time.Now(), []string{"has-session", "-t", "gone"}, 0, errors.New("session not found"))
+
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	if len(lines) != 2 {
+		t.Fatalf("got %d lines, want 2:\n%s", len(lines), buf.String())
+	}
+	if !strings.Contains(lines[0], "tmux capture-pane -p -t hq-mayor (") || !strings.HasSuffix(lines[0], "out=4096B)") {
+		t.Errorf("line 0 = %q", lines[0])
+	}
+	if !strings.HasSuffix(lines[1], "err=session not found)") {
+		t.Errorf("line 1 = %q", lines[1])
+	}
+}
+
+func TestTraceArgs(t *testing.T) {
+	long := strings.Repeat("x", 100)
+	got := traceArgs([]string{"send-keys", "-l", "hello world", long, ""})
+	want := `send-keys -l "hello world" ` + strings.Repeat("x", traceArgMax) + `…[100B] ""`
+	if got != want {
+		t.Errorf("traceArgs() = %q, want %q", got, want)
+	}
+}
+
+func TestSetTraceWriter_LogsCommands(t *testing.T) {
+	tm := newTestTmux(t)
+	var buf bytes.Buffer
+	SetTraceWriter(&buf)
+	defer SetTraceWriter(nil)
+
+	_, _ = tm.HasSession("gt-test-trace-nonexistent")
+	SetTraceWriter(nil)
+	_, _ = tm.HasSession("gt-test-trace-after-off")
+
+	out := buf.String()
+	if !strings.Contains(out, "gt-test-trace-nonexistent") {
+		t.Errorf("trace = %q, want the has-session call", out)
+	}
+	if strings.Contains(out, "gt-test-trace-after-off") {
+		t.Errorf("trace = %q, logged a call after tracing was turned off", out)
+	}
+}
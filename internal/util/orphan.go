@@ -105,7 +105,7 @@ func getTmuxSessionPIDs() map[int]bool {
 // collectPanePIDs queries a single tmux socket for all pane PIDs and adds them
 // (plus their descendant processes) to the protection set.
 func collectPanePIDs(socketPath string, childMap map[int][]int, pids map[int]bool) {
-	out, err := exec.Command("tmux", "-S", socketPath, "list-panes", "-a", "-F", "#{pane_pid}").Output()
+	out, err := exec.Command(tmux.Binary(), "-S", socketPath, "list-panes", "-a", "-F", "#{pane_pid}").Output()
 	if err != nil {
 		return
 	}
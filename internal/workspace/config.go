@@ -0,0 +1,62 @@
+package workspace
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"github.com/steveyegge/gastown/internal/config"
+)
+
+// Config is a workspace's parsed town settings (settings/config.json under
+// the town root). Accessors on the embedded TownSettings, such as
+// ConvoySettings, return zero-value defaults for unset sections.
+type Config struct {
+	*config.TownSettings
+
+	// Path is the settings file the config was read from.
+	Path string
+
+	// UnknownKeys lists keys in the file that gt does not recognize.
+	// They are ignored; callers should warn so typos don't go unnoticed.
+	UnknownKeys []string
+}
+
+// Load locates the workspace from the current directory (see
+// FindFromCwdOrError) and returns its root and config.
+func Load() (string, *Config, error) {
+	root, err := FindFromCwdOrError()
+	if err != nil {
+		return "", nil, err
+	}
+	cfg, err := LoadConfig(root)
+	if err != nil {
+		return "", nil, err
+	}
+	return root, cfg, nil
+}
+
+// LoadConfig reads the config for the workspace at townRoot. A missing
+// settings file is not an error: the defaults from config.NewTownSettings
+// are returned instead.
+func LoadConfig(townRoot string) (*Config, error) {
+	path := config.TownSettingsPath(townRoot)
+	cfg := &Config{Path: path}
+
+	data, err := os.ReadFile(path) //nolint:gosec // G304: path is constructed internally
+	if os.IsNotExist(err) {
+		cfg.TownSettings = config.NewTownSettings()
+		return cfg, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("reading %s: %w", path, err)
+	}
+
+	var settings config.TownSettings
+	if err := json.Unmarshal(data, &settings); err != nil {
+		return nil, fmt.Errorf("parsing %s: %w", path, err)
+	}
+	cfg.TownSettings = &settings
+	cfg.UnknownKeys = config.UnknownTownSettingsKeys(data)
+	return cfg, nil
+}
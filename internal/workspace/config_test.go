@@ -0,0 +1,65 @@
+package workspace
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestLoadConfig_MissingFileReturnsDefaults(t *testing.T) {
+	root := t.TempDir()
+	cfg, err := LoadConfig(root)
+	if err != nil {
+		t.Fatalf("LoadConfig: %v", err)
+	}
+	if cfg.DefaultAgent != "claude" {
+		t.Errorf("DefaultAgent = %q, want claude", cfg.DefaultAgent)
+	}
+	if got := cfg.ConvoySettings().MaxPerRig; got != 0 {
+		t.Errorf("ConvoySettings().MaxPerRig = %d, want 0", got)
+	}
+	if len(cfg.UnknownKeys) != 0 {
+		t.Errorf("UnknownKeys = %v, want none", cfg.UnknownKeys)
+	}
+}
+
+func TestLoadConfig_ParsesAndReportsUnknownKeys(t *testing.T) {
+	root := t.TempDir()
+	path := filepath.Join(root, "settings", "config.json")
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		t.Fatalf("mkdir: %v", err)
+	}
+	data := `{"type":"town-settings","version":1,"convoy":{"max_per_rig":3,"max_per_rgi":4},"colour":"red"}`
+	if err := os.WriteFile(path, []byte(data), 0644); err != nil {
+		t.Fatalf("write: %v", err)
+	}
+
+	cfg, err := LoadConfig(root)
+	if err != nil {
+		t.Fatalf("LoadConfig: %v", err)
+	}
+	if got := cfg.ConvoySettings().MaxPerRig; got != 3 {
+		t.Errorf("ConvoySettings().MaxPerRig = %d, want 3", got)
+	}
+	if cfg.Path != path {
+		t.Errorf("Path = %q, want %q", cfg.Path, path)
+	}
+	want := []string{"colour", "convoy.max_per_rgi"}
+	if len(cfg.UnknownKeys) != len(want) || cfg.UnknownKeys[0] != want[0] || cfg.UnknownKeys[1] != want[1] {
+		t.Errorf("UnknownKeys = %v, want %v", cfg.UnknownKeys, want)
+	}
+}
+
+func TestLoadConfig_InvalidJSON(t *testing.T) {
+	root := t.TempDir()
+	path := filepath.Join(root, "settings", "config.json")
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		t.Fatalf("mkdir: %v", err)
+	}
+	if err := os.WriteFile(path, []byte("{not json"), 0644); err != nil {
+		t.Fatalf("write: %v", err)
+	}
+	if _, err := LoadConfig(root); err == nil {
+		t.Error("LoadConfig() with invalid JSON: want error")
+	}
+}
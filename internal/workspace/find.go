@@ -75,8 +75,12 @@ func FindOrError(startDir string) (string, error) {
 	return root, nil
 }
 
-// FindFromCwd locates the town root from the current working directory.
+// FindFromCwd locates the town root from the current working directory,
+// unless a root was given explicitly (see Override).
 func FindFromCwd() (string, error) {
+	if root, ok, err := Override(); ok {
+		return root, err
+	}
 	cwd, err := os.Getwd()
 	if err != nil {
 		return "", fmt.Errorf("getting current directory: %w", err)
@@ -85,9 +89,13 @@ func FindFromCwd() (string, error) {
 }
 
 // FindFromCwdOrError is like FindFromCwd but returns an error if not found.
-// It searches for a workspace starting from the CWD. If none is found, it
+// An explicit root (--workspace, then GT_WORKSPACE) wins. Otherwise it
+// searches for a workspace starting from the CWD and, if none is found,
 // falls back to the GT_TOWN_ROOT or GT_ROOT environment variables.
 func FindFromCwdOrError() (string, error) {
+	if root, ok, err := Override(); ok {
+		return root, err
+	}
 	cwd, err := os.Getwd()
 	if err == nil {
 		root, err := Find(cwd)
@@ -118,6 +126,15 @@ func FindFromCwdOrError() (string, error) {
 // working directory is deleted (e.g., polecat worktree nuked by Witness).
 func FindFromCwdWithFallback() (townRoot string, cwd string, err error) {
 	cwd, err = os.Getwd()
+	if root, ok, overrideErr := Override(); ok {
+		if overrideErr != nil {
+			return "", "", overrideErr
+		}
+		if err != nil {
+			cwd = ""
+		}
+		return root, cwd, nil
+	}
 	if err != nil {
 		// Fallback: try GT_TOWN_ROOT env var
 		if townRoot = os.Getenv("GT_TOWN_ROOT"); townRoot != "" {
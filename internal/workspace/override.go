@@ -0,0 +1,54 @@
+package workspace
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+)
+
+// EnvWorkspace names the environment variable that pins the workspace root,
+// bypassing the upward search from the current directory. Useful when gt
+// runs outside the tree, e.g. from CI or an editor plugin.
+const EnvWorkspace = "GT_WORKSPACE"
+
+var (
+	flagMu   sync.RWMutex
+	flagRoot string
+)
+
+// SetOverride pins the workspace root from the global --workspace flag.
+// It takes precedence over GT_WORKSPACE. An empty path clears it.
+func SetOverride(path string) {
+	flagMu.Lock()
+	defer flagMu.Unlock()
+	flagRoot = path
+}
+
+// Override returns the explicitly requested workspace root, validated, if
+// one was given via --workspace or GT_WORKSPACE (in that order). ok is false
+// when neither is set and callers should search from the current directory.
+// A path that is not a workspace is an error naming the source.
+func Override() (root string, ok bool, err error) {
+	flagMu.RLock()
+	path, source := flagRoot, "--workspace"
+	flagMu.RUnlock()
+	if path == "" {
+		path, source = os.Getenv(EnvWorkspace), EnvWorkspace
+	}
+	if path == "" {
+		return "", false, nil
+	}
+
+	abs, err := filepath.Abs(path)
+	if err != nil {
+		return "", true, fmt.Errorf("%s %s: resolving path: %w", source, path, err)
+	}
+	if info, err := os.Stat(abs); err != nil || !info.IsDir() {
+		return "", true, fmt.Errorf("%s %s: not a directory", source, path)
+	}
+	if isWs, _ := IsWorkspace(abs); !isWs {
+		return "", true, fmt.Errorf("%s %s: %w (no %s)", source, path, ErrNotFound, PrimaryMarker)
+	}
+	return abs, true, nil
+}
@@ -0,0 +1,69 @@
+package workspace
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func makeTown(t *testing.T) string {
+	t.Helper()
+	root := realPath(t, t.TempDir())
+	if err := os.MkdirAll(filepath.Join(root, "mayor"), 0755); err != nil {
+		t.Fatalf("mkdir: %v", err)
+	}
+	if err := os.WriteFile(filepath.Join(root, PrimaryMarker), []byte(`{"type":"town"}`), 0644); err != nil {
+		t.Fatalf("write: %v", err)
+	}
+	return root
+}
+
+func TestOverride_Precedence(t *testing.T) {
+	flagTown, envTown := makeTown(t), makeTown(t)
+	t.Cleanup(func() { SetOverride("") })
+
+	t.Setenv(EnvWorkspace, "")
+	if _, ok, _ := Override(); ok {
+		t.Fatal("Override() with nothing set: ok = true")
+	}
+
+	t.Setenv(EnvWorkspace, envTown)
+	if root, ok, err := Override(); !ok || err != nil || root != envTown {
+		t.Errorf("Override() from env = (%q, %v, %v), want %q", root, ok, err, envTown)
+	}
+
+	SetOverride(flagTown)
+	if root, ok, err := Override(); !ok || err != nil || root != flagTown {
+		t.Errorf("Override() from flag = (%q, %v, %v), want %q", root, ok, err, flagTown)
+	}
+}
+
+func TestOverride_InvalidNamesSource(t *testing.T) {
+	t.Cleanup(func() { SetOverride("") })
+	notTown := t.TempDir()
+
+	t.Setenv(EnvWorkspace, notTown)
+	_, ok, err := Override()
+	if !ok || !errors.Is(err, ErrNotFound) || !strings.Contains(err.Error(), EnvWorkspace) {
+		t.Errorf("Override() env = (ok %v, err %v), want ErrNotFound naming %s", ok, err, EnvWorkspace)
+	}
+
+	SetOverride(filepath.Join(notTown, "missing"))
+	_, _, err = Override()
+	if err == nil || !strings.Contains(err.Error(), "--workspace") {
+		t.Errorf("Override() flag err = %v, want error naming --workspace", err)
+	}
+}
+
+func TestFindFromCwdOrError_UsesOverride(t *testing.T) {
+	town := makeTown(t)
+	t.Setenv(EnvWorkspace, town)
+	t.Chdir(t.TempDir()) // outside any workspace
+
+	root, err := FindFromCwdOrError()
+	if err != nil || root != town {
+		t.Errorf("FindFromCwdOrError() = (%q, %v), want %q", root, err, town)
+	}
+}